@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/flovouin/terraform-provider-metabase/internal/importer"
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+)
+
+// The ID of the root collection, which contains all other collections.
+const rootCollectionId = "root"
+
+// Returns whether the collection is located under the given collection, using its `location`, e.g. `/3/12/` for a
+// collection under collections 3 and 12. All collections are under the root collection.
+func isInCollectionTree(c metabase.Collection, rootId string) bool {
+	if rootId == rootCollectionId {
+		return true
+	}
+
+	return c.Location != nil && strings.Contains(*c.Location, "/"+rootId+"/")
+}
+
+// Returns whether the collection is located under any of the given collections, using its `location`.
+func isUnderCollections(c metabase.Collection, ids map[string]bool) bool {
+	if c.Location == nil {
+		return false
+	}
+
+	for _, ancestorId := range strings.Split(strings.Trim(*c.Location, "/"), "/") {
+		if ids[ancestorId] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns the IDs of the given collection and all its sub-collections.
+// Archived collections, special collections managed by Metabase, and personal collections are skipped, along with the
+// sub-collections of special and personal collections, unless one of them is the root of the tree.
+func listCollectionTree(ctx context.Context, rootId string, client metabase.ClientWithResponses) ([]string, error) {
+	listResp, err := client.ListCollectionsWithResponse(ctx, &metabase.ListCollectionsParams{})
+	if err != nil {
+		return nil, err
+	}
+	if listResp.JSON200 == nil {
+		return nil, errors.New("received unexpected response when listing collections")
+	}
+
+	skippedIds := make(map[string]bool)
+	for _, c := range *listResp.JSON200 {
+		id, err := collectionIdAsString(c.Id)
+		if err != nil || *id == rootId {
+			continue
+		}
+
+		if c.Type != nil || c.PersonalOwnerId != nil {
+			skippedIds[*id] = true
+		}
+	}
+
+	collectionIds := []string{rootId}
+	for _, c := range *listResp.JSON200 {
+		id, err := collectionIdAsString(c.Id)
+		if err != nil || *id == rootId {
+			continue
+		}
+
+		if c.Archived != nil && *c.Archived {
+			continue
+		}
+
+		if skippedIds[*id] || isUnderCollections(c, skippedIds) {
+			continue
+		}
+
+		if isInCollectionTree(c, rootId) {
+			collectionIds = append(collectionIds, *id)
+		}
+	}
+
+	return collectionIds, nil
+}
+
+// Imports every dashboard and card in the given collection and its sub-collections. The collections themselves are also
+// imported, unless they are defined in the configuration.
+func importCollectionTree(ctx context.Context, rootId string, client metabase.ClientWithResponses, ic *importer.ImportContext) error {
+	collectionIds, err := listCollectionTree(ctx, rootId, client)
+	if err != nil {
+		return err
+	}
+
+	for _, collectionId := range collectionIds {
+		// The root collection is not a resource, and cards and dashboards in it simply have a null collection.
+		if collectionId != rootCollectionId {
+			_, err = ic.ImportCollection(ctx, collectionId)
+			if err != nil {
+				return err
+			}
+		}
+
+		items, err := metabase.ListAllCollectionItems(ctx, &client, collectionId, metabase.ListCollectionItemsParams{
+			Models: &[]metabase.CollectionItemModel{
+				metabase.CollectionItemModelCard,
+				metabase.CollectionItemModelDataset,
+				metabase.CollectionItemModelDashboard,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			switch item.Model {
+			case metabase.CollectionItemModelDashboard:
+				_, err = ic.ImportDashboard(ctx, item.Id)
+			case metabase.CollectionItemModelCard, metabase.CollectionItemModelDataset:
+				_, err = ic.ImportCard(ctx, item.Id)
+			}
+			if err != nil {
+				return fmt.Errorf("unable to import %s %d from collection %s: %w", item.Model, item.Id, collectionId, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+)
+
+// The collections returned by the Metabase API in the tests, including archived, special, personal, and nested personal
+// collections.
+const testCollectionsResponse = `[
+	{"id": "root", "name": "Our analytics"},
+	{"id": 1, "name": "Alice's Personal Collection", "location": "/", "personal_owner_id": 1},
+	{"id": 2, "name": "Reports", "location": "/"},
+	{"id": 3, "name": "Monthly", "location": "/2/"},
+	{"id": 4, "name": "Old reports", "location": "/2/", "archived": true},
+	{"id": 5, "name": "Drafts", "location": "/1/"},
+	{"id": 6, "name": "Nested drafts", "location": "/1/5/"},
+	{"id": 7, "name": "Metabase analytics", "location": "/", "type": "instance-analytics"},
+	{"id": 8, "name": "Usage", "location": "/7/"},
+	{"id": 9, "name": "Weekly", "location": "/2/3/"}
+]`
+
+func TestIsInCollectionTree(t *testing.T) {
+	location := "/2/3/"
+	nested := metabase.Collection{Name: "Weekly", Location: &location}
+
+	testCases := []struct {
+		rootId   string
+		expected bool
+	}{
+		{rootCollectionId, true},
+		{"2", true},
+		{"3", true},
+		{"9", false},
+		// IDs are matched as a whole, not as a prefix.
+		{"23", false},
+	}
+
+	for _, tc := range testCases {
+		if isInCollectionTree(nested, tc.rootId) != tc.expected {
+			t.Errorf("Expected %t for root %s.", tc.expected, tc.rootId)
+		}
+	}
+
+	if isInCollectionTree(metabase.Collection{Name: "No location"}, "2") {
+		t.Errorf("Expected a collection without a location not to be in the tree.")
+	}
+}
+
+func TestListCollectionTree(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/collection" {
+			t.Errorf("Unexpected request: %s %s.", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(testCollectionsResponse))
+	}))
+	defer server.Close()
+
+	client, err := metabase.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v.", err)
+	}
+
+	testCases := []struct {
+		rootId   string
+		expected []string
+	}{
+		{rootCollectionId, []string{"root", "2", "3", "9"}},
+		{"2", []string{"2", "3", "9"}},
+		// A personal collection explicitly selected as the root is imported along with its sub-collections.
+		{"1", []string{"1", "5", "6"}},
+	}
+
+	for _, tc := range testCases {
+		collectionIds, err := listCollectionTree(context.Background(), tc.rootId, *client)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v.", err)
+		}
+
+		if !reflect.DeepEqual(collectionIds, tc.expected) {
+			t.Errorf("Expected collections %v for root %s, got %v.", tc.expected, tc.rootId, collectionIds)
+		}
+	}
+}
+
+func TestIsUnderCollections(t *testing.T) {
+	var collections []metabase.Collection
+	err := json.Unmarshal([]byte(testCollectionsResponse), &collections)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	skippedIds := map[string]bool{"1": true, "7": true}
+	var underSkipped []string
+	for _, c := range collections {
+		if isUnderCollections(c, skippedIds) {
+			underSkipped = append(underSkipped, c.Name)
+		}
+	}
+
+	expected := []string{"Drafts", "Nested drafts", "Usage"}
+	if !reflect.DeepEqual(underSkipped, expected) {
+		t.Errorf("Expected %v to be under skipped collections, got %v.", expected, underSkipped)
+	}
+}
@@ -23,6 +23,7 @@ type metabaseConfig struct {
 	Endpoint string `koanf:"endpoint"` // The URL to the Metabase API.
 	Username string `koanf:"username"` // The username (email address) to use to log in.
 	Password string `koanf:"password"` // The password to use to log in.
+	ApiKey   string `koanf:"api_key"`  // The API key to use instead of a username and password.
 }
 
 // A single mapping from a database to a Terraform resource name.
@@ -46,7 +47,9 @@ type collectionMappingConfig struct {
 
 // Defines how collections references are handled and converted in the generated Terraform code.
 type collectionsConfig struct {
-	Mapping []collectionMappingConfig `koanf:"mapping"` // The list of mappings from collections to Terraform resources.
+	Mapping         []collectionMappingConfig `koanf:"mapping"`          // The list of mappings from collections to Terraform resources.
+	IncludeArchived bool                      `koanf:"include_archived"` // Whether archived collections can match a mapping by name.
+	AutoImport      bool                      `koanf:"auto_import"`      // Whether collections missing from the mapping should be imported as Terraform resources.
 }
 
 // Defines a reference to a collection in Metabase.
@@ -57,11 +60,13 @@ type collectionDefinition struct {
 
 // Defines which dashboards to include in the import.
 type dashboardFilterConfig struct {
-	IncludedCollections  []collectionDefinition `koanf:"included_collections"`  // The list of collections for which dashboards should be imported. All collections are imported by default.
-	ExcludedCollections  []collectionDefinition `koanf:"excluded_collections"`  // The list of collections to exclude from the import.
-	DashboardName        string                 `koanf:"dashboard_name"`        // A regexp that the dashboard name should match in order to be imported.
-	DashboardDescription string                 `koanf:"dashboard_description"` // A regexp that the dashboard description should match in order to be imported.
-	DashboardIds         []int                  `koanf:"dashboard_ids"`         // The list of IDs of the dashboards to import. If this is non-empty, all other parameters are ignored.
+	IncludedCollections        []collectionDefinition `koanf:"included_collections"`         // The list of collections for which dashboards should be imported. All collections are imported by default.
+	ExcludedCollections        []collectionDefinition `koanf:"excluded_collections"`         // The list of collections to exclude from the import.
+	IncludeArchivedCollections bool                   `koanf:"include_archived_collections"` // Whether dashboards in archived collections can be imported.
+	IncludeSpecialCollections  bool                   `koanf:"include_special_collections"`  // Whether dashboards in special collections managed by Metabase can be imported.
+	DashboardName              string                 `koanf:"dashboard_name"`               // A regexp that the dashboard name should match in order to be imported.
+	DashboardDescription       string                 `koanf:"dashboard_description"`        // A regexp that the dashboard description should match in order to be imported.
+	DashboardIds               []int                  `koanf:"dashboard_ids"`                // The list of IDs of the dashboards to import. If this is non-empty, all other parameters are ignored.
 }
 
 // Defines how the Terraform configuration is written to files.
@@ -69,6 +74,18 @@ type outputConfig struct {
 	Path              string `koanf:"path"`               // The path where the Terraform configuration will be written.
 	Clear             bool   `koanf:"clear"`              // Whether generated files with the right prefix should be removed from the output directory before writing.
 	DisableFormatting bool   `koanf:"disable_formatting"` // If `true`, does not attempt to run `terraform fmt` after writing the files.
+
+	EmitProviderConfig bool   `koanf:"emit_provider_config"` // Whether a file requiring the Metabase provider should be written along with the resources.
+	ProviderVersion    string `koanf:"provider_version"`     // The constraint on the version of the provider in the generated file. No constraint is set if empty.
+	EmitProviderBlock  bool   `koanf:"emit_provider_block"`  // Whether the generated file should also contain a `provider` block setting the endpoint.
+}
+
+// Defines how Metabase's native serialization archives are exported and imported.
+type serializationConfig struct {
+	Path        string `koanf:"path"`         // The path of the archive, written when exporting and read when importing.
+	Settings    bool   `koanf:"settings"`     // Whether instance settings are included in the export.
+	DataModel   bool   `koanf:"data_model"`   // Whether the data model (databases, tables, and fields) is included in the export.
+	FieldValues bool   `koanf:"field_values"` // Whether the values of fields are included in the export.
 }
 
 // The entire configuration when importing dashboards from Metabase.
@@ -78,6 +95,7 @@ type importerConfig struct {
 	Collections     collectionsConfig     `koanf:"collections"`      // Defines how collections references are handled and converted in the generated Terraform code.
 	DashboardFilter dashboardFilterConfig `koanf:"dashboard_filter"` // Defines which dashboards to include in the import.
 	Output          outputConfig          `koanf:"output"`           // Defines how the Terraform configuration is written to files.
+	Serialization   serializationConfig   `koanf:"serialization"`    // Defines how Metabase's native serialization archives are exported and imported.
 }
 
 // Loads the `importedConfig` from the config file and the environment.
@@ -88,6 +106,11 @@ func loadConfig() (*importerConfig, error) {
 		Output: outputConfig{
 			Path: "./",
 		},
+		Serialization: serializationConfig{
+			Path:      "metabase-serialization.tar.gz",
+			Settings:  true,
+			DataModel: true,
+		},
 	}, "koanf"), nil)
 	if err != nil {
 		return nil, err
@@ -98,9 +121,11 @@ func loadConfig() (*importerConfig, error) {
 		return nil, err
 	}
 
+	// Only the first underscore separates the section from the key, such that keys can contain underscores, e.g.
+	// `MBTF_METABASE_API_KEY` sets `metabase.api_key`.
 	err = k.Load(env.Provider(environmentVariablesPrefix, ".", func(s string) string {
 		return strings.Replace(strings.ToLower(
-			strings.TrimPrefix(s, environmentVariablesPrefix)), "_", ".", -1)
+			strings.TrimPrefix(s, environmentVariablesPrefix)), "_", ".", 1)
 	}), nil)
 	if err != nil {
 		return nil, err
@@ -72,6 +72,15 @@ func listCollectionsToImport(ctx context.Context, config dashboardFilterConfig,
 			continue
 		}
 
+		if !config.IncludeArchivedCollections && c.Archived != nil && *c.Archived {
+			continue
+		}
+
+		// Special collections (e.g. instance analytics) contain content managed by Metabase itself.
+		if !config.IncludeSpecialCollections && c.Type != nil {
+			continue
+		}
+
 		// Excluded collections take precedence over inclusion.
 		isExcluded, err := isCollectionInDefinitions(c, config.ExcludedCollections)
 		if err != nil {
@@ -131,20 +140,14 @@ func listDashboardsToImport(ctx context.Context, config dashboardFilterConfig, c
 	dashboardIds := make([]int, 0)
 
 	for _, collectionId := range collectionIds {
-		listResp, err := client.ListCollectionItemsWithResponse(ctx, collectionId, &metabase.ListCollectionItemsParams{
+		dashboards, err := metabase.ListAllCollectionItems(ctx, &client, collectionId, metabase.ListCollectionItemsParams{
 			Models: &[]metabase.CollectionItemModel{metabase.CollectionItemModelDashboard},
 		})
 		if err != nil {
 			return nil, err
 		}
-		if listResp.JSON200 == nil {
-			return nil, errors.New("received unexpected response when listing dashboards")
-		}
-		if listResp.JSON200.Total != len(listResp.JSON200.Data) {
-			return nil, errors.New("received unexpected response when listing dashboards: pagination is not supported")
-		}
 
-		for _, dashboard := range listResp.JSON200.Data {
+		for _, dashboard := range dashboards {
 			if nameRegexp != nil && !nameRegexp.MatchString(dashboard.Name) {
 				continue
 			}
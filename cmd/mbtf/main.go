@@ -16,15 +16,24 @@ func makeMetabaseClient(ctx context.Context, config metabaseConfig) (*metabase.C
 		return nil, errors.New("the Metabase endpoint should be set and non-empty")
 	}
 
+	// API keys do not require creating a session, which is useful for instances where password login is disabled.
+	if len(config.ApiKey) > 0 {
+		if len(config.Username) > 0 || len(config.Password) > 0 {
+			return nil, errors.New("only one of the Metabase username / password or API key should be set")
+		}
+
+		return metabase.MakeAuthenticatedClientWithApiKey(ctx, config.Endpoint, config.ApiKey, "")
+	}
+
 	if len(config.Username) == 0 {
-		return nil, errors.New("the Metabase username should be set and non-empty")
+		return nil, errors.New("the Metabase username (or API key) should be set and non-empty")
 	}
 
 	if len(config.Password) == 0 {
 		return nil, errors.New("the Metabase password should be set and non-empty")
 	}
 
-	client, err := metabase.MakeAuthenticatedClientWithUsernameAndPassword(ctx, config.Endpoint, config.Username, config.Password)
+	client, err := metabase.MakeAuthenticatedClientWithUsernameAndPassword(ctx, config.Endpoint, config.Username, config.Password, "")
 	if err != nil {
 		return nil, err
 	}
@@ -89,31 +98,62 @@ func setUpCollections(ctx context.Context, config collectionsConfig, ic importer
 		})
 	}
 
-	return ic.ImportCollectionsFromDefinitions(ctx, definitions)
+	return ic.ImportCollectionsFromDefinitions(ctx, definitions, config.IncludeArchived)
 }
 
-// Runs the command line.
-func runImport() error {
-	config, err := loadConfig()
+// Creates the Metabase API client and the import context, including the databases and collections defined in the
+// configuration.
+func makeImportContext(ctx context.Context, config importerConfig) (*metabase.ClientWithResponses, *importer.ImportContext, error) {
+	client, err := makeMetabaseClient(ctx, config.Metabase)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	ctx := context.Background()
+	ic := importer.NewImportContext(*client)
+	if config.Collections.AutoImport {
+		ic.EnableCollectionsImport()
+	}
 
-	client, err := makeMetabaseClient(ctx, config.Metabase)
+	err = setUpDatabases(ctx, config.Databases, ic)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	ic := importer.NewImportContext(*client)
+	err = setUpCollections(ctx, config.Collections, ic)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	err = setUpDatabases(ctx, config.Databases, ic)
+	return client, &ic, nil
+}
+
+// Writes the imported Terraform configuration to the output defined in the configuration.
+func writeImportOutput(config importerConfig, ic *importer.ImportContext) error {
+	writeOptions := importer.WriteOptions{
+		ClearOutput:       config.Output.Clear,
+		DisableFormatting: config.Output.DisableFormatting,
+	}
+	if config.Output.EmitProviderConfig {
+		writeOptions.ProviderConfig = &importer.ProviderConfigOptions{
+			VersionConstraint: config.Output.ProviderVersion,
+			EmitProviderBlock: config.Output.EmitProviderBlock,
+			Endpoint:          config.Metabase.Endpoint,
+		}
+	}
+
+	return ic.Write(config.Output.Path, writeOptions)
+}
+
+// Runs the command line.
+func runImport() error {
+	config, err := loadConfig()
 	if err != nil {
 		return err
 	}
 
-	err = setUpCollections(ctx, config.Collections, ic)
+	ctx := context.Background()
+
+	client, ic, err := makeImportContext(ctx, *config)
 	if err != nil {
 		return err
 	}
@@ -130,20 +170,55 @@ func runImport() error {
 		}
 	}
 
-	err = ic.Write(config.Output.Path, importer.WriteOptions{
-		ClearOutput:       config.Output.Clear,
-		DisableFormatting: config.Output.DisableFormatting,
-	})
+	return writeImportOutput(*config, ic)
+}
+
+// Imports every dashboard and card in a collection and its sub-collections, ignoring the dashboard filter. Collections
+// in the tree are always imported, unless they are defined in the configuration.
+func runImportCollection(collectionId string) error {
+	config, err := loadConfig()
 	if err != nil {
 		return err
 	}
 
-	return nil
+	ctx := context.Background()
+
+	client, ic, err := makeImportContext(ctx, *config)
+	if err != nil {
+		return err
+	}
+	ic.EnableCollectionsImport()
+
+	err = importCollectionTree(ctx, collectionId, *client, ic)
+	if err != nil {
+		return err
+	}
+
+	return writeImportOutput(*config, ic)
+}
+
+// Runs the command corresponding to the arguments. With no argument, dashboards are imported as Terraform
+// configuration. `import-collection <id>` imports the content of a collection tree instead of filtering dashboards.
+// `serialization export` and `serialization import` use Metabase's native serialization format instead.
+func run(args []string) error {
+	if len(args) == 0 {
+		return runImport()
+	}
+
+	if args[0] == "import-collection" && len(args) == 2 {
+		return runImportCollection(args[1])
+	}
+
+	if args[0] == "serialization" && len(args) == 2 {
+		return runSerialization(args[1])
+	}
+
+	return fmt.Errorf("unexpected arguments: %v, expected no argument, 'import-collection <id>', or 'serialization export|import'", args)
 }
 
 // The main entrypoint.
 func main() {
-	err := runImport()
+	err := run(os.Args[1:])
 	if err != nil {
 		fmt.Println(err.Error())
 		os.Exit(1)
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+)
+
+// The name of the multipart form field containing the archive when importing it.
+const serializationImportFileField = "file"
+
+// Returns an error if the response of a serialization call is not successful.
+// Serialization is only available in paid versions of Metabase, which is mentioned when the response indicates it.
+func checkSerializationResponse(r metabase.MetabaseResponse, err error, operation string) error {
+	if err != nil {
+		return err
+	}
+
+	if r.StatusCode() == 200 {
+		return nil
+	}
+
+	if metabase.IsPaidFeatureUnavailable(r) {
+		return fmt.Errorf("serialization is not available in this Metabase instance (status code %d), it requires a paid version of Metabase", r.StatusCode())
+	}
+
+	return fmt.Errorf("unexpected response from the Metabase API during %s (status code %d): %s", operation, r.StatusCode(), r.BodyString())
+}
+
+// Exports the content of the Metabase instance using the native serialization format, and writes the archive to the
+// configured path.
+// The export is performed synchronously by Metabase, and the archive is returned once it is complete.
+func exportSerialization(ctx context.Context, config serializationConfig, client metabase.ClientWithResponses) error {
+	allCollections := true
+	resp, err := client.ExportSerializationWithResponse(ctx, &metabase.ExportSerializationParams{
+		AllCollections: &allCollections,
+		Settings:       &config.Settings,
+		DataModel:      &config.DataModel,
+		FieldValues:    &config.FieldValues,
+	})
+	err = checkSerializationResponse(resp, err, "serialization export")
+	if err != nil {
+		return err
+	}
+
+	// Writing to a temporary file first, such that an existing archive is not left half-written on failure.
+	tmpFile, err := os.CreateTemp(filepath.Dir(config.Path), ".mbtf-serialization-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	_, err = tmpFile.Write(resp.Body)
+	if err != nil {
+		tmpFile.Close()
+		return err
+	}
+
+	err = tmpFile.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), config.Path)
+}
+
+// Reads the archive at the configured path and imports it into the Metabase instance using the native serialization
+// format. The logs of the import returned by Metabase are written to `logs`.
+func importSerialization(ctx context.Context, config serializationConfig, client metabase.ClientWithResponses, logs io.Writer) error {
+	archive, err := os.Open(config.Path)
+	if err != nil {
+		return err
+	}
+	defer archive.Close()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	part, err := writer.CreateFormFile(serializationImportFileField, filepath.Base(config.Path))
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(part, archive)
+	if err != nil {
+		return err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ImportSerializationWithBodyWithResponse(ctx, writer.FormDataContentType(), &body)
+	err = checkSerializationResponse(resp, err, "serialization import")
+	if err != nil {
+		return err
+	}
+
+	// Metabase returns the logs of the import, which are useful to check what has been modified.
+	_, err = fmt.Fprintln(logs, resp.BodyString())
+	return err
+}
+
+// Runs a serialization command, which is either `export` or `import`.
+func runSerialization(command string) error {
+	config, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	client, err := makeMetabaseClient(ctx, config.Metabase)
+	if err != nil {
+		return err
+	}
+
+	switch command {
+	case "export":
+		return exportSerialization(ctx, config.Serialization, *client)
+	case "import":
+		return importSerialization(ctx, config.Serialization, *client, os.Stderr)
+	default:
+		return fmt.Errorf("unknown serialization command '%s', expected 'export' or 'import'", command)
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+)
+
+func TestCheckSerializationResponse(t *testing.T) {
+	testCases := []struct {
+		statusCode  int
+		body        string
+		expectError bool
+		expectPaid  bool
+	}{
+		{200, `{}`, false, false},
+		{402, `"Serialization is a paid feature not currently available to your instance."`, true, true},
+		{404, `"API endpoint does not exist."`, true, true},
+		{404, `<html>Not Found</html>`, true, false},
+		{500, `"Internal error."`, true, false},
+	}
+
+	for _, tc := range testCases {
+		resp := &metabase.ImportSerializationResponse{
+			Body:         []byte(tc.body),
+			HTTPResponse: &http.Response{StatusCode: tc.statusCode},
+		}
+
+		err := checkSerializationResponse(resp, nil, "serialization import")
+		if (err != nil) != tc.expectError {
+			t.Errorf("Unexpected error for status code %d: %v.", tc.statusCode, err)
+			continue
+		}
+		if err != nil && strings.Contains(err.Error(), "paid version") != tc.expectPaid {
+			t.Errorf("Unexpected error message for status code %d and body %s: %v.", tc.statusCode, tc.body, err)
+		}
+	}
+
+	requestErr := errors.New("connection refused")
+	if err := checkSerializationResponse(nil, requestErr, "serialization import"); err != requestErr {
+		t.Errorf("Expected the request error to be returned, got: %v.", err)
+	}
+}
+
+func TestExportSerializationWritesArchive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/ee/serialization/export" {
+			t.Errorf("Unexpected request: %s %s.", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("all_collections") != "true" || r.URL.Query().Get("data_model") != "false" {
+			t.Errorf("Unexpected query parameters: %s.", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/gzip")
+		w.Write([]byte("archive content"))
+	}))
+	defer server.Close()
+
+	client, err := metabase.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v.", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "metabase.tar.gz")
+	err = exportSerialization(context.Background(), serializationConfig{Path: path}, *client)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v.", err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v.", err)
+	}
+	if string(content) != "archive content" {
+		t.Errorf("Unexpected archive content: %s.", content)
+	}
+}
+
+func TestImportSerializationSendsMultipartArchive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/ee/serialization/import" {
+			t.Errorf("Unexpected request: %s %s.", r.Method, r.URL.Path)
+		}
+
+		file, header, err := r.FormFile(serializationImportFileField)
+		if err != nil {
+			t.Fatalf("Unable to read the archive from the multipart body: %v.", err)
+		}
+		defer file.Close()
+
+		content, err := io.ReadAll(file)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v.", err)
+		}
+		if header.Filename != "metabase.tar.gz" || string(content) != "archive content" {
+			t.Errorf("Unexpected archive %s: %s.", header.Filename, content)
+		}
+
+		w.Write([]byte("Imported 3 entities."))
+	}))
+	defer server.Close()
+
+	client, err := metabase.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v.", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "metabase.tar.gz")
+	err = os.WriteFile(path, []byte("archive content"), 0o600)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v.", err)
+	}
+
+	var logs bytes.Buffer
+	err = importSerialization(context.Background(), serializationConfig{Path: path}, *client, &logs)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v.", err)
+	}
+
+	if logs.String() != "Imported 3 entities.\n" {
+		t.Errorf("Expected the import logs to be written, got: %s.", logs.String())
+	}
+}
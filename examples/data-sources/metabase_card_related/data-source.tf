@@ -0,0 +1,7 @@
+data "metabase_card_related" "orders" {
+  card_id = metabase_card.orders.id
+}
+
+output "similar_questions" {
+  value = [for q in data.metabase_card_related.orders.similar_questions : q.name]
+}
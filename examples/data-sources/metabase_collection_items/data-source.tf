@@ -0,0 +1,8 @@
+data "metabase_collection_items" "reports" {
+  collection_id = metabase_collection.reports.id
+  models        = ["dashboard"]
+}
+
+output "report_dashboards" {
+  value = [for i in data.metabase_collection_items.reports.items : i.name]
+}
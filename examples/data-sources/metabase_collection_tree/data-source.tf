@@ -0,0 +1,16 @@
+data "metabase_collection_tree" "all" {}
+
+resource "metabase_permissions_group" "viewers" {
+  name = "Viewers"
+}
+
+# Grants read access to all top-level collections, without listing them one by one.
+resource "metabase_collection_graph" "graph" {
+  permissions = [
+    for c in data.metabase_collection_tree.all.collections : {
+      group      = metabase_permissions_group.viewers.id
+      collection = c.id
+      permission = "read"
+    } if c.parent_id == null
+  ]
+}
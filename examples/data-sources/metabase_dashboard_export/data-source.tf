@@ -0,0 +1,9 @@
+data "metabase_dashboard_export" "sales" {
+  id = 1
+}
+
+# Snapshots the full dashboard definition to a file, e.g. for backup purposes.
+resource "local_file" "sales_backup" {
+  filename = "${path.module}/backups/sales.json"
+  content  = data.metabase_dashboard_export.sales.raw_json
+}
@@ -0,0 +1,8 @@
+data "metabase_database" "warehouse" {
+  name = "Warehouse"
+}
+
+locals {
+  # Native queries can be written differently depending on the SQL dialect.
+  is_bigquery = data.metabase_database.warehouse.engine == "bigquery-cloud-sdk"
+}
@@ -0,0 +1,14 @@
+data "metabase_database_schemas" "warehouse" {
+  database_id = metabase_database.warehouse.id
+}
+
+locals {
+  reporting_schema = "reporting"
+}
+
+check "reporting_schema_exists" {
+  assert {
+    condition     = contains(data.metabase_database_schemas.warehouse.schemas, local.reporting_schema)
+    error_message = "The ${local.reporting_schema} schema has not been synced by Metabase."
+  }
+}
@@ -0,0 +1,23 @@
+data "metabase_table" "table" {
+  db_id  = 2 # Or use `metabase_database.db.id`.
+  name   = "table_name"
+  schema = "schema"
+}
+
+# This finds a single field (column) in a table using its name.
+data "metabase_field" "field" {
+  table_id = data.metabase_table.table.id
+  name     = "column_name"
+}
+
+# The parent table can also be searched directly, without using the table data source.
+data "metabase_field" "other_field" {
+  db_id        = 2
+  table_name   = "table_name"
+  table_schema = "schema"
+  name         = "other_column_name"
+}
+
+output "field_id" {
+  value = data.metabase_field.field.id
+}
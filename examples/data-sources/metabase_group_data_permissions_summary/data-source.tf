@@ -0,0 +1,13 @@
+resource "metabase_permissions_group" "data_analysts" {
+  name = "Data Analysts"
+}
+
+# This lists the database permissions of a single group, as defined in the permissions graph.
+data "metabase_group_data_permissions_summary" "data_analysts" {
+  group_id = metabase_permissions_group.data_analysts.id
+}
+
+# The list of databases to which the group has access to, along with the corresponding permissions.
+output "data_analysts_permissions" {
+  value = data.metabase_group_data_permissions_summary.data_analysts.permissions
+}
@@ -0,0 +1,16 @@
+data "metabase_permissions_groups" "all" {}
+
+resource "metabase_collection" "shared" {
+  name = "Shared"
+}
+
+# Grants read access to the shared collection to all groups except Administrators.
+resource "metabase_collection_graph" "graph" {
+  permissions = [
+    for g in data.metabase_permissions_groups.all.groups : {
+      group      = g.id
+      collection = metabase_collection.shared.id
+      permission = "read"
+    } if g.name != "Administrators"
+  ]
+}
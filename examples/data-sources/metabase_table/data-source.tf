@@ -20,6 +20,11 @@ output "field_id" {
   value = data.metabase_table.table_by_name.fields["column_name"]
 }
 
+# The `field_details` attribute also exposes the types of each field, which can be useful when writing queries.
+output "field_base_type" {
+  value = data.metabase_table.table_by_name.field_details["column_name"].base_type
+}
+
 output "table_name" {
   value = data.metabase_table.table_by_id.name
 }
@@ -5,6 +5,14 @@ provider "metabase" {
   username = "email@address.com"
   password = "password"
 
-  # ...or using an API key.
+  # ...or using an API key...
   # api_key = "API key"
+
+  # ...or using an existing session.
+  # session_token = "session ID"
+
+  # Headers can be added to all requests, e.g. to traverse an authentication proxy in front of Metabase.
+  # extra_headers = {
+  #   "X-Forwarded-Access-Token" = "token"
+  # }
 }
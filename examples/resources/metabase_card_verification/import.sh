@@ -0,0 +1,2 @@
+# Use the integer ID of the card from the Metabase API.
+terraform import metabase_card_verification.some_great_insights 1
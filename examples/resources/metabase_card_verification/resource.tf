@@ -0,0 +1,4 @@
+resource "metabase_card_verification" "some_great_insights" {
+  card_id = metabase_card.some_great_insights.id
+  status  = "verified"
+}
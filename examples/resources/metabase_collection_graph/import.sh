@@ -1,3 +1,3 @@
-# By convention, the revision number of the collection graph should be used, although it does not really matter as it
-# will be read during the import anyway.
-terraform import metabase_collection_graph.graph 1
+# The current revision of the collection graph is used when importing "latest". A specific revision number can also be
+# passed, although it does not really matter as the graph will be read during the import anyway.
+terraform import metabase_collection_graph.graph latest
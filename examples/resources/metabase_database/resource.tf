@@ -22,6 +22,19 @@ resource "metabase_database" "imported" {
   }
 }
 
+resource "metabase_database" "clickhouse" {
+  name = "🏠 ClickHouse"
+
+  clickhouse_details = {
+    host     = "clickhouse.example.com"
+    port     = 8443
+    user     = "metabase"
+    password = "password"
+    dbname   = "analytics"
+    ssl      = true
+  }
+}
+
 # If an engine is not supported by the provider, you can also set a raw configuration that will be passed through to the
 # Metabase API.
 resource "metabase_database" "custom" {
@@ -50,3 +63,9 @@ resource "metabase_database" "custom" {
     ]
   }
 }
+
+# The sample database shipped with Metabase can be renamed without managing its connection.
+resource "metabase_database" "sample" {
+  name         = "🧪 Examples"
+  adopt_sample = true
+}
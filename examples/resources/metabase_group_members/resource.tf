@@ -0,0 +1,4 @@
+resource "metabase_group_members" "data_analysts" {
+  group_id = metabase_permissions_group.data_analysts.id
+  user_ids = [2, 3, 5]
+}
@@ -0,0 +1,2 @@
+# Use the integer IDs of the group and the database, separated by a comma.
+terraform import metabase_impersonation.impersonation 3,1
@@ -0,0 +1,5 @@
+resource "metabase_impersonation" "analysts" {
+  group_id    = metabase_permissions_group.data_analysts.id
+  database_id = metabase_database.postgres.id
+  attribute   = "db_role"
+}
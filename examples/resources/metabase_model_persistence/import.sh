@@ -0,0 +1,2 @@
+# Use the integer ID of the database.
+terraform import metabase_model_persistence.postgres 1
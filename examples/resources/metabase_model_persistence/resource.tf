@@ -0,0 +1,5 @@
+resource "metabase_model_persistence" "postgres" {
+  database_id      = metabase_database.postgres.id
+  refresh_schedule = "0 0 0/6 * * ? *"
+  model_ids        = [metabase_card.revenue_model.id]
+}
@@ -1,3 +1,3 @@
-# By convention, the revision number of the permissions graph should be used, although it does not really matter as it
-# will be read during the import anyway.
-terraform import metabase_permissions_graph.graph 1
+# The current revision of the permissions graph is used when importing "latest". A specific revision number can also be
+# passed, although it does not really matter as the graph will be read during the import anyway.
+terraform import metabase_permissions_graph.graph latest
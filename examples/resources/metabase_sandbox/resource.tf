@@ -0,0 +1,8 @@
+resource "metabase_sandbox" "customers" {
+  group_id = metabase_permissions_group.customers.id
+  table_id = metabase_table.orders.id
+
+  attribute_remappings = {
+    "customer_id" = jsonencode(["dimension", ["field", metabase_table.orders.fields["customer_id"], null]])
+  }
+}
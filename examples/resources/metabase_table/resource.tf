@@ -15,6 +15,31 @@ resource "metabase_table" "table_by_name" {
     column_1 = null            # "No semantic type".
     column_2 = "type/Category" # "Category".
   }
+
+  # The formatting settings of each field in this map are merged into the existing settings of the field.
+  field_formatting = {
+    column_3 = jsonencode({
+      number_style = "currency"
+      currency     = "EUR"
+    })
+  }
+
+  # Displays the name of the customer instead of its ID, using the field referenced by the foreign key.
+  field_dimensions = {
+    customer_id = {
+      type                    = "external"
+      name                    = "Customer"
+      human_readable_field_id = 42 # Or use `metabase_table.customers.fields["name"]`.
+    }
+  }
+
+  # Displays custom labels instead of the raw values of the field. This requires an `internal` dimension.
+  field_value_labels = {
+    status = {
+      "0" = "Inactive"
+      "1" = "Active"
+    }
+  }
 }
 
 # Although less useful, a table can be imported by its ID if it's already known.
@@ -6,13 +6,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"strings"
 	"text/template"
 
 	"github.com/flovouin/terraform-provider-metabase/metabase"
 )
 
 // The template producing a `metabase_card` Terraform resource definition.
-const cardTemplate = `resource "metabase_card" "{{.TerraformSlug}}" {
+const cardTemplate = `{{if .Warning}}# WARNING: {{.Warning}}
+{{end}}resource "metabase_card" "{{.TerraformSlug}}" {
   json = jsonencode({{.Json}})
 }
 `
@@ -21,9 +24,26 @@ const cardTemplate = `resource "metabase_card" "{{.TerraformSlug}}" {
 type cardTemplateData struct {
 	TerraformSlug string // The slug used as the name of the Terraform resource.
 	Json          string // The content of the card, as a JSON string.
+	Warning       string // An optional warning, written as a comment above the resource.
 }
 
-// Replaces table integer IDs by references to Terraform `metabase_table` data sources.
+// An error returned when a reference in a card cannot be mapped to a Terraform resource, e.g. because the referenced
+// database is not part of the configuration, or because the query uses a structure the importer does not support.
+// Only this error causes a card to be imported as raw JSON. Any other error (e.g. from the Metabase API) fails the import.
+type unmappedReferenceError struct {
+	message string
+}
+
+func (e *unmappedReferenceError) Error() string {
+	return e.message
+}
+
+// Creates an `unmappedReferenceError` with a formatted message.
+func newUnmappedReferenceError(format string, a ...interface{}) error {
+	return &unmappedReferenceError{message: fmt.Sprintf(format, a...)}
+}
+
+// Replaces table integer IDs by references to Terraform `metabase_table` resources.
 // A card may contain `source-table` attributes with a value which is a (integer) table ID.
 // For each of those attributes, the table is looked up, imported, and referenced by replacing the value with an
 // `importedTable`.
@@ -34,12 +54,12 @@ func (ic *ImportContext) insertCardTableReferenceRecursively(ctx context.Context
 			if k == metabase.SourceTableAttribute {
 				tableIdFloat, ok := i.(float64)
 				if !ok {
-					return errors.New("failed to unmarshal \"source-table\" field to float")
+					return newUnmappedReferenceError("failed to unmarshal \"source-table\" field to float")
 				}
 
 				importedTable, err := ic.importTable(ctx, int(tableIdFloat))
 				if err != nil {
-					return nil
+					return err
 				}
 
 				typedObj[k] = importedTable
@@ -48,7 +68,7 @@ func (ic *ImportContext) insertCardTableReferenceRecursively(ctx context.Context
 
 			err := ic.insertCardTableReferenceRecursively(ctx, i)
 			if err != nil {
-				return nil
+				return err
 			}
 		}
 
@@ -72,22 +92,22 @@ func (ic *ImportContext) insertCardTableReferenceRecursively(ctx context.Context
 func (ic *ImportContext) insertCardDatabaseReference(ctx context.Context, card map[string]interface{}) error {
 	queryAny, ok := card[metabase.DatasetQueryAttribute]
 	if !ok {
-		return errors.New("unable to find database_query field in card")
+		return newUnmappedReferenceError("unable to find database_query field in card")
 	}
 
 	queryMap, ok := queryAny.(map[string]interface{})
 	if !ok {
-		return errors.New("unable to unmarshal database_query field as map")
+		return newUnmappedReferenceError("unable to unmarshal database_query field as map")
 	}
 
 	databaseAny, ok := queryMap[metabase.DatabaseAttribute]
 	if !ok {
-		return errors.New("unable to find database field in database_query map")
+		return newUnmappedReferenceError("unable to find database field in database_query map")
 	}
 
 	databaseId, ok := databaseAny.(float64)
 	if !ok {
-		return errors.New("unable to unmarshal database field as number")
+		return newUnmappedReferenceError("unable to unmarshal database field as number")
 	}
 
 	database, err := ic.getDatabase(int(databaseId))
@@ -111,7 +131,7 @@ func (ic *ImportContext) insertFieldReferenceInCardColumnSettings(ctx context.Co
 
 	visualizationSettings, ok := visualizationSettingsAny.(map[string]interface{})
 	if !ok {
-		return errors.New("unable to unmarshal visualization_settings to a JSON object")
+		return newUnmappedReferenceError("unable to unmarshal visualization_settings to a JSON object")
 	}
 
 	columnSettingsAny, ok := visualizationSettings[metabase.ColumnSettingsAttribute]
@@ -121,7 +141,7 @@ func (ic *ImportContext) insertFieldReferenceInCardColumnSettings(ctx context.Co
 
 	columnSettings, ok := columnSettingsAny.(map[string]interface{})
 	if !ok {
-		return errors.New("unable to unmarshal column_settings to a JSON object")
+		return newUnmappedReferenceError("unable to unmarshal column_settings to a JSON object")
 	}
 
 	// The references converted to `importedField`s will be added after iterating over the column settings, to avoid
@@ -148,15 +168,15 @@ func (ic *ImportContext) insertFieldReferenceInCardColumnSettings(ctx context.Co
 
 		inserted, err := ic.tryInsertFieldReference(ctx, fieldArrayElement)
 		if err != nil {
-			return nil
+			return err
 		}
 
 		if inserted {
 			// The replaced reference is marshalled back into JSON. `replacePlaceholders` will take care of ensuring the
-			// Terraform data source is correctly referenced, even inside a string (there is a dedicated regexp for that).
+			// Terraform resource is correctly referenced, even inside a string (there is a dedicated regexp for that).
 			newKey, err := json.Marshal(keyArray)
 			if err != nil {
-				return nil
+				return err
 			}
 
 			entriesToAdd[string(newKey)] = v
@@ -175,7 +195,7 @@ func (ic *ImportContext) insertFieldReferenceInCardColumnSettings(ctx context.Co
 func (ic *ImportContext) insertCardCollectionReference(ctx context.Context, card map[string]interface{}) error {
 	collectionIdAny, ok := card[metabase.CollectionIdAttribute]
 	if !ok {
-		return errors.New("unable to find collection_id field in card")
+		return newUnmappedReferenceError("unable to find collection_id field in card")
 	}
 
 	if collectionIdAny == nil {
@@ -186,10 +206,10 @@ func (ic *ImportContext) insertCardCollectionReference(ctx context.Context, card
 	// collection, the `collection_id` will simply be `null`.
 	collectionId, ok := collectionIdAny.(float64)
 	if !ok {
-		return errors.New("unable to unmarshal collection_id field as number")
+		return newUnmappedReferenceError("unable to unmarshal collection_id field as number")
 	}
 
-	collection, err := ic.getCollection(fmt.Sprint(collectionId))
+	collection, err := ic.importCollection(ctx, fmt.Sprint(collectionId))
 	if err != nil {
 		return err
 	}
@@ -199,9 +219,8 @@ func (ic *ImportContext) insertCardCollectionReference(ctx context.Context, card
 	return nil
 }
 
-// Converts a raw JSON card to its HCL representation, including references to other Terraform resources and data
-// sources. Only known attributes are kept.
-func (ic *ImportContext) makeCardJson(ctx context.Context, card []byte) (*string, error) {
+// Unmarshals a raw JSON card, only keeping the attributes defining the card.
+func unmarshalDefiningCardAttributes(card []byte) (map[string]interface{}, error) {
 	var cardMap map[string]interface{}
 	err := json.Unmarshal(card, &cardMap)
 	if err != nil {
@@ -209,9 +228,49 @@ func (ic *ImportContext) makeCardJson(ctx context.Context, card []byte) (*string
 	}
 
 	for key := range cardMap {
-		if !metabase.DefiningCardAttributes[key] {
-			delete(cardMap, key)
+		if metabase.DefiningCardAttributes[key] {
+			continue
 		}
+
+		// Models should not be imported as plain questions. The attributes flagging them are only kept for cards which
+		// are not questions, such that the definition of questions remains minimal.
+		if key == metabase.CardTypeAttribute && cardMap[key] != nil && cardMap[key] != metabase.CardTypeQuestion {
+			continue
+		}
+		if key == metabase.CardIsDatasetAttribute && cardMap[key] == true {
+			continue
+		}
+
+		delete(cardMap, key)
+	}
+
+	return cardMap, nil
+}
+
+// Converts a raw JSON card to its HCL representation, without replacing any ID by a reference.
+// This is used as a fallback when the card cannot be fully processed.
+func makeRawCardJson(card []byte) (*string, error) {
+	cardMap, err := unmarshalDefiningCardAttributes(card)
+	if err != nil {
+		return nil, err
+	}
+
+	cardJson, err := json.MarshalIndent(cardMap, "  ", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	hcl := string(cardJson)
+
+	return &hcl, nil
+}
+
+// Converts a raw JSON card to its HCL representation, including references to other Terraform resources and data
+// sources. Only known attributes are kept.
+func (ic *ImportContext) makeCardJson(ctx context.Context, card []byte) (*string, error) {
+	cardMap, err := unmarshalDefiningCardAttributes(card)
+	if err != nil {
+		return nil, err
 	}
 
 	err = ic.insertCardDatabaseReference(ctx, cardMap)
@@ -250,21 +309,35 @@ func (ic *ImportContext) makeCardJson(ctx context.Context, card []byte) (*string
 }
 
 // Produces the Terraform definition for a `metabase_card` resource.
+// If a reference in the card cannot be mapped to a Terraform resource (e.g. because the query type is not supported),
+// the raw JSON definition is used instead, and a warning is written alongside the resource. Other errors are returned.
 func (ic *ImportContext) makeCardHcl(ctx context.Context, card []byte, slug string) (*string, error) {
 	tpl, err := template.New("card").Parse(cardTemplate)
 	if err != nil {
 		return nil, err
 	}
 
+	warning := ""
 	cardJson, err := ic.makeCardJson(ctx, card)
-	if err != nil {
+	var unmappedErr *unmappedReferenceError
+	if err != nil && !errors.As(err, &unmappedErr) {
 		return nil, err
 	}
+	if err != nil {
+		warning = fmt.Sprintf("This card could not be fully processed by the importer (%s). IDs have not been replaced by references to other resources and may need to be updated manually.", strings.ReplaceAll(err.Error(), "\n", " "))
+		fmt.Fprintf(os.Stderr, "card %s could not be fully processed and is imported as raw JSON: %s\n", slug, err.Error())
+
+		cardJson, err = makeRawCardJson(card)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	buf := new(bytes.Buffer)
 	err = tpl.Execute(buf, cardTemplateData{
 		TerraformSlug: slug,
 		Json:          *cardJson,
+		Warning:       warning,
 	})
 	if err != nil {
 		return nil, err
@@ -275,9 +348,14 @@ func (ic *ImportContext) makeCardHcl(ctx context.Context, card []byte, slug stri
 	return &hcl, nil
 }
 
+// The error returned when importing a card that has been archived. Such cards are not imported, as the Terraform
+// resource would unarchive them when applied.
+var errArchivedCard = errors.New("the card is archived")
+
 // Fetches a card from the Metabase API and produces the corresponding Terraform definition.
+// `errArchivedCard` is returned if the card has been archived.
 func (ic *ImportContext) importCard(ctx context.Context, cardId int) (*importedCard, error) {
-	card, ok := ic.cards[cardId]
+	card, ok := lockedGet(ic.mu, ic.cards, cardId)
 	if ok {
 		return &card, nil
 	}
@@ -289,8 +367,11 @@ func (ic *ImportContext) importCard(ctx context.Context, cardId int) (*importedC
 	if getResp.JSON200 == nil {
 		return nil, errors.New("received unexpected response when getting card")
 	}
+	if getResp.JSON200.Archived {
+		return nil, fmt.Errorf("unable to import card %d (%s): %w", cardId, getResp.JSON200.Name, errArchivedCard)
+	}
 
-	slug := makeUniqueSlug(getResp.JSON200.Name, ic.cardsSlugs)
+	slug := ic.makeUniqueSlug(getResp.JSON200.Name, ic.cardsSlugs)
 
 	hcl, err := ic.makeCardHcl(ctx, getResp.Body, slug)
 	if err != nil {
@@ -303,7 +384,13 @@ func (ic *ImportContext) importCard(ctx context.Context, cardId int) (*importedC
 		Hcl:  *hcl,
 	}
 
-	ic.cards[cardId] = card
+	card = lockedLoadOrStore(ic.mu, ic.cards, cardId, card)
 
 	return &card, nil
 }
+
+// Fetches a card from the Metabase API and produces the corresponding Terraform definition. This allows importing cards
+// which are not part of any imported dashboard.
+func (ic *ImportContext) ImportCard(ctx context.Context, cardId int) (*importedCard, error) {
+	return ic.importCard(ctx, cardId)
+}
@@ -0,0 +1,272 @@
+package importer
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+)
+
+// The response returned by the test server for a table that can be successfully imported.
+const testTableMetadataResponse = `{
+  "id": 5,
+  "db_id": 1,
+  "name": "orders",
+  "display_name": "Orders",
+  "entity_type": "entity/GenericTable",
+  "schema": "public",
+  "description": null,
+  "fields": []
+}`
+
+// Makes a card query referencing the given table, in the database with ID 1.
+func makeTestCard(extraAttributes string) []byte {
+	return []byte(`{
+  "id": 10,
+  "name": "Orders",
+  "collection_id": null,
+  "dataset_query": {
+    "database": 1,
+    "type": "query",
+    "query": { "source-table": 5 }
+  }` + extraAttributes + `
+}`)
+}
+
+// Creates an import context for which the database with ID 1 has been registered.
+func newTestImportContextWithDatabase(t *testing.T, handler http.Handler) ImportContext {
+	ic := newTestImportContext(t, handler)
+	ic.databases[1] = importedDatabase{Slug: "db"}
+	return ic
+}
+
+func TestMakeCardJsonReferencesTable(t *testing.T) {
+	ic := newTestImportContextWithDatabase(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/table/5/query_metadata" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(testTableMetadataResponse))
+	}))
+
+	cardJson, err := ic.makeCardJson(context.Background(), makeTestCard(""))
+	if err != nil {
+		t.Fatalf("Unexpected error when making card JSON: %s", err)
+	}
+
+	if !strings.Contains(*cardJson, "metabase_table.public_orders.id") {
+		t.Errorf("Expected card to reference the imported table, got: %s", *cardJson)
+	}
+	if !strings.Contains(*cardJson, "metabase_database.db.id") {
+		t.Errorf("Expected card to reference the database, got: %s", *cardJson)
+	}
+}
+
+func TestMakeCardJsonReferencesJoinedTablesAndFields(t *testing.T) {
+	responses := map[string]string{
+		"/table/5/query_metadata": testTableMetadataResponse,
+		"/table/6/query_metadata": `{"id": 6, "db_id": 1, "name": "products", "display_name": "Products", "entity_type": "entity/GenericTable", "schema": "public", "description": null, "fields": []}`,
+		"/field/7":                `{"id": 7, "name": "product_id", "display_name": "Product ID", "table_id": 5, "semantic_type": "type/FK", "description": null}`,
+		"/field/8":                `{"id": 8, "name": "id", "display_name": "ID", "table_id": 6, "semantic_type": "type/PK", "description": null}`,
+		"/field/9":                `{"id": 9, "name": "category", "display_name": "Category", "table_id": 6, "semantic_type": "type/Category", "description": null}`,
+	}
+	ic := newTestImportContextWithDatabase(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+
+	card := []byte(`{
+  "id": 10,
+  "name": "Orders by category",
+  "collection_id": null,
+  "dataset_query": {
+    "database": 1,
+    "type": "query",
+    "query": {
+      "source-table": 5,
+      "joins": [
+        {
+          "alias": "Products",
+          "source-table": 6,
+          "condition": ["=", ["field", 7, null], ["field", 8, { "join-alias": "Products" }]],
+          "fields": [["field", 9, { "join-alias": "Products" }]]
+        }
+      ],
+      "breakout": [["field", 9, { "source-field": 7 }]]
+    }
+  }
+}`)
+
+	cardJson, err := ic.makeCardJson(context.Background(), card)
+	if err != nil {
+		t.Fatalf("Unexpected error when making card JSON: %s", err)
+	}
+
+	for _, expected := range []string{
+		`"source-table": metabase_table.public_products.id`,
+		`metabase_table.public_orders.fields["product_id"],`,
+		`metabase_table.public_products.fields["id"],`,
+		`metabase_table.public_products.fields["category"],`,
+		`"source-field": metabase_table.public_orders.fields["product_id"]`,
+	} {
+		if !strings.Contains(*cardJson, expected) {
+			t.Errorf("Expected card to contain %s, got: %s", expected, *cardJson)
+		}
+	}
+	if regexp.MustCompile(`\b[6-9]\b`).MatchString(*cardJson) {
+		t.Errorf("Expected no raw table or field ID to be left in the card, got: %s", *cardJson)
+	}
+}
+
+func TestMakeCardJsonFailsWhenTableImportFails(t *testing.T) {
+	ic := newTestImportContextWithDatabase(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+
+	cardJson, err := ic.makeCardJson(context.Background(), makeTestCard(""))
+	if err == nil {
+		t.Fatalf("Expected an error when the table cannot be imported, got: %s", *cardJson)
+	}
+}
+
+func TestMakeCardJsonFailsWhenColumnSettingsFieldImportFails(t *testing.T) {
+	ic := newTestImportContextWithDatabase(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/table/5/query_metadata" {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(testTableMetadataResponse))
+			return
+		}
+
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+
+	card := makeTestCard(`,
+  "visualization_settings": {
+    "column_settings": {
+      "[\"ref\",[\"field\",7,null]]": { "column_title": "Total" }
+    }
+  }`)
+
+	cardJson, err := ic.makeCardJson(context.Background(), card)
+	if err == nil {
+		t.Fatalf("Expected an error when the field cannot be imported, got: %s", *cardJson)
+	}
+}
+
+func TestMakeCardHclFallsBackToRawJson(t *testing.T) {
+	ic := newTestImportContextWithDatabase(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request: %s %s.", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+
+	// Questions based on other questions reference them using a string, which the importer does not support.
+	card := []byte(`{
+  "id": 10,
+  "name": "Orders",
+  "collection_id": null,
+  "dataset_query": {
+    "database": 1,
+    "type": "query",
+    "query": { "source-table": "card__12" }
+  }
+}`)
+
+	hcl, err := ic.makeCardHcl(context.Background(), card, "orders")
+	if err != nil {
+		t.Fatalf("Unexpected error when making card HCL: %s", err)
+	}
+
+	if !strings.HasPrefix(*hcl, "# WARNING:") {
+		t.Errorf("Expected a warning comment in the card HCL, got: %s", *hcl)
+	}
+	if !strings.Contains(*hcl, `"source-table": "card__12"`) {
+		t.Errorf("Expected the raw source table to be kept in the card HCL, got: %s", *hcl)
+	}
+}
+
+func TestMakeCardHclFailsOnApiError(t *testing.T) {
+	ic := newTestImportContextWithDatabase(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+
+	hcl, err := ic.makeCardHcl(context.Background(), makeTestCard(""), "orders")
+	if err == nil {
+		t.Fatalf("Expected an error when the table cannot be fetched, got: %s", *hcl)
+	}
+}
+
+func TestMakeCardHclFallsBackToRawJsonForUnmappedDatabase(t *testing.T) {
+	ic := newTestImportContext(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request: %s %s.", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+
+	hcl, err := ic.makeCardHcl(context.Background(), makeTestCard(""), "orders")
+	if err != nil {
+		t.Fatalf("Unexpected error when making card HCL: %s", err)
+	}
+
+	if !strings.HasPrefix(*hcl, "# WARNING:") || !strings.Contains(*hcl, `"source-table": 5`) {
+		t.Errorf("Expected the card to be imported as raw JSON with a warning, got: %s", *hcl)
+	}
+}
+
+func TestUnmarshalDefiningCardAttributesKeepsModelType(t *testing.T) {
+	model, err := unmarshalDefiningCardAttributes(makeTestCard(`, "type": "model", "dataset": true`))
+	if err != nil {
+		t.Fatalf("Unexpected error when unmarshalling card: %s", err)
+	}
+	if model["type"] != "model" || model["dataset"] != true {
+		t.Errorf("Expected the model type to be kept, got: %v", model)
+	}
+
+	question, err := unmarshalDefiningCardAttributes(makeTestCard(`, "type": "question", "dataset": false`))
+	if err != nil {
+		t.Fatalf("Unexpected error when unmarshalling card: %s", err)
+	}
+	if _, ok := question["type"]; ok {
+		t.Errorf("Expected the question type to be removed, got: %v", question)
+	}
+	if _, ok := question["dataset"]; ok {
+		t.Errorf("Expected the dataset flag to be removed, got: %v", question)
+	}
+}
+
+func TestMakeDashboardCardsHclSkipsArchivedCards(t *testing.T) {
+	ic := newTestImportContextWithDatabase(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/card/10" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(makeTestCard(`, "archived": true`))
+	}))
+
+	archivedCardId := 10
+	cardsHcl, err := ic.makeDashboardCardsHcl(context.Background(), []metabase.DashboardCard{
+		{Id: 1, CardId: &archivedCardId},
+		{Id: 2, CardId: nil, SizeX: 4},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error when making dashboard cards HCL: %s", err)
+	}
+
+	if strings.Contains(*cardsHcl, "metabase_card") || !strings.Contains(*cardsHcl, `"size_x": 4`) {
+		t.Errorf("Expected only the card without a reference to be kept, got: %s", *cardsHcl)
+	}
+	if len(ic.cards) != 0 {
+		t.Errorf("Expected the archived card not to be imported, got: %v", ic.cards)
+	}
+}
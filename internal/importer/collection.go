@@ -1,9 +1,13 @@
 package importer
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
+	"text/template"
 
 	"github.com/flovouin/terraform-provider-metabase/metabase"
 )
@@ -16,20 +20,155 @@ type ExistingCollectionDefinition struct {
 	ResourceName string  // The name of the manually defined Terraform resource.
 }
 
+// The template producing a `metabase_collection` Terraform resource definition.
+const collectionTemplate = `resource "metabase_collection" "{{.TerraformSlug}}" {
+  name        = {{.Name}}
+  description = {{if .Description}}{{.Description}}{{else}}null{{end}}
+  parent_id   = {{if .ParentRef}}tonumber(metabase_collection.{{.ParentRef}}.id){{else}}null{{end}}
+}
+`
+
+// The data required to produce a `metabase_collection` Terraform resource definition.
+type collectionTemplateData struct {
+	TerraformSlug string  // The slug used as the name of the Terraform resource.
+	Name          string  // The name of the collection.
+	Description   *string // The description of the collection.
+	ParentRef     *string // The reference to the parent collection, or `nil` for a top-level collection.
+}
+
+// Returns the ID of the parent collection from the `location` of a collection, e.g. `12` for `/3/12/`.
+// `nil` is returned for top-level collections, which have a location of `/`.
+func getParentCollectionId(location *string) *string {
+	if location == nil {
+		return nil
+	}
+
+	ancestors := strings.Split(strings.Trim(*location, "/"), "/")
+	parentId := ancestors[len(ancestors)-1]
+	if len(parentId) == 0 {
+		return nil
+	}
+
+	return &parentId
+}
+
+// Produces the Terraform definition for a `metabase_collection` resource.
+func (ic *ImportContext) makeCollectionHcl(ctx context.Context, collection metabase.Collection, slug string) (*string, error) {
+	tpl, err := template.New("collection").Parse(collectionTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	// The parent is imported first, such that the collection can reference it.
+	var parentRef *string
+	parentId := getParentCollectionId(collection.Location)
+	if parentId != nil {
+		parent, err := ic.importCollection(ctx, *parentId)
+		if err != nil {
+			return nil, err
+		}
+
+		parentRef = &parent.Slug
+	}
+
+	// Converting strings to JSON ensures special characters are escaped.
+	name, err := json.Marshal(collection.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var description *string
+	if collection.Description != nil {
+		descriptionBytes, err := json.Marshal(*collection.Description)
+		if err != nil {
+			return nil, err
+		}
+
+		descriptionStr := string(descriptionBytes)
+		description = &descriptionStr
+	}
+
+	buf := new(bytes.Buffer)
+	err = tpl.Execute(buf, collectionTemplateData{
+		TerraformSlug: slug,
+		Name:          string(name),
+		Description:   description,
+		ParentRef:     parentRef,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hcl := buf.String()
+
+	return &hcl, nil
+}
+
 // Retrieves an imported collection given its ID.
-func (ic *ImportContext) getCollection(collectionId string) (*importedCollection, error) {
-	col, ok := ic.collections[collectionId]
-	if !ok {
-		return nil, fmt.Errorf("collection %s has not been defined in the importer configuration", collectionId)
+// If the collection has not been defined in the importer configuration, it is fetched from the Metabase API and
+// imported along with its parents when collections import is enabled. Otherwise an error describing the missing
+// collection is returned.
+func (ic *ImportContext) importCollection(ctx context.Context, collectionId string) (*importedCollection, error) {
+	col, ok := lockedGet(ic.mu, ic.collections, collectionId)
+	if ok {
+		return &col, nil
+	}
+
+	getResp, err := ic.client.GetCollectionWithResponse(ctx, collectionId)
+	if err != nil {
+		return nil, err
+	}
+	if getResp.JSON200 == nil {
+		return nil, fmt.Errorf("collection %s has not been defined in the importer configuration and could not be fetched from the Metabase API", collectionId)
+	}
+
+	collection := *getResp.JSON200
+
+	// Special collections (e.g. instance analytics) are managed by Metabase itself, and cannot be managed by the
+	// collection resource.
+	if collection.Type != nil {
+		return nil, newUnmappedReferenceError("collection %s (%q) is a special collection of type %s managed by Metabase, and cannot be imported", collectionId, collection.Name, *collection.Type)
+	}
+
+	if !ic.importCollections {
+		location := "/"
+		if collection.Location != nil {
+			location = *collection.Location
+		}
+
+		return nil, newUnmappedReferenceError("collection %s (%q, at location %s) has not been defined in the importer configuration, either add it to the collections mapping or enable collections import", collectionId, collection.Name, location)
 	}
 
+	slug := ic.makeUniqueSlug(collection.Name, ic.collectionsSlugs)
+
+	hcl, err := ic.makeCollectionHcl(ctx, collection, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	col = importedCollection{
+		Collection: collection,
+		Slug:       slug,
+		Hcl:        *hcl,
+	}
+	col = lockedLoadOrStore(ic.mu, ic.collections, collectionId, col)
+
 	return &col, nil
 }
 
+// Fetches a collection from the Metabase API and produces the corresponding Terraform definition, unless the collection
+// has already been defined in the importer configuration. Collections import should be enabled.
+func (ic *ImportContext) ImportCollection(ctx context.Context, collectionId string) (*importedCollection, error) {
+	return ic.importCollection(ctx, collectionId)
+}
+
 // Imports existing collections already defined manually in Terraform, such that they can be referenced by automatically
 // generated Metabase resource.
-// A collection imported using its ID will be an exact match. A collection can also be looked up using its name.
-func (ic *ImportContext) ImportCollectionsFromDefinitions(ctx context.Context, existingCollections []ExistingCollectionDefinition) error {
+// A collection imported using its ID will be an exact match. A collection can also be looked up using its name, in which
+// case archived collections are ignored unless `includeArchived` is `true`. This avoids an archived collection shadowing
+// a live one with the same name. Special collections managed by Metabase (e.g. instance analytics) are always ignored
+// when looking up a collection by name.
+func (ic *ImportContext) ImportCollectionsFromDefinitions(ctx context.Context, existingCollections []ExistingCollectionDefinition, includeArchived bool) error {
 	var collectionList *[]metabase.Collection
 
 	for _, existingCollection := range existingCollections {
@@ -65,6 +204,14 @@ func (ic *ImportContext) ImportCollectionsFromDefinitions(ctx context.Context, e
 			}
 
 			for _, col := range *collectionList {
+				if !includeArchived && col.Archived != nil && *col.Archived {
+					continue
+				}
+
+				if col.Type != nil {
+					continue
+				}
+
 				if col.Name == *existingCollection.Name {
 					collection = &col
 					break
@@ -86,15 +233,16 @@ func (ic *ImportContext) ImportCollectionsFromDefinitions(ctx context.Context, e
 			collectionId = fmt.Sprint(idInt)
 		}
 
-		_, exists := ic.collections[collectionId]
+		_, exists := lockedGet(ic.mu, ic.collections, collectionId)
 		if exists {
 			return fmt.Errorf("collection %s has already been imported", collectionId)
 		}
 
-		ic.collections[collectionId] = importedCollection{
+		lockedSet(ic.mu, ic.collectionsSlugs, existingCollection.ResourceName, true)
+		lockedSet(ic.mu, ic.collections, collectionId, importedCollection{
 			Collection: *collection,
 			Slug:       existingCollection.ResourceName,
-		}
+		})
 	}
 
 	return nil
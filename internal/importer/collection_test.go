@@ -0,0 +1,102 @@
+package importer
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Serves a top-level collection with ID 3, and its sub-collection with ID 12. The collection with ID 20 is the special
+// instance analytics collection.
+var testCollectionsHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	switch r.URL.Path {
+	case "/collection/3":
+		w.Write([]byte(`{ "id": 3, "name": "Team", "location": "/" }`))
+	case "/collection/12":
+		w.Write([]byte(`{ "id": 12, "name": "Reports", "description": "Weekly reports", "location": "/3/" }`))
+	case "/collection/20":
+		w.Write([]byte(`{ "id": 20, "name": "Metabase analytics", "location": "/", "type": "instance-analytics" }`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+})
+
+func TestGetParentCollectionId(t *testing.T) {
+	topLevel := "/"
+	if parentId := getParentCollectionId(&topLevel); parentId != nil {
+		t.Errorf("Expected no parent for a top-level collection, got: %s", *parentId)
+	}
+
+	nested := "/3/12/"
+	parentId := getParentCollectionId(&nested)
+	if parentId == nil || *parentId != "12" {
+		t.Errorf("Expected parent 12 for a nested collection, got: %v", parentId)
+	}
+}
+
+func TestImportCollectionFailsWithMissingCollectionDetails(t *testing.T) {
+	ic := newTestImportContext(t, testCollectionsHandler)
+
+	_, err := ic.importCollection(context.Background(), "12")
+	if err == nil {
+		t.Fatalf("Expected an error when the collection has not been defined")
+	}
+
+	if !strings.Contains(err.Error(), "collection 12") || !strings.Contains(err.Error(), "Reports") {
+		t.Errorf("Expected error to describe the missing collection, got: %s", err)
+	}
+}
+
+func TestImportCollectionImportsParents(t *testing.T) {
+	ic := newTestImportContext(t, testCollectionsHandler)
+	ic.EnableCollectionsImport()
+
+	collection, err := ic.importCollection(context.Background(), "12")
+	if err != nil {
+		t.Fatalf("Unexpected error when importing collection: %s", err)
+	}
+
+	if !strings.Contains(collection.Hcl, "parent_id   = tonumber(metabase_collection.team.id)") {
+		t.Errorf("Expected collection to reference its parent, got: %s", collection.Hcl)
+	}
+
+	parent, ok := ic.collections["3"]
+	if !ok {
+		t.Fatalf("Expected parent collection to be imported")
+	}
+	if !strings.Contains(parent.Hcl, "parent_id   = null") {
+		t.Errorf("Expected top-level collection to have no parent, got: %s", parent.Hcl)
+	}
+}
+
+func TestImportCollectionReferencesDefinedParent(t *testing.T) {
+	ic := newTestImportContext(t, testCollectionsHandler)
+	ic.EnableCollectionsImport()
+	ic.collections["3"] = importedCollection{Slug: "existing"}
+
+	collection, err := ic.importCollection(context.Background(), "12")
+	if err != nil {
+		t.Fatalf("Unexpected error when importing collection: %s", err)
+	}
+
+	if !strings.Contains(collection.Hcl, "metabase_collection.existing.id") {
+		t.Errorf("Expected collection to reference the defined parent, got: %s", collection.Hcl)
+	}
+}
+
+func TestImportCollectionFailsWithSpecialCollection(t *testing.T) {
+	ic := newTestImportContext(t, testCollectionsHandler)
+	ic.EnableCollectionsImport()
+
+	_, err := ic.importCollection(context.Background(), "20")
+	if err == nil {
+		t.Fatalf("Expected an error when importing a special collection")
+	}
+
+	if !strings.Contains(err.Error(), "instance-analytics") {
+		t.Errorf("Expected error to mention the collection type, got: %s", err)
+	}
+}
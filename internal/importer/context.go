@@ -1,6 +1,8 @@
 package importer
 
 import (
+	"sync"
+
 	"github.com/flovouin/terraform-provider-metabase/metabase"
 )
 
@@ -11,10 +13,10 @@ type importedCard struct {
 	Hcl  string        // The HCL definition for the card.
 }
 
-// A table imported from the Metabase API and converted to HCL (as a data source).
+// A table imported from the Metabase API and converted to HCL (as a resource).
 type importedTable struct {
 	Table metabase.TableMetadata // The table, as returned by the Metabase API.
-	Slug  string                 // A slug attributed to the table, used as the name of the Terraform data source.
+	Slug  string                 // A slug attributed to the table, used as the name of the Terraform resource.
 	Hcl   string                 // The HCL definition for the table.
 }
 
@@ -26,7 +28,7 @@ type importedDashboard struct {
 }
 
 // A field imported from the Metabase API.
-// A field is exposed in Terraform through the parent table data source.
+// A field is exposed in Terraform through the parent table resource.
 type importedField struct {
 	Field       metabase.Field // The field, as returned by the Metabase API.
 	ParentTable *importedTable // The table containing the field.
@@ -40,38 +42,108 @@ type importedDatabase struct {
 }
 
 // A collection available as a reference for other Terraform resources.
-// It is not automatically imported, but defined as an input to the importer.
+// It is either defined as an input to the importer, or automatically imported when collections import is enabled.
 type importedCollection struct {
 	Collection metabase.Collection // The collection, as returned by the Metabase API.
 	Slug       string              // A slug attributed to the collection, used as the name of the Terraform resource.
+	Hcl        string              // The HCL definition for the collection. Empty if the collection is defined manually.
 }
 
 // A context that can be created to import one or several dashboards from a Metabase API.
+// The maps caching imported objects and assigned slugs are guarded by a mutex, such that lookups can be performed
+// concurrently.
 type ImportContext struct {
-	client          metabase.ClientWithResponses  // The client to use to perform calls to the API.
-	cards           map[int]importedCard          // The cards imported from the API.
-	tables          map[int]importedTable         // The tables imported from the API.
-	fields          map[int]importedField         // The fields imported from the API.
-	dashboards      map[int]importedDashboard     // The dashboards imported from the API.
-	databases       map[int]importedDatabase      // The databases available to other Terraform resources.
-	collections     map[string]importedCollection // The collections available to other Terraform resources.
-	cardsSlugs      map[string]bool               // The slugs that have been assigned to cards, for which uniqueness should be guaranteed.
-	tablesSlugs     map[string]bool               // The slugs that have been assigned to tables, for which uniqueness should be guaranteed.
-	dashboardsSlugs map[string]bool               // The slugs that have been assigned to dashboards, for which uniqueness should be guaranteed.
+	client            metabase.ClientWithResponses  // The client to use to perform calls to the API.
+	mu                *sync.Mutex                   // Guards the maps below. Shared by copies of the context, like the maps themselves.
+	cards             map[int]importedCard          // The cards imported from the API.
+	tables            map[int]importedTable         // The tables imported from the API.
+	fields            map[int]importedField         // The fields imported from the API.
+	dashboards        map[int]importedDashboard     // The dashboards imported from the API.
+	databases         map[int]importedDatabase      // The databases available to other Terraform resources.
+	collections       map[string]importedCollection // The collections available to other Terraform resources.
+	cardsSlugs        map[string]bool               // The slugs that have been assigned to cards, for which uniqueness should be guaranteed.
+	tablesSlugs       map[string]bool               // The slugs that have been assigned to tables, for which uniqueness should be guaranteed.
+	dashboardsSlugs   map[string]bool               // The slugs that have been assigned to dashboards, for which uniqueness should be guaranteed.
+	prefetchedFields  map[int]metabase.Field        // Fields fetched in bulk from the API, which have not necessarily been imported.
+	collectionsSlugs  map[string]bool               // The slugs that have been assigned to collections, for which uniqueness should be guaranteed.
+	importCollections bool                          // Whether collections which have not been defined as inputs should be imported automatically.
 }
 
 // Creates a new import context that will use the given Metabase client.
 func NewImportContext(client metabase.ClientWithResponses) ImportContext {
 	return ImportContext{
-		client:          client,
-		cards:           make(map[int]importedCard),
-		tables:          make(map[int]importedTable),
-		fields:          make(map[int]importedField),
-		dashboards:      make(map[int]importedDashboard),
-		databases:       make(map[int]importedDatabase),
-		collections:     make(map[string]importedCollection),
-		cardsSlugs:      make(map[string]bool),
-		tablesSlugs:     make(map[string]bool),
-		dashboardsSlugs: make(map[string]bool),
+		client:           client,
+		mu:               &sync.Mutex{},
+		cards:            make(map[int]importedCard),
+		tables:           make(map[int]importedTable),
+		fields:           make(map[int]importedField),
+		dashboards:       make(map[int]importedDashboard),
+		databases:        make(map[int]importedDatabase),
+		collections:      make(map[string]importedCollection),
+		cardsSlugs:       make(map[string]bool),
+		tablesSlugs:      make(map[string]bool),
+		dashboardsSlugs:  make(map[string]bool),
+		prefetchedFields: make(map[int]metabase.Field),
+		collectionsSlugs: make(map[string]bool),
 	}
 }
+
+// Enables the automatic import of collections referenced by cards and dashboards but not defined as inputs to the
+// importer. Their parent collections are also imported, unless they have been defined as inputs.
+func (ic *ImportContext) EnableCollectionsImport() {
+	ic.importCollections = true
+}
+
+// Returns the value stored under the given key in one of the import context's maps.
+// The lock is only held while accessing the map, and never during calls to the Metabase API.
+func lockedGet[K comparable, V any](mu *sync.Mutex, m map[K]V, key K) (V, bool) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	value, ok := m[key]
+	return value, ok
+}
+
+// Stores a value under the given key in one of the import context's maps.
+func lockedSet[K comparable, V any](mu *sync.Mutex, m map[K]V, key K, value V) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	m[key] = value
+}
+
+// Stores a value under the given key in one of the import context's maps, unless a value already exists for the key.
+// The stored value is returned, such that concurrent imports of the same object all end up with the same definition.
+func lockedLoadOrStore[K comparable, V any](mu *sync.Mutex, m map[K]V, key K, value V) V {
+	mu.Lock()
+	defer mu.Unlock()
+
+	existing, ok := m[key]
+	if ok {
+		return existing
+	}
+
+	m[key] = value
+	return value
+}
+
+// Returns a copy of the values in one of the import context's maps.
+func lockedValues[K comparable, V any](mu *sync.Mutex, m map[K]V) []V {
+	mu.Lock()
+	defer mu.Unlock()
+
+	values := make([]V, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// Calls `makeUniqueSlug` while holding the lock, such that concurrent imports cannot be attributed the same slug.
+func (ic *ImportContext) makeUniqueSlug(str string, existingSlugs map[string]bool) string {
+	ic.mu.Lock()
+	defer ic.mu.Unlock()
+
+	return makeUniqueSlug(str, existingSlugs)
+}
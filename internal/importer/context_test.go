@@ -0,0 +1,115 @@
+package importer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+)
+
+// Creates an import context with a client targeting a test server that responds using the given handler.
+func newTestImportContext(t *testing.T, handler http.Handler) ImportContext {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := metabase.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("Failed to create Metabase client: %s", err)
+	}
+
+	return NewImportContext(*client)
+}
+
+// Creates an import context serving the metadata of table 5, and counting the number of calls made to the API.
+func newTestImportContextCountingCalls(t *testing.T) (ImportContext, *atomic.Int32) {
+	t.Helper()
+
+	calls := &atomic.Int32{}
+	ic := newTestImportContextWithDatabase(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+
+		if r.URL.Path != "/table/5/query_metadata" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(testTableMetadataResponse))
+	}))
+
+	return ic, calls
+}
+
+func TestImportTableCacheMissFetchesTable(t *testing.T) {
+	ic, calls := newTestImportContextCountingCalls(t)
+
+	table, err := ic.importTable(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("Unexpected error when importing table: %s", err)
+	}
+
+	if calls.Load() != 1 {
+		t.Errorf("Expected a single call to the API, got: %d", calls.Load())
+	}
+	if _, ok := ic.tables[5]; !ok {
+		t.Errorf("Expected table to be cached after being imported.")
+	}
+	if table.Slug != "public_orders" {
+		t.Errorf("Expected slug public_orders, got: %s", table.Slug)
+	}
+}
+
+func TestImportTableCacheHitDoesNotCallApi(t *testing.T) {
+	ic, calls := newTestImportContextCountingCalls(t)
+	ic.tables[5] = importedTable{Slug: "cached"}
+
+	table, err := ic.importTable(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("Unexpected error when importing table: %s", err)
+	}
+
+	if calls.Load() != 0 {
+		t.Errorf("Expected no call to the API, got: %d", calls.Load())
+	}
+	if table.Slug != "cached" {
+		t.Errorf("Expected the cached table to be returned, got: %s", table.Slug)
+	}
+}
+
+func TestImportTableConcurrently(t *testing.T) {
+	ic, _ := newTestImportContextCountingCalls(t)
+
+	var wg sync.WaitGroup
+	slugs := make([]string, 10)
+	for i := range slugs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			table, err := ic.importTable(context.Background(), 5)
+			if err != nil {
+				t.Errorf("Unexpected error when importing table: %s", err)
+				return
+			}
+
+			slugs[i] = table.Slug
+		}(i)
+	}
+	wg.Wait()
+
+	for _, slug := range slugs {
+		if slug != slugs[0] {
+			t.Errorf("Expected all concurrent imports to return the same table, got: %v", slugs)
+			break
+		}
+	}
+	if len(ic.tables) != 1 {
+		t.Errorf("Expected a single table to be cached, got: %d", len(ic.tables))
+	}
+}
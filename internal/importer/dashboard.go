@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"sort"
 	"text/template"
 
 	"github.com/flovouin/terraform-provider-metabase/metabase"
@@ -20,6 +22,10 @@ const dashboardTemplate = `resource "metabase_dashboard" "{{.TerraformSlug}}" {
   collection_position = {{if .CollectionPosition}}{{.CollectionPosition}}{{else}}null{{end}}
 
   parameters_json = jsonencode({{.ParametersHcl}})
+{{- if .TabsHcl}}
+
+  tabs_json = jsonencode({{.TabsHcl}})
+{{- end}}
 
   cards_json = jsonencode({{.CardsHcl}})
 }
@@ -34,6 +40,7 @@ type dashboardTemplateData struct {
 	CollectionRef      *string // The reference to the collection where the dashboard is located.
 	CollectionPosition *int    // The position in the collection.
 	ParametersHcl      string  // The dashboard parameters, as an HCL string.
+	TabsHcl            *string // The dashboard tabs, as an HCL string, or `nil` if the dashboard has no tab.
 	CardsHcl           string  // The dashboard cards, as an HCL string, possibly referencing cards.
 }
 
@@ -117,9 +124,47 @@ func (ic *ImportContext) insertReferencesInCard(ctx context.Context, card map[st
 	return nil
 }
 
+// Converts the list of dashboard tabs to HCL, ordered by position. The ID of each tab in the Terraform definition is its
+// position in the list (starting at 1), similarly to the IDs attributed by the dashboard resource when the definition
+// is read from Metabase. The returned map converts the IDs of the tabs in Metabase to the IDs in the definition.
+// `nil` is returned if the dashboard has no tab.
+func makeDashboardTabsHcl(tabs *[]metabase.DashboardTab) (*string, map[int]int, error) {
+	if tabs == nil || len(*tabs) == 0 {
+		return nil, nil, nil
+	}
+
+	sortedTabs := make([]metabase.DashboardTab, len(*tabs))
+	copy(sortedTabs, *tabs)
+	sort.SliceStable(sortedTabs, func(i, j int) bool {
+		if sortedTabs[i].Position == nil || sortedTabs[j].Position == nil {
+			return false
+		}
+		return *sortedTabs[i].Position < *sortedTabs[j].Position
+	})
+
+	tabIds := make(map[int]int, len(sortedTabs))
+	importedTabs := make([]map[string]interface{}, 0, len(sortedTabs))
+	for i, t := range sortedTabs {
+		tabIds[t.Id] = i + 1
+		importedTabs = append(importedTabs, map[string]interface{}{
+			"id":   i + 1,
+			"name": t.Name,
+		})
+	}
+
+	tabsJson, err := json.MarshalIndent(importedTabs, "  ", "  ")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hcl := string(tabsJson)
+
+	return &hcl, tabIds, nil
+}
+
 // Converts the list of "dashcards" to HCL, and replaces the references to card IDs by their corresponding Terraform
-// resources.
-func (ic *ImportContext) makeDashboardCardsHcl(ctx context.Context, cards []metabase.DashboardCard) (*string, error) {
+// resources. The `dashboard_tab_id` of each card is converted to the ID of the tab in the definition using `tabIds`.
+func (ic *ImportContext) makeDashboardCardsHcl(ctx context.Context, cards []metabase.DashboardCard, tabIds map[int]int) (*string, error) {
 	cardsJson, err := json.Marshal(cards)
 	if err != nil {
 		return nil, err
@@ -132,6 +177,7 @@ func (ic *ImportContext) makeDashboardCardsHcl(ctx context.Context, cards []meta
 		return nil, err
 	}
 
+	importedCards := make([]interface{}, 0, len(cardsUntyped))
 	for _, c := range cardsUntyped {
 		card, ok := c.(map[string]interface{})
 		if !ok {
@@ -139,14 +185,32 @@ func (ic *ImportContext) makeDashboardCardsHcl(ctx context.Context, cards []meta
 		}
 
 		err = ic.insertReferencesInCard(ctx, card)
+		if errors.Is(err, errArchivedCard) {
+			// The dashboard card is dropped rather than referencing a card that cannot be imported.
+			fmt.Fprintf(os.Stderr, "skipping dashboard card referencing an archived card: %s\n", err.Error())
+			continue
+		}
 		if err != nil {
 			return nil, err
 		}
 
 		delete(card, "id")
+
+		// Cards are not assigned to a tab when the dashboard has none.
+		if tabIdAny, ok := card["dashboard_tab_id"]; ok {
+			tabId, isNumber := tabIdAny.(float64)
+			terraformTabId, found := tabIds[int(tabId)]
+			if isNumber && found {
+				card["dashboard_tab_id"] = terraformTabId
+			} else {
+				delete(card, "dashboard_tab_id")
+			}
+		}
+
+		importedCards = append(importedCards, card)
 	}
 
-	cardsJson, err = json.MarshalIndent(cardsUntyped, "  ", "  ")
+	cardsJson, err = json.MarshalIndent(importedCards, "  ", "  ")
 	if err != nil {
 		return nil, err
 	}
@@ -156,6 +220,63 @@ func (ic *ImportContext) makeDashboardCardsHcl(ctx context.Context, cards []meta
 	return &hcl, nil
 }
 
+// Converts the dashboard parameters to HCL, replacing the references to cards and fields used as the source of values
+// (e.g. for linked filters) by their corresponding Terraform resources.
+// The ID of a parameter is only used within the dashboard itself, and it is not the ID of an object in the Metabase API.
+// The `param_fields` returned along with the dashboard are computed by Metabase from the parameter mappings, which are
+// also rewritten, and they are not part of the definition.
+func (ic *ImportContext) makeDashboardParametersHcl(ctx context.Context, parameters []metabase.DashboardParameter) (*string, error) {
+	parametersJson, err := json.Marshal(parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	var parametersUntyped []interface{}
+	err = json.Unmarshal(parametersJson, &parametersUntyped)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range parametersUntyped {
+		parameter, ok := p.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("unable to parse dashboard parameter")
+		}
+
+		configAny, ok := parameter["values_source_config"]
+		if !ok || configAny == nil {
+			continue
+		}
+
+		config, ok := configAny.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("unable to convert values_source_config to object in dashboard parameter")
+		}
+
+		// Values can be sourced from the column of a card, referenced by both the card ID and a field reference.
+		if _, ok := config[metabase.CardIdAttribute]; ok {
+			err := ic.insertCardReference(ctx, config)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err = ic.insertFieldReferencesRecursively(ctx, config)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	parametersJson, err = json.MarshalIndent(parametersUntyped, "  ", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	hcl := replacePlaceholders(string(parametersJson))
+
+	return &hcl, nil
+}
+
 // Produces the Terraform definition for a `metabase_dashboard` resource.
 func (ic *ImportContext) makeDashboardHcl(ctx context.Context, dashboard metabase.Dashboard, slug string) (*string, error) {
 	tpl, err := template.New("dashboard").Parse(dashboardTemplate)
@@ -163,14 +284,17 @@ func (ic *ImportContext) makeDashboardHcl(ctx context.Context, dashboard metabas
 		return nil, err
 	}
 
-	// Parameters should not contain references to tables or fields, and can be converted to JSON/HCL as is.
-	// Their ID is only used within the dashboard itself, and it is not the ID of an object in the Metabase API / DB.
-	parametersStr, err := json.MarshalIndent(dashboard.Parameters, "  ", "  ")
+	parametersHcl, err := ic.makeDashboardParametersHcl(ctx, dashboard.Parameters)
 	if err != nil {
 		return nil, err
 	}
 
-	cardsHcl, err := ic.makeDashboardCardsHcl(ctx, dashboard.Dashcards)
+	tabsHcl, tabIds, err := makeDashboardTabsHcl(dashboard.Tabs)
+	if err != nil {
+		return nil, err
+	}
+
+	cardsHcl, err := ic.makeDashboardCardsHcl(ctx, dashboard.Dashcards, tabIds)
 	if err != nil {
 		return nil, err
 	}
@@ -195,7 +319,7 @@ func (ic *ImportContext) makeDashboardHcl(ctx context.Context, dashboard metabas
 	var collectionRef *string
 	if dashboard.CollectionId != nil {
 		collectionId := fmt.Sprint(*dashboard.CollectionId)
-		collection, err := ic.getCollection(collectionId)
+		collection, err := ic.importCollection(ctx, collectionId)
 		if err != nil {
 			return nil, err
 		}
@@ -211,7 +335,8 @@ func (ic *ImportContext) makeDashboardHcl(ctx context.Context, dashboard metabas
 		CacheTtl:           dashboard.CacheTtl,
 		CollectionRef:      collectionRef,
 		CollectionPosition: dashboard.CollectionPosition,
-		ParametersHcl:      string(parametersStr),
+		ParametersHcl:      *parametersHcl,
+		TabsHcl:            tabsHcl,
 		CardsHcl:           *cardsHcl,
 	})
 	if err != nil {
@@ -223,9 +348,63 @@ func (ic *ImportContext) makeDashboardHcl(ctx context.Context, dashboard metabas
 	return &hcl, nil
 }
 
+// Converts an untyped object returned by the Metabase API to the given typed structure.
+func convertUntypedObject(obj map[string]interface{}, target interface{}) error {
+	objJson, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(objJson, target)
+}
+
+// Fetches the fields referenced by a dashboard, such that they do not have to be fetched individually when importing
+// them. This is only an optimization: if the metadata cannot be retrieved (e.g. with older versions of Metabase), fields
+// are fetched individually instead.
+// The tables returned along with the fields do not include hidden fields, and are therefore not kept: tables are always
+// fetched individually, such that their definition does not depend on how they were reached.
+func (ic *ImportContext) prefetchDashboardQueryMetadata(ctx context.Context, dashboardId int) error {
+	metadataResp, err := ic.client.GetDashboardQueryMetadataWithResponse(ctx, dashboardId)
+	if err != nil {
+		return err
+	}
+	if metadataResp.JSON200 == nil {
+		return nil
+	}
+
+	if metadataResp.JSON200.Tables != nil {
+		for _, t := range *metadataResp.JSON200.Tables {
+			// Virtual tables for cards have a string ID and cannot be converted. They are not referenced as tables anyway.
+			var table metabase.TableMetadata
+			err := convertUntypedObject(t, &table)
+			if err != nil {
+				continue
+			}
+
+			for _, f := range table.Fields {
+				lockedSet(ic.mu, ic.prefetchedFields, f.Id, f)
+			}
+		}
+	}
+
+	if metadataResp.JSON200.Fields != nil {
+		for _, f := range *metadataResp.JSON200.Fields {
+			var field metabase.Field
+			err := convertUntypedObject(f, &field)
+			if err != nil {
+				continue
+			}
+
+			lockedSet(ic.mu, ic.prefetchedFields, field.Id, field)
+		}
+	}
+
+	return nil
+}
+
 // Fetches a dashboard from the Metabase API and produces the corresponding Terraform definition.
 func (ic *ImportContext) ImportDashboard(ctx context.Context, dashboardId int) (*importedDashboard, error) {
-	dashboard, ok := ic.dashboards[dashboardId]
+	dashboard, ok := lockedGet(ic.mu, ic.dashboards, dashboardId)
 	if ok {
 		return &dashboard, nil
 	}
@@ -238,7 +417,14 @@ func (ic *ImportContext) ImportDashboard(ctx context.Context, dashboardId int) (
 		return nil, errors.New("unexpected response from the Metabase API when fetching dashboard")
 	}
 
-	slug := makeUniqueSlug(getResp.JSON200.Name, ic.dashboardsSlugs)
+	// Fetching the tables and fields referenced by the dashboard in a single call avoids one call per table and field
+	// when references are resolved.
+	err = ic.prefetchDashboardQueryMetadata(ctx, dashboardId)
+	if err != nil {
+		return nil, err
+	}
+
+	slug := ic.makeUniqueSlug(getResp.JSON200.Name, ic.dashboardsSlugs)
 
 	hcl, err := ic.makeDashboardHcl(ctx, *getResp.JSON200, slug)
 	if err != nil {
@@ -251,7 +437,7 @@ func (ic *ImportContext) ImportDashboard(ctx context.Context, dashboardId int) (
 		Hcl:       *hcl,
 	}
 
-	ic.dashboards[dashboardId] = dashboard
+	dashboard = lockedLoadOrStore(ic.mu, ic.dashboards, dashboardId, dashboard)
 
 	return &dashboard, nil
 }
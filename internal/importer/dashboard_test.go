@@ -0,0 +1,219 @@
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+)
+
+// The metadata of a table returned by the dashboard query metadata, which does not include hidden fields.
+const testDashboardTableMetadataResponse = `{
+  "id": 5,
+  "db_id": 1,
+  "name": "orders",
+  "display_name": "Orders",
+  "entity_type": "entity/GenericTable",
+  "schema": "public",
+  "description": null,
+  "fields": [
+    { "id": 7, "table_id": 5, "name": "total", "display_name": "Total", "description": null, "effective_type": null, "semantic_type": "type/Currency" }
+  ]
+}`
+
+// The metadata of the same table, including hidden fields.
+const testTableMetadataWithHiddenFieldsResponse = `{
+  "id": 5,
+  "db_id": 1,
+  "name": "orders",
+  "display_name": "Orders",
+  "entity_type": "entity/GenericTable",
+  "schema": "public",
+  "description": null,
+  "fields": [
+    { "id": 7, "table_id": 5, "name": "total", "display_name": "Total", "description": null, "effective_type": null, "semantic_type": "type/Currency" },
+    { "id": 8, "table_id": 5, "name": "secret", "display_name": "Secret", "description": null, "effective_type": null, "semantic_type": null, "visibility_type": "sensitive" }
+  ]
+}`
+
+// Serves the dashboard query metadata and the table metadata. Any other request, e.g. for a single field, fails.
+func serveTestDashboardQueryMetadata(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/dashboard/1/query_metadata":
+			w.Write([]byte(`{
+  "tables": [` + testDashboardTableMetadataResponse + `, { "id": "card__12", "db_id": 1, "name": "Model" }],
+  "fields": []
+}`))
+		case r.URL.Path == "/table/5/query_metadata" && r.URL.Query().Get("include_hidden_fields") == "true":
+			w.Write([]byte(testTableMetadataWithHiddenFieldsResponse))
+		default:
+			t.Errorf("Unexpected request: %s %s.", r.Method, r.URL.String())
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}
+}
+
+func TestPrefetchDashboardQueryMetadataAvoidsFieldLookups(t *testing.T) {
+	ic := newTestImportContextWithDatabase(t, serveTestDashboardQueryMetadata(t))
+
+	err := ic.prefetchDashboardQueryMetadata(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Unexpected error when prefetching dashboard metadata: %s", err)
+	}
+
+	if len(ic.tables) != 0 || len(ic.fields) != 0 {
+		t.Errorf("Expected prefetched objects not to be imported, got: %d tables and %d fields", len(ic.tables), len(ic.fields))
+	}
+
+	field, err := ic.importField(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("Unexpected error when importing field: %s", err)
+	}
+
+	if field.ParentTable.Slug != "public_orders" {
+		t.Errorf("Expected field to reference the orders table, got: %s", field.ParentTable.Slug)
+	}
+}
+
+func TestImportTableIsIndependentOfPrefetching(t *testing.T) {
+	prefetched := newTestImportContextWithDatabase(t, serveTestDashboardQueryMetadata(t))
+	err := prefetched.prefetchDashboardQueryMetadata(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Unexpected error when prefetching dashboard metadata: %s", err)
+	}
+
+	prefetchedTable, err := prefetched.importTable(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("Unexpected error when importing table: %s", err)
+	}
+
+	direct := newTestImportContextWithDatabase(t, serveTestDashboardQueryMetadata(t))
+	directTable, err := direct.importTable(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("Unexpected error when importing table: %s", err)
+	}
+
+	if prefetchedTable.Hcl != directTable.Hcl {
+		t.Errorf("Expected the same table definition with and without prefetching, got:\n%s\nand:\n%s", prefetchedTable.Hcl, directTable.Hcl)
+	}
+	if !strings.Contains(prefetchedTable.Hcl, `"secret": null`) {
+		t.Errorf("Expected hidden fields to be part of the table definition, got: %s", prefetchedTable.Hcl)
+	}
+}
+
+func TestPrefetchDashboardQueryMetadataIgnoresUnavailableMetadata(t *testing.T) {
+	ic := newTestImportContext(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	err := ic.prefetchDashboardQueryMetadata(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Unexpected error when prefetching dashboard metadata: %s", err)
+	}
+
+	if len(ic.prefetchedFields) != 0 {
+		t.Errorf("Expected no prefetched field, got: %d", len(ic.prefetchedFields))
+	}
+}
+
+func TestMakeDashboardParametersHclReplacesValuesSource(t *testing.T) {
+	ic := newTestImportContext(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	ic.cards[10] = importedCard{Slug: "orders"}
+	ic.tables[5] = importedTable{Slug: "public_orders"}
+	ic.prefetchedFields[7] = metabase.Field{Id: 7, Name: "total", TableId: 5}
+
+	var parameters []metabase.DashboardParameter
+	err := json.Unmarshal([]byte(`[
+  {
+    "id": "a1b2c3",
+    "name": "Total",
+    "slug": "total",
+    "sectionId": "number",
+    "type": "number/=",
+    "filteringParameters": ["d4e5f6"],
+    "values_source_type": "card",
+    "values_source_config": { "card_id": 10, "value_field": ["field", 7, null] }
+  }
+]`), &parameters)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parametersHcl, err := ic.makeDashboardParametersHcl(context.Background(), parameters)
+	if err != nil {
+		t.Fatalf("Unexpected error when making parameters HCL: %s", err)
+	}
+
+	if !strings.Contains(*parametersHcl, "metabase_card.orders.id") {
+		t.Errorf("Expected parameter to reference the source card, got: %s", *parametersHcl)
+	}
+	if !strings.Contains(*parametersHcl, `metabase_table.public_orders.fields["total"]`) {
+		t.Errorf("Expected parameter to reference the source field, got: %s", *parametersHcl)
+	}
+	if !strings.Contains(*parametersHcl, `"d4e5f6"`) {
+		t.Errorf("Expected linked filters to be kept, got: %s", *parametersHcl)
+	}
+}
+
+func TestMakeDashboardTabsHcl(t *testing.T) {
+	tabsHcl, tabIds, err := makeDashboardTabsHcl(nil)
+	if err != nil || tabsHcl != nil || tabIds != nil {
+		t.Errorf("Expected no tab definition for a dashboard without tabs, got: %v", tabsHcl)
+	}
+
+	first, second := 0, 1
+	tabsHcl, tabIds, err = makeDashboardTabsHcl(&[]metabase.DashboardTab{
+		{Id: 42, Name: "Details", Position: &second},
+		{Id: 17, Name: "Overview", Position: &first},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error when making dashboard tabs HCL: %s", err)
+	}
+
+	var tabs []map[string]interface{}
+	err = json.Unmarshal([]byte(*tabsHcl), &tabs)
+	if err != nil {
+		t.Fatalf("Unexpected error when parsing tabs: %s", err)
+	}
+
+	if len(tabs) != 2 || tabs[0]["name"] != "Overview" || tabs[0]["id"] != float64(1) || tabs[1]["id"] != float64(2) {
+		t.Errorf("Expected tabs to be ordered by position and identified by their position, got: %s", *tabsHcl)
+	}
+	if tabIds[17] != 1 || tabIds[42] != 2 {
+		t.Errorf("Expected Metabase tab IDs to be converted to positions, got: %v", tabIds)
+	}
+}
+
+func TestMakeDashboardCardsHclAssignsTabs(t *testing.T) {
+	ic := newTestImportContext(t, nil)
+
+	tabId, unknownTabId := 42, 5
+	cardsHcl, err := ic.makeDashboardCardsHcl(context.Background(), []metabase.DashboardCard{
+		{Id: 1, SizeX: 4, DashboardTabId: &tabId},
+		{Id: 2, SizeX: 6, DashboardTabId: &unknownTabId},
+	}, map[int]int{42: 2})
+	if err != nil {
+		t.Fatalf("Unexpected error when making dashboard cards HCL: %s", err)
+	}
+
+	var cards []map[string]interface{}
+	err = json.Unmarshal([]byte(*cardsHcl), &cards)
+	if err != nil {
+		t.Fatalf("Unexpected error when parsing cards: %s", err)
+	}
+
+	if cards[0]["dashboard_tab_id"] != float64(2) {
+		t.Errorf("Expected the card to reference the tab by its position, got: %v", cards[0]["dashboard_tab_id"])
+	}
+	if _, ok := cards[1]["dashboard_tab_id"]; ok {
+		t.Errorf("Expected the reference to an unknown tab to be removed, got: %v", cards[1]["dashboard_tab_id"])
+	}
+}
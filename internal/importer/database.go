@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/flovouin/terraform-provider-metabase/metabase"
 )
@@ -18,9 +19,9 @@ type ExistingDatabaseDefinition struct {
 
 // Retrieves an imported database given its ID.
 func (ic *ImportContext) getDatabase(databaseId int) (*importedDatabase, error) {
-	db, ok := ic.databases[databaseId]
+	db, ok := lockedGet(ic.mu, ic.databases, databaseId)
 	if !ok {
-		return nil, fmt.Errorf("database %d has not been defined in the importer configuration", databaseId)
+		return nil, newUnmappedReferenceError("database %d has not been defined in the importer configuration", databaseId)
 	}
 
 	return &db, nil
@@ -64,27 +65,39 @@ func (ic *ImportContext) ImportDatabasesFromDefinitions(ctx context.Context, exi
 				databasesList = listResp.JSON200
 			}
 
+			var matchingDatabases []metabase.Database
 			for _, db := range databasesList.Data {
 				if db.Name == *existingDatabase.Name {
-					database = &db
-					break
+					matchingDatabases = append(matchingDatabases, db)
 				}
 			}
 
-			if database == nil {
+			if len(matchingDatabases) == 0 {
 				return fmt.Errorf("unable to find database with name %s from the Metabase API response", *existingDatabase.Name)
 			}
+
+			// Picking one of the databases arbitrarily could silently reference the wrong one.
+			if len(matchingDatabases) > 1 {
+				candidateIds := make([]string, 0, len(matchingDatabases))
+				for _, db := range matchingDatabases {
+					candidateIds = append(candidateIds, fmt.Sprint(db.Id))
+				}
+
+				return fmt.Errorf("found several databases with name %s (IDs: %s), the database should be referenced by ID instead", *existingDatabase.Name, strings.Join(candidateIds, ", "))
+			}
+
+			database = &matchingDatabases[0]
 		}
 
-		_, exists := ic.databases[database.Id]
+		_, exists := lockedGet(ic.mu, ic.databases, database.Id)
 		if exists {
 			return fmt.Errorf("database %d has already been imported", database.Id)
 		}
 
-		ic.databases[database.Id] = importedDatabase{
+		lockedSet(ic.mu, ic.databases, database.Id, importedDatabase{
 			Database: *database,
 			Slug:     existingDatabase.ResourceName,
-		}
+		})
 	}
 
 	return nil
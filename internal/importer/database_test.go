@@ -0,0 +1,61 @@
+package importer
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// The response returned by the test server when listing databases, with two databases sharing the same name.
+const testDatabaseListResponse = `{
+  "data": [
+    { "id": 1, "name": "Warehouse", "engine": "postgres", "details": {} },
+    { "id": 2, "name": "Warehouse", "engine": "postgres", "details": {} },
+    { "id": 3, "name": "Analytics", "engine": "bigquery-cloud-sdk", "details": {} }
+  ]
+}`
+
+func newTestImportContextWithDatabaseList(t *testing.T) ImportContext {
+	return newTestImportContext(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/database" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(testDatabaseListResponse))
+	}))
+}
+
+func TestImportDatabasesFromDefinitionsByName(t *testing.T) {
+	ic := newTestImportContextWithDatabaseList(t)
+
+	name := "Analytics"
+	err := ic.ImportDatabasesFromDefinitions(context.Background(), []ExistingDatabaseDefinition{
+		{Name: &name, ResourceName: "analytics"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error when importing database: %s", err)
+	}
+
+	if ic.databases[3].Slug != "analytics" {
+		t.Errorf("Expected database 3 to be imported, got: %v", ic.databases)
+	}
+}
+
+func TestImportDatabasesFromDefinitionsFailsWithSeveralMatches(t *testing.T) {
+	ic := newTestImportContextWithDatabaseList(t)
+
+	name := "Warehouse"
+	err := ic.ImportDatabasesFromDefinitions(context.Background(), []ExistingDatabaseDefinition{
+		{Name: &name, ResourceName: "warehouse"},
+	})
+	if err == nil {
+		t.Fatal("Expected an error when several databases match the name.")
+	}
+
+	if !strings.Contains(err.Error(), "1, 2") {
+		t.Errorf("Expected the error to list the candidate IDs, got: %s", err)
+	}
+}
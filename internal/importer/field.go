@@ -8,7 +8,7 @@ import (
 )
 
 // Searches a JSON object or array recursively to find references to `Field` Metabase objects. The references are
-// replaced by an `importedField`, which is marshalled as a reference to the corresponding Terraform table data source
+// replaced by an `importedField`, which is marshalled as a reference to the corresponding Terraform table resource
 // instead.
 func (ic *ImportContext) insertFieldReferencesRecursively(ctx context.Context, obj interface{}) error {
 	switch typedObj := obj.(type) {
@@ -43,7 +43,8 @@ func (ic *ImportContext) insertFieldReferencesRecursively(ctx context.Context, o
 	return nil
 }
 
-// Tries to replace the reference to a field ID by the corresponding `importedField`.
+// Tries to replace the reference to a field ID by the corresponding `importedField`. The foreign key referenced by the
+// `source-field` option, if any, is also replaced.
 // If the given array is not a reference to a field, this function returns `false`. If the array is a reference to a
 // field, but the field cannot be imported, the function will return an error.
 func (ic *ImportContext) tryInsertFieldReference(ctx context.Context, array []interface{}) (bool, error) {
@@ -68,36 +69,63 @@ func (ic *ImportContext) tryInsertFieldReference(ctx context.Context, array []in
 
 	array[1] = importedField
 
+	// The options of the reference can contain the foreign key through which the table of the field is joined.
+	if len(array) < 3 {
+		return true, nil
+	}
+
+	options, ok := array[2].(map[string]interface{})
+	if !ok {
+		return true, nil
+	}
+
+	sourceFieldIdFloat, ok := options[metabase.SourceFieldAttribute].(float64)
+	if !ok {
+		return true, nil
+	}
+
+	sourceField, err := ic.importField(ctx, int(sourceFieldIdFloat))
+	if err != nil {
+		return false, err
+	}
+
+	options[metabase.SourceFieldAttribute] = sourceField
+
 	return true, nil
 }
 
-// Fetches a field from the Metabase API and produces the corresponding Terraform definition.
+// Fetches a field from the Metabase API (unless it has been prefetched) and produces the corresponding Terraform definition.
 // This will import the parent table if it hasn't already been imported.
 func (ic *ImportContext) importField(ctx context.Context, fieldId int) (*importedField, error) {
-	field, ok := ic.fields[fieldId]
+	field, ok := lockedGet(ic.mu, ic.fields, fieldId)
 	if ok {
 		return &field, nil
 	}
 
-	getResp, err := ic.client.GetFieldWithResponse(ctx, fieldId)
-	if err != nil {
-		return nil, err
-	}
-	if getResp.JSON200 == nil {
-		return nil, errors.New("received unexpected response when getting field")
+	rawField, ok := lockedGet(ic.mu, ic.prefetchedFields, fieldId)
+	if !ok {
+		getResp, err := ic.client.GetFieldWithResponse(ctx, fieldId)
+		if err != nil {
+			return nil, err
+		}
+		if getResp.JSON200 == nil {
+			return nil, errors.New("received unexpected response when getting field")
+		}
+
+		rawField = *getResp.JSON200
 	}
 
-	table, err := ic.importTable(ctx, getResp.JSON200.TableId)
+	table, err := ic.importTable(ctx, rawField.TableId)
 	if err != nil {
 		return nil, err
 	}
 
 	field = importedField{
-		Field:       *getResp.JSON200,
+		Field:       rawField,
 		ParentTable: table,
 	}
 
-	ic.fields[fieldId] = field
+	field = lockedLoadOrStore(ic.mu, ic.fields, fieldId, field)
 
 	return &field, nil
 }
@@ -12,17 +12,17 @@ import (
 // The captured group can be used as is in an HCL file.
 var cardRegexp = regexp.MustCompile("\\\"!!(metabase_card\\.\\w+\\.id)!!\\\"")
 
-// The regexp matching the placeholder for `metabase_table` data sources, accessing their `fields` attribute.
+// The regexp matching the placeholder for `metabase_table` resources, accessing their `fields` attribute.
 // The first group is the table and the second group is the name of the field (column).
 var fieldRegexp = regexp.MustCompile("\\\"!!(metabase_table\\.\\w+\\.fields)\\[(\\w+)\\]!!\\\"")
 
-// The regexp matching the placeholder for `metabase_table` data sources, accessing their `fields` attribute.
+// The regexp matching the placeholder for `metabase_table` resources, accessing their `fields` attribute.
 // The first group is the table and the second group is the name of the field (column).
 // This regexp matches the placeholder when it has been serialized twice, and that the surrounding double quotes have
 // been escaped.
 var fieldInStringRegexp = regexp.MustCompile("\\\\\\\"!!(metabase_table\\.\\w+\\.fields)\\[(\\w+)\\]!!\\\\\\\"")
 
-// The regexp matching the placeholder for `metabase_table` data sources.
+// The regexp matching the placeholder for `metabase_table` resources.
 // The captured group can be used as is in an HCL file.
 var tableRegexp = regexp.MustCompile("\\\"!!(metabase_table\\.\\w+\\.id)!!\\\"")
 
@@ -39,13 +39,13 @@ func (c *importedCard) MarshalJSON() ([]byte, error) {
 	return []byte(fmt.Sprintf("\"!!metabase_card.%s.id!!\"", c.Slug)), nil
 }
 
-// Marshals an `importedField` as a placeholder which references the corresponding Terraform table data source, and
+// Marshals an `importedField` as a placeholder which references the corresponding Terraform table resource, and
 // accesses the `field` attribute for this `metabase_table`.
 func (f *importedField) MarshalJSON() ([]byte, error) {
 	return []byte(fmt.Sprintf("\"!!metabase_table.%s.fields[%s]!!\"", f.ParentTable.Slug, f.Field.Name)), nil
 }
 
-// Marshals an `importedTable` as a placeholder which references the corresponding Terraform data source.
+// Marshals an `importedTable` as a placeholder which references the corresponding Terraform resource.
 func (t *importedTable) MarshalJSON() ([]byte, error) {
 	return []byte(fmt.Sprintf("\"!!metabase_table.%s.id!!\"", t.Slug)), nil
 }
@@ -62,7 +62,7 @@ func (c *importedCollection) MarshalJSON() ([]byte, error) {
 }
 
 // Replaces all placeholders introduced by marshalling `imported*` structures to JSON.
-// This produces a valid HCL snippet which references Metabase Terraform resources and data sources.
+// This produces a valid HCL snippet which references Metabase Terraform resources.
 func replacePlaceholders(hcl string) string {
 	hcl = cardRegexp.ReplaceAllString(hcl, "$1")
 	hcl = fieldRegexp.ReplaceAllString(hcl, "$1[\"$2\"]")
@@ -73,14 +73,25 @@ func replacePlaceholders(hcl string) string {
 	return hcl
 }
 
+// The slug used for names that do not contain any character which can be used in a Terraform identifier, e.g. emojis.
+const emptySlug = "unnamed"
+
+// The prefix added to slugs starting with a digit, as Terraform identifiers must start with a letter or an underscore.
+const digitSlugPrefix = "n_"
+
 // Makes a unique slug containing underscores instead of dashes.
-// The returned slug is guaranteed not to existing in `existingSlugs`. When this function returns, the slug has been
-// added to the map passed as input.
+// The returned slug is a valid Terraform identifier, and is guaranteed not to existing in `existingSlugs`. When this
+// function returns, the slug has been added to the map passed as input.
 func makeUniqueSlug(str string, existingSlugs map[string]bool) string {
-	slug.MaxLength = 124 // Leaving 4 characters for the suffix in case of duplicates.
+	slug.MaxLength = 122 // Leaving 4 characters for the suffix in case of duplicates, and 2 for the digit prefix.
 
 	slg := slug.Make(str)
 	slg = strings.ReplaceAll(slg, "-", "_")
+	if len(slg) == 0 {
+		slg = emptySlug
+	} else if slg[0] >= '0' && slg[0] <= '9' {
+		slg = digitSlugPrefix + slg
+	}
 	baseSlug := slg
 
 	for i := 1; ; i++ {
@@ -0,0 +1,25 @@
+package importer
+
+import "testing"
+
+func TestMakeUniqueSlugReturnsValidIdentifiers(t *testing.T) {
+	existingSlugs := make(map[string]bool)
+
+	testCases := []struct {
+		name     string
+		expected string
+	}{
+		{"Sales Report", "sales_report"},
+		{"2024 Report", "n_2024_report"},
+		{"📈", "unnamed"},
+		{"📉", "unnamed_001"},
+		{"Sales Report", "sales_report_001"},
+	}
+
+	for _, tc := range testCases {
+		slug := makeUniqueSlug(tc.name, existingSlugs)
+		if slug != tc.expected {
+			t.Errorf("Expected slug %s for %q, got %s", tc.expected, tc.name, slug)
+		}
+	}
+}
@@ -6,12 +6,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"text/template"
 
 	"github.com/flovouin/terraform-provider-metabase/metabase"
 )
 
-// The template producing a `metabase_table` Terraform data source definition.
+// The template producing a `metabase_table` Terraform resource definition.
+// The semantic types of all fields are listed in `forced_field_types`, such that the field configuration is also codified.
 const tableTemplate = `resource "metabase_table" "{{.TerraformSlug}}" {
   {{if .DbRef}}db_id = metabase_database.{{.DbRef}}.id{{end}}
   {{if .Schema}}schema = {{.Schema}}{{end}}
@@ -21,7 +23,7 @@ const tableTemplate = `resource "metabase_table" "{{.TerraformSlug}}" {
 }
 `
 
-// The data required to produce a `metabase_table` Terraform data source definition.
+// The data required to produce a `metabase_table` Terraform resource definition.
 type tableTemplateData struct {
 	TerraformSlug    string  // The slug used as the name of the Terraform resource.
 	Name             string  // The name of the table.
@@ -30,7 +32,7 @@ type tableTemplateData struct {
 	ForcedFieldTypes string  // A map of semantic types for fields in the table.
 }
 
-// Produces the Terraform definition for a `metabase_table` data source.
+// Produces the Terraform definition for a `metabase_table` resource.
 func (ic *ImportContext) makeTableHcl(table metabase.TableMetadata, slug string) (*string, error) {
 	tpl, err := template.New("table").Parse(tableTemplate)
 	if err != nil {
@@ -55,7 +57,7 @@ func (ic *ImportContext) makeTableHcl(table metabase.TableMetadata, slug string)
 	}
 
 	// If the database cannot be found in the list of imported databases, the `db_id` condition is simply not added to the
-	// data source definition. It is not treated as an error because the field is optional to find the table.
+	// resource definition. It is not treated as an error because the field is optional to find the table.
 	var dbRef *string
 	db, err := ic.getDatabase(table.DbId)
 	if err == nil {
@@ -88,14 +90,42 @@ func (ic *ImportContext) makeTableHcl(table metabase.TableMetadata, slug string)
 	return &hcl, nil
 }
 
+// The initial sync status of tables and databases which have been fully synced by Metabase.
+const initialSyncStatusComplete = "complete"
+
+// Returns warnings about a table whose list of fields may be partial, e.g. because it is hidden or because Metabase has
+// not finished syncing it. The database can be `nil` if it is not known to the importer.
+func getPartialTableWarnings(table metabase.TableMetadata, database *metabase.Database) []string {
+	warnings := []string{}
+
+	if table.VisibilityType != nil {
+		warnings = append(warnings, fmt.Sprintf("table %s is %s in Metabase", table.Name, *table.VisibilityType))
+	}
+
+	if table.InitialSyncStatus != nil && *table.InitialSyncStatus != initialSyncStatusComplete {
+		warnings = append(warnings, fmt.Sprintf("the initial sync of table %s is %s", table.Name, *table.InitialSyncStatus))
+	}
+
+	if database != nil && database.InitialSyncStatus != nil && *database.InitialSyncStatus != initialSyncStatusComplete {
+		warnings = append(warnings, fmt.Sprintf("the initial sync of database %s is %s", database.Name, *database.InitialSyncStatus))
+	}
+
+	return warnings
+}
+
 // Fetches a table from the Metabase API and produces the corresponding Terraform definition.
+// A warning is logged if the fields of the table may be partial.
 func (ic *ImportContext) importTable(ctx context.Context, tableId int) (*importedTable, error) {
-	table, ok := ic.tables[tableId]
+	table, ok := lockedGet(ic.mu, ic.tables, tableId)
 	if ok {
 		return &table, nil
 	}
 
-	getResp, err := ic.client.GetTableMetadataWithResponse(ctx, tableId, &metabase.GetTableMetadataParams{})
+	// Hidden fields are included such that their semantic types are also part of `forced_field_types`.
+	includeHiddenFields := true
+	getResp, err := ic.client.GetTableMetadataWithResponse(ctx, tableId, &metabase.GetTableMetadataParams{
+		IncludeHiddenFields: &includeHiddenFields,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -104,14 +134,24 @@ func (ic *ImportContext) importTable(ctx context.Context, tableId int) (*importe
 	}
 
 	rawTable := *getResp.JSON200
+
+	var database *metabase.Database
+	if db, ok := lockedGet(ic.mu, ic.databases, rawTable.DbId); ok {
+		database = &db.Database
+	}
+
+	for _, w := range getPartialTableWarnings(rawTable, database) {
+		fmt.Fprintf(os.Stderr, "the fields of the imported table may be partial: %s\n", w)
+	}
+
 	tableName := rawTable.Name
 	if rawTable.Schema != nil && len(*rawTable.Schema) > 0 {
-		// Prefixing the data source name with the table's schema if it is non-empty.
+		// Prefixing the resource name with the table's schema if it is non-empty.
 		// This makes names slightly more readable than suffixing with a number in case a table name conflicts between two
 		// databases.
 		tableName = fmt.Sprintf("%s_%s", *rawTable.Schema, tableName)
 	}
-	slug := makeUniqueSlug(tableName, ic.tablesSlugs)
+	slug := ic.makeUniqueSlug(tableName, ic.tablesSlugs)
 
 	hcl, err := ic.makeTableHcl(rawTable, slug)
 	if err != nil {
@@ -124,7 +164,7 @@ func (ic *ImportContext) importTable(ctx context.Context, tableId int) (*importe
 		Hcl:   *hcl,
 	}
 
-	ic.tables[tableId] = table
+	table = lockedLoadOrStore(ic.mu, ic.tables, tableId, table)
 
 	return &table, nil
 }
@@ -0,0 +1,75 @@
+package importer
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+)
+
+func TestImportTableIncludesForcedFieldTypes(t *testing.T) {
+	ic := newTestImportContextWithDatabase(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/table/5/query_metadata" || r.URL.Query().Get("include_hidden_fields") != "true" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+  "id": 5,
+  "db_id": 1,
+  "name": "orders",
+  "display_name": "Orders",
+  "entity_type": "entity/GenericTable",
+  "schema": "public",
+  "description": null,
+  "fields": [
+    { "id": 7, "table_id": 5, "name": "total", "display_name": "Total", "description": null, "effective_type": null, "semantic_type": "type/Currency" },
+    { "id": 8, "table_id": 5, "name": "notes", "display_name": "Notes", "description": null, "effective_type": null, "semantic_type": null }
+  ]
+}`))
+	}))
+
+	table, err := ic.importTable(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("Unexpected error when importing table: %s", err)
+	}
+
+	if !strings.Contains(table.Hcl, `resource "metabase_table" "public_orders"`) {
+		t.Errorf("Expected table to be defined as a resource, got: %s", table.Hcl)
+	}
+	if !strings.Contains(table.Hcl, `"total": "type/Currency"`) {
+		t.Errorf("Expected forced field types to contain the semantic type, got: %s", table.Hcl)
+	}
+	if !strings.Contains(table.Hcl, `"notes": null`) {
+		t.Errorf("Expected forced field types to contain fields without semantic type, got: %s", table.Hcl)
+	}
+}
+
+func TestGetPartialTableWarnings(t *testing.T) {
+	complete := "complete"
+	table := metabase.TableMetadata{Name: "orders", InitialSyncStatus: &complete}
+	database := metabase.Database{Name: "Warehouse", InitialSyncStatus: &complete}
+
+	warnings := getPartialTableWarnings(table, &database)
+	if len(warnings) != 0 {
+		t.Errorf("Expected no warning for a visible and synced table, got: %v", warnings)
+	}
+
+	hidden := "hidden"
+	incomplete := "incomplete"
+	table.VisibilityType = &hidden
+	database.InitialSyncStatus = &incomplete
+
+	warnings = getPartialTableWarnings(table, &database)
+	if len(warnings) != 2 || !strings.Contains(warnings[0], "hidden") || !strings.Contains(warnings[1], "Warehouse") {
+		t.Errorf("Expected warnings for the hidden table and the incomplete database sync, got: %v", warnings)
+	}
+
+	warnings = getPartialTableWarnings(table, nil)
+	if len(warnings) != 1 {
+		t.Errorf("Expected only the visibility warning when the database is unknown, got: %v", warnings)
+	}
+}
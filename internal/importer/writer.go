@@ -1,12 +1,18 @@
 package importer
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"text/template"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 )
 
 // The default prefix for generated files, if none is specified.
@@ -18,6 +24,98 @@ type WriteOptions struct {
 	DisableFileNameResourceType bool   // If `true`, each generated file name does not contain the type of resource defined in the file.
 	ClearOutput                 bool   // If `true`, all files at the output path with the right prefix will be removed before generation.
 	DisableFormatting           bool   // If `true`, does not attempt to run `terraform fmt` after writing the files.
+
+	ProviderConfig *ProviderConfigOptions // If set, a file requiring the Metabase provider is written along with the resources.
+}
+
+// Options defining the provider configuration written along with the imported resources.
+type ProviderConfigOptions struct {
+	VersionConstraint string // The constraint on the version of the provider, e.g. `~> 0.9`. No constraint is set if empty.
+	EmitProviderBlock bool   // If `true`, a `provider` block is also written, which sets the endpoint but not the credentials.
+	Endpoint          string // The endpoint to the Metabase API, set in the `provider` block.
+}
+
+// The source of the Metabase provider in the Terraform registry.
+const providerSource = "flovouin/metabase"
+
+// The template producing the Terraform block requiring the Metabase provider, and optionally the provider block.
+const providerConfigTemplate = `terraform {
+  required_providers {
+    metabase = {
+      source = "{{.Source}}"
+{{- if .VersionConstraint}}
+      version = {{.VersionConstraint}}
+{{- end}}
+    }
+  }
+}
+{{- if .Endpoint}}
+
+provider "metabase" {
+  endpoint = {{.Endpoint}}
+
+  # Credentials are not written by the importer. They should be set using username and password, api_key, or
+  # session_token, e.g. from variables.
+}
+{{- end}}
+`
+
+// The data required to produce the provider configuration.
+type providerConfigTemplateData struct {
+	Source            string // The source of the provider.
+	VersionConstraint string // The constraint on the version of the provider, as an HCL string, or empty.
+	Endpoint          string // The endpoint to the Metabase API, as an HCL string, or empty if no provider block is written.
+}
+
+// Converts a string to JSON, which ensures special characters are escaped. Contrary to `json.Marshal`, characters like
+// `>` are not escaped, as they are common in version constraints.
+func marshalHclString(str string) (string, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	err := encoder.Encode(str)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// Produces the Terraform definition requiring the Metabase provider.
+func makeProviderConfigHcl(opts ProviderConfigOptions) (string, error) {
+	tpl, err := template.New("provider").Parse(providerConfigTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	data := providerConfigTemplateData{Source: providerSource}
+
+	if len(opts.VersionConstraint) > 0 {
+		versionConstraint, err := marshalHclString(opts.VersionConstraint)
+		if err != nil {
+			return "", err
+		}
+
+		data.VersionConstraint = versionConstraint
+	}
+
+	if opts.EmitProviderBlock {
+		endpoint, err := marshalHclString(opts.Endpoint)
+		if err != nil {
+			return "", err
+		}
+
+		data.Endpoint = endpoint
+	}
+
+	var buf bytes.Buffer
+	err = tpl.Execute(&buf, data)
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
 }
 
 // Returns either the prefix set in the options, or the default one.
@@ -77,37 +175,89 @@ func formatTerraformFiles(path string) error {
 	return nil
 }
 
-// Writes the tables, cards, and dashboards that have been imported to Terraform files.
-func (ic *ImportContext) Write(path string, opts WriteOptions) error {
-	if opts.ClearOutput {
-		err := clearOutput(path, opts)
-		if err != nil {
-			return err
+// A Terraform file produced by the importer.
+type generatedFile struct {
+	Path string // The path where the file is written.
+	Hcl  string // The content of the file.
+}
+
+// Lists the files for the collections, tables, cards, and dashboards that have been imported, and the provider
+// configuration if requested.
+func (ic *ImportContext) makeGeneratedFiles(path string, opts WriteOptions) ([]generatedFile, error) {
+	files := []generatedFile{}
+
+	for _, c := range lockedValues(ic.mu, ic.collections) {
+		// Collections defined manually as inputs to the importer should not be written.
+		if len(c.Hcl) == 0 {
+			continue
 		}
+
+		files = append(files, generatedFile{Path: makeFilePath(path, "collection", c.Slug, opts), Hcl: c.Hcl})
 	}
 
-	for _, t := range ic.tables {
-		path := makeFilePath(path, "table", t.Slug, opts)
+	for _, t := range lockedValues(ic.mu, ic.tables) {
+		files = append(files, generatedFile{Path: makeFilePath(path, "table", t.Slug, opts), Hcl: t.Hcl})
+	}
 
-		err := os.WriteFile(path, []byte(t.Hcl), 0644)
+	for _, c := range lockedValues(ic.mu, ic.cards) {
+		files = append(files, generatedFile{Path: makeFilePath(path, "card", c.Slug, opts), Hcl: c.Hcl})
+	}
+
+	for _, d := range lockedValues(ic.mu, ic.dashboards) {
+		files = append(files, generatedFile{Path: makeFilePath(path, "dashboard", d.Slug, opts), Hcl: d.Hcl})
+	}
+
+	if opts.ProviderConfig != nil {
+		hcl, err := makeProviderConfigHcl(*opts.ProviderConfig)
 		if err != nil {
-			return err
+			return nil, err
 		}
+
+		files = append(files, generatedFile{
+			Path: filepath.Join(path, fmt.Sprintf("%sversions.tf", opts.getFileNamePrefix())),
+			Hcl:  hcl,
+		})
+	}
+
+	return files, nil
+}
+
+// Parses the content of a generated file, and returns an error describing the first syntax errors if it is not valid
+// HCL.
+func validateGeneratedFile(f generatedFile) error {
+	_, diags := hclsyntax.ParseConfig([]byte(f.Hcl), f.Path, hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		return fmt.Errorf("invalid HCL generated for %s: %w", f.Path, diags)
 	}
 
-	for _, c := range ic.cards {
-		path := makeFilePath(path, "card", c.Slug, opts)
+	return nil
+}
+
+// Writes the collections, tables, cards, and dashboards that have been imported to Terraform files.
+// If requested, the provider configuration is also written, such that the output can be used as is.
+// All files are parsed before any of them is written, such that the importer never produces invalid HCL.
+func (ic *ImportContext) Write(path string, opts WriteOptions) error {
+	files, err := ic.makeGeneratedFiles(path, opts)
+	if err != nil {
+		return err
+	}
 
-		err := os.WriteFile(path, []byte(c.Hcl), 0644)
+	for _, f := range files {
+		err := validateGeneratedFile(f)
 		if err != nil {
 			return err
 		}
 	}
 
-	for _, d := range ic.dashboards {
-		path := makeFilePath(path, "dashboard", d.Slug, opts)
+	if opts.ClearOutput {
+		err := clearOutput(path, opts)
+		if err != nil {
+			return err
+		}
+	}
 
-		err := os.WriteFile(path, []byte(d.Hcl), 0644)
+	for _, f := range files {
+		err := os.WriteFile(f.Path, []byte(f.Hcl), 0644)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,78 @@
+package importer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMakeProviderConfigHcl(t *testing.T) {
+	hcl, err := makeProviderConfigHcl(ProviderConfigOptions{VersionConstraint: "~> 0.8"})
+	if err != nil {
+		t.Fatalf("Unexpected error when making provider configuration: %s", err)
+	}
+
+	if !strings.Contains(hcl, `source = "flovouin/metabase"`) || !strings.Contains(hcl, `version = "~> 0.8"`) {
+		t.Errorf("Expected the provider source and version to be pinned, got: %s", hcl)
+	}
+	if strings.Contains(hcl, `provider "metabase"`) {
+		t.Errorf("Expected no provider block by default, got: %s", hcl)
+	}
+
+	hcl, err = makeProviderConfigHcl(ProviderConfigOptions{
+		EmitProviderBlock: true,
+		Endpoint:          "https://metabase.example.com/api",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error when making provider configuration: %s", err)
+	}
+
+	if strings.Contains(hcl, "version") {
+		t.Errorf("Expected no version constraint, got: %s", hcl)
+	}
+	if !strings.Contains(hcl, `endpoint = "https://metabase.example.com/api"`) {
+		t.Errorf("Expected the provider block to set the endpoint, got: %s", hcl)
+	}
+}
+
+func TestWriteProviderConfig(t *testing.T) {
+	ic := newTestImportContext(t, nil)
+	path := t.TempDir()
+
+	err := ic.Write(path, WriteOptions{
+		DisableFormatting: true,
+		ProviderConfig:    &ProviderConfigOptions{},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error when writing files: %s", err)
+	}
+
+	_, err = os.Stat(filepath.Join(path, "mb-gen-versions.tf"))
+	if err != nil {
+		t.Errorf("Expected the provider configuration to be written: %s", err)
+	}
+}
+
+func TestWriteFailsOnInvalidHcl(t *testing.T) {
+	ic := newTestImportContext(t, nil)
+	ic.tables[1] = importedTable{Slug: "valid", Hcl: "resource \"metabase_table\" \"valid\" {\n  id = 1\n}\n"}
+	ic.cards[2] = importedCard{Slug: "invalid", Hcl: "resource \"metabase_card\" \"invalid\" {\n  json = jsonencode({\n}\n"}
+	path := t.TempDir()
+
+	err := ic.Write(path, WriteOptions{DisableFormatting: true})
+	if err == nil {
+		t.Fatal("Expected an error when writing invalid HCL.")
+	}
+	if !strings.Contains(err.Error(), "mb-gen-card-invalid.tf") {
+		t.Errorf("Expected the error to reference the invalid file, got: %s", err)
+	}
+
+	files, err := os.ReadDir(path)
+	if err != nil {
+		t.Fatalf("Unexpected error when listing files: %s", err)
+	}
+	if len(files) != 0 {
+		t.Errorf("Expected no file to be written, got %d files.", len(files))
+	}
+}
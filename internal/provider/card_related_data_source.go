@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CardRelatedDataSource{}
+
+// Creates a new card related data source.
+func NewCardRelatedDataSource() datasource.DataSource {
+	return &CardRelatedDataSource{}
+}
+
+// A data source listing the cards and dashboards Metabase considers related to a card.
+// This can be used to discover cards when assembling dashboards programmatically.
+type CardRelatedDataSource struct {
+	// The Metabase API client.
+	client *metabase.ClientWithResponses
+}
+
+// The Terraform model for the objects related to a card.
+type CardRelatedDataSourceModel struct {
+	CardId           types.Int64 `tfsdk:"card_id"`           // The ID of the card.
+	SimilarQuestions types.List  `tfsdk:"similar_questions"` // Questions similar to the card.
+	DashboardMates   types.List  `tfsdk:"dashboard_mates"`   // The cards placed in the same dashboards as the card.
+	Dashboards       types.List  `tfsdk:"dashboards"`        // The dashboards recommended as related to the card, which may not contain it.
+}
+
+// The object type for a single object related to a card.
+var cardRelatedItemObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":   types.Int64Type,
+		"name": types.StringType,
+	},
+}
+
+func (d *CardRelatedDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_card_related"
+}
+
+// Makes the schema for a list of objects related to a card.
+func makeCardRelatedItemsAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.Int64Attribute{
+					MarkdownDescription: "The ID of the related object.",
+					Computed:            true,
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The name of the related object.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func (d *CardRelatedDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The cards and dashboards related to a Metabase card (question), as suggested by Metabase.
+
+This can help discovering cards when assembling dashboards programmatically. The suggestions are computed by Metabase and may change as questions and dashboards are added.`,
+
+		Attributes: map[string]schema.Attribute{
+			"card_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the card.",
+				Required:            true,
+			},
+			"similar_questions": makeCardRelatedItemsAttribute("The questions similar to the card, e.g. querying the same table."),
+			"dashboard_mates":   makeCardRelatedItemsAttribute("The cards placed in the same dashboards as the card."),
+			"dashboards":        makeCardRelatedItemsAttribute("The dashboards recommended by Metabase as related to the card, which do not necessarily contain it. This list is capped, and should not be used to find the dashboards using the card."),
+		},
+	}
+}
+
+func (d *CardRelatedDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*metabase.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected client type when configuring Metabase data source.",
+			fmt.Sprintf("Expected *metabase.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Converts a possibly `nil` list of related objects returned by the Metabase API to a Terraform list.
+func makeCardRelatedItemsList(items *[]metabase.CardRelatedItem) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := []attr.Value{}
+	if items != nil {
+		for _, item := range *items {
+			value, objectDiags := types.ObjectValue(cardRelatedItemObjectType.AttrTypes, map[string]attr.Value{
+				"id":   types.Int64Value(int64(item.Id)),
+				"name": types.StringValue(item.Name),
+			})
+			diags.Append(objectDiags...)
+			if diags.HasError() {
+				return types.ListNull(cardRelatedItemObjectType), diags
+			}
+
+			values = append(values, value)
+		}
+	}
+
+	list, listDiags := types.ListValue(cardRelatedItemObjectType, values)
+	diags.Append(listDiags...)
+
+	return list, diags
+}
+
+// Updates the given `CardRelatedDataSourceModel` from the related objects returned by the Metabase API.
+func updateModelFromCardRelated(related metabase.CardRelated, data *CardRelatedDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	similarQuestions, listDiags := makeCardRelatedItemsList(related.SimilarQuestions)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	dashboardMates, listDiags := makeCardRelatedItemsList(related.DashboardMates)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	dashboards, listDiags := makeCardRelatedItemsList(related.Dashboards)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.SimilarQuestions = similarQuestions
+	data.DashboardMates = dashboardMates
+	data.Dashboards = dashboards
+
+	return diags
+}
+
+func (d *CardRelatedDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CardRelatedDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResp, err := d.client.GetCardRelatedWithResponse(ctx, int(data.CardId.ValueInt64()))
+
+	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200}, "get card related")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromCardRelated(*getResp.JSON200, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUpdateModelFromCardRelated(t *testing.T) {
+	var related metabase.CardRelated
+	err := json.Unmarshal([]byte(`{
+		"table": {"id": 5},
+		"similar-questions": [{"id": 2, "name": "Orders by month", "display": "line"}],
+		"dashboards": [{"id": 7, "name": "Sales"}]
+	}`), &related)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var data CardRelatedDataSourceModel
+	diags := updateModelFromCardRelated(related, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	similarQuestions := data.SimilarQuestions.Elements()
+	if len(similarQuestions) != 1 {
+		t.Fatalf("Expected 1 similar question, got %d.", len(similarQuestions))
+	}
+	if name := similarQuestions[0].(types.Object).Attributes()["name"].String(); name != `"Orders by month"` {
+		t.Errorf("Expected the name of the similar question, got %s.", name)
+	}
+
+	// Missing lists should be empty rather than null, such that they can be iterated over.
+	if data.DashboardMates.IsNull() || len(data.DashboardMates.Elements()) != 0 {
+		t.Errorf("Expected an empty list of dashboard mates, got %s.", data.DashboardMates.String())
+	}
+	if len(data.Dashboards.Elements()) != 1 {
+		t.Errorf("Expected 1 dashboard, got %d.", len(data.Dashboards.Elements()))
+	}
+}
@@ -2,17 +2,27 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"os"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // The list of JSON attributes in a Card object that should be persisted in the state. Those are also the attributes
@@ -31,8 +41,33 @@ var allowedCardAttributes = map[string]bool{
 	"visualization_settings": true,
 }
 
+// The list of JSON attributes in a Card object that can be managed but are not required in the input JSON definition.
+// Those attributes are only persisted in the state if they are part of the input JSON definition. This avoids diffs for
+// configurations that do not set them, as Metabase always returns them.
+var optionalCardAttributes = map[string]bool{
+	"collection_preview":            true,
+	metabase.CardTypeAttribute:      true,
+	metabase.CardIsDatasetAttribute: true,
+}
+
+// The list of JSON attributes in a Card object that are set by Metabase and cannot be managed by the provider. A
+// warning is raised when one of them is found in the input JSON definition. They are removed before sending the
+// definition to Metabase, and ignored when comparing the definition to the Metabase response.
+var serverOwnedCardAttributes = map[string]bool{
+	"archived":          true,
+	"archived_directly": true,
+	"created_at":        true,
+	"creator_id":        true,
+	"entity_id":         true,
+	"id":                true,
+	"updated_at":        true,
+}
+
 // Ensures provider defined types fully satisfy framework interfaces.
 var _ resource.ResourceWithImportState = &CardResource{}
+var _ resource.ResourceWithValidateConfig = &CardResource{}
+var _ resource.ResourceWithModifyPlan = &CardResource{}
+var _ resource.ResourceWithConfigValidators = &CardResource{}
 
 // Creates a new card resource.
 func NewCardResource() resource.Resource {
@@ -48,18 +83,36 @@ type CardResource struct {
 
 // The Terraform model for a card.
 // Because it is a complex object with many possible attributes, the entire structure is not exposed from Terraform. The
-// card's definition should simply be passed as a JSON string, possibly using a template. Only the ID is exposed, as it
-// is only known once the card is created.
+// card's definition should simply be passed as a JSON string, possibly using a template, or read from a file. Only the
+// ID is exposed, as it is only known once the card is created.
 type CardResourceModel struct {
-	Id   types.Int64  `tfsdk:"id"`   // The ID of the card.
-	Json types.String `tfsdk:"json"` // The entire definition of the card, as a JSON string.
+	Id                        types.Int64  `tfsdk:"id"`                          // The ID of the card.
+	Json                      types.String `tfsdk:"json"`                        // The entire definition of the card, as a JSON string.
+	JsonFile                  types.String `tfsdk:"json_file"`                   // The path to a file containing the definition of the card.
+	JsonFileHash              types.String `tfsdk:"json_file_hash"`              // The SHA-256 hash of the content of `json_file`.
+	Url                       types.String `tfsdk:"url"`                         // The URL to the card in the Metabase UI.
+	PreserveUnknownAttributes types.Bool   `tfsdk:"preserve_unknown_attributes"` // Whether unknown attributes in the definition should be managed.
+	DatabaseId                types.Int64  `tfsdk:"database_id"`                 // Overrides the database queried by the card.
+	EntityId                  types.String `tfsdk:"entity_id"`                   // A unique string identifier, used by serialization.
+	PreventArchiveIfInUse     types.Bool   `tfsdk:"prevent_archive_if_in_use"`   // Whether archiving the card should fail if it is used by dashboards.
+	Force                     types.Bool   `tfsdk:"force"`                       // Whether the card should be archived even if it is used by dashboards.
 }
 
 func (r *CardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `A Metabase card (question).
 
-Because the content of a card is complex and can vary a lot between cards, the full schema is not defined in Terraform, and a JSON string should be used instead. You can use templatefile or jsonencode to make the experience smoother.`,
+Because the content of a card is complex and can vary a lot between cards, the full schema is not defined in Terraform, and a JSON string should be used instead. You can use templatefile or jsonencode to make the experience smoother.
+
+The following attributes of the JSON definition are managed by the provider and should always be specified: cache_ttl, collection_id, collection_position, dataset_query, description, display, name, parameter_mappings, parameters, query_type, and visualization_settings. The collection_preview, type (e.g. model), and dataset (used by older Metabase versions to flag models) attributes can also be managed, but they are only compared to the Metabase response when they are specified. Other attributes are owned by Metabase (e.g. archived, archived_directly, created_at, entity_id, updated_at) and should not be part of the definition. If they are (e.g. when copying an exported card), they are not sent to Metabase and do not cause a diff.
+
+To place a card in the root collection, collection_id should be null. Unlike collections and the collection graph, the "root" string is not accepted for cards.
+
+Instead of json, the json_file attribute can point to a file containing the definition. The file is read when planning, and a change to its content is detected using its hash. This avoids passing large definitions through file() in the configuration.
+
+The database queried by the card can be set using database_id rather than in the dataset_query of the definition. This is convenient when the same definition is used across environments, where only the database differs. When database_id is set, it takes precedence over the database in the definition, which can then be omitted.
+
+When the definition changes, the provider checks that the database and tables referenced by the query exist in the Metabase instance it is configured for, and that the tables belong to the database. A warning is raised otherwise. This helps catching references to objects from another Metabase instance when several provider aliases are used.`,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
@@ -68,13 +121,349 @@ Because the content of a card is complex and can vary a lot between cards, the f
 				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"json": schema.StringAttribute{
-				MarkdownDescription: "The full card definition as a JSON string.",
-				Required:            true,
+				MarkdownDescription: "The full card definition as a JSON string. When `json_file` is set, this is the content of the file.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"json_file": schema.StringAttribute{
+				MarkdownDescription: "The path to a file containing the full card definition as JSON. Conflicts with `json`.",
+				Optional:            true,
+			},
+			"json_file_hash": schema.StringAttribute{
+				MarkdownDescription: "The SHA-256 hash of the content of `json_file`, used to detect changes to the file.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL to the card in the Metabase UI, built from the provider endpoint.",
+				Computed:            true,
+			},
+			"preserve_unknown_attributes": schema.BoolAttribute{
+				MarkdownDescription: "Whether attributes of the JSON definition which are not known by the provider should be managed, rather than being dropped when reading the card. This allows managing attributes introduced by newer versions of Metabase. Server-owned attributes are still ignored. Defaults to `false`.",
+				Optional:            true,
+			},
+			"entity_id": schema.StringAttribute{
+				MarkdownDescription: "A unique string identifier for the card, used by Metabase serialization.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"database_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the database queried by the card. When set, this overrides `dataset_query.database` in the JSON definition, and changes made to the database outside of Terraform are reported on this attribute.",
+				Optional:            true,
+			},
+			"prevent_archive_if_in_use": schema.BoolAttribute{
+				MarkdownDescription: "Whether destroying the resource should fail if the card is part of dashboards, as archiving it would break them. The error lists the dashboards using the card. Defaults to `false`.",
+				Optional:            true,
+			},
+			"force": schema.BoolAttribute{
+				MarkdownDescription: "Whether the card should be archived even if `prevent_archive_if_in_use` is set and the card is part of dashboards. As for other attributes, this should be applied before destroying the resource. Defaults to `false`.",
+				Optional:            true,
 			},
 		},
 	}
 }
 
+func (r *CardResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("json"),
+			path.MatchRoot("json_file"),
+		),
+	}
+}
+
+// Checks that the `collection_id` of a card definition is either null or a positive integer.
+// Unlike collections, cards placed in the root collection use a null `collection_id` rather than `"root"`.
+func validateCardCollectionId(attributePath path.Path, collectionId interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if collectionId == nil {
+		return diags
+	}
+
+	id, ok := collectionId.(float64)
+	if ok && id >= 1 && id == float64(int64(id)) {
+		return diags
+	}
+
+	detail := fmt.Sprintf("The collection_id of a card should either be null or a positive integer, got: %v.", collectionId)
+	if collectionId == "root" {
+		detail = "Cards in the root collection should use a null collection_id. Unlike the collection resources, the \"root\" string is not accepted by Metabase for cards."
+	}
+
+	diags.AddAttributeError(attributePath, "Invalid collection_id in the card definition.", detail)
+
+	return diags
+}
+
+// Checks that the card JSON definition can be parsed, and warns about server-owned attributes it contains.
+// Diagnostics are reported on the given attribute, which is either `json` or `json_file`.
+func validateCardJson(attributePath path.Path, cardJson string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var card map[string]interface{}
+	err := json.Unmarshal([]byte(cardJson), &card)
+	if err != nil {
+		diags.AddAttributeError(attributePath, "Unable to parse the card JSON definition.", err.Error())
+		return diags
+	}
+
+	diags.Append(validateCardCollectionId(attributePath, card["collection_id"])...)
+
+	for key := range card {
+		if serverOwnedCardAttributes[key] {
+			diags.AddAttributeWarning(
+				attributePath,
+				"Found a server-owned attribute in the card definition.",
+				fmt.Sprintf("The %s attribute is set by Metabase and cannot be managed by the provider. It should be removed from the card definition.", key),
+			)
+		}
+	}
+
+	return diags
+}
+
+func (r *CardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CardResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Json.IsNull() || data.Json.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateCardJson(path.Root("json"), data.Json.ValueString())...)
+}
+
+// Reads the card definition from the file at the given path, returning its content and its SHA-256 hash.
+func readCardJsonFile(filePath string) (string, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		diags.AddAttributeError(path.Root("json_file"), "Unable to read the card JSON definition file.", err.Error())
+		return "", "", diags
+	}
+
+	hash := sha256.Sum256(content)
+
+	return string(content), hex.EncodeToString(hash[:]), diags
+}
+
+// Recursively finds the (integer) IDs of the tables referenced by `source-table` attributes in a card's query.
+// References to other cards (e.g. `card__1`) are ignored.
+func collectSourceTableIds(obj interface{}, tableIds map[int]bool) {
+	switch typedObj := obj.(type) {
+	case map[string]interface{}:
+		for k, v := range typedObj {
+			if tableIdFloat, ok := v.(float64); ok && k == metabase.SourceTableAttribute {
+				tableIds[int(tableIdFloat)] = true
+				continue
+			}
+
+			collectSourceTableIds(v, tableIds)
+		}
+	case []interface{}:
+		for _, v := range typedObj {
+			collectSourceTableIds(v, tableIds)
+		}
+	}
+}
+
+// Sets the database queried by the card in its `dataset_query`, if the `database_id` attribute is known and set. The
+// card is modified in place.
+func setCardDatabaseId(card map[string]interface{}, databaseId types.Int64) {
+	if databaseId.IsNull() || databaseId.IsUnknown() {
+		return
+	}
+
+	datasetQuery, ok := card[metabase.DatasetQueryAttribute].(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	datasetQuery[metabase.DatabaseAttribute] = float64(databaseId.ValueInt64())
+}
+
+// Returns the ID of the database queried by the card, or null if it cannot be found in its `dataset_query`.
+func getCardDatabaseId(card map[string]interface{}) types.Int64 {
+	datasetQuery, ok := card[metabase.DatasetQueryAttribute].(map[string]interface{})
+	if !ok {
+		return types.Int64Null()
+	}
+
+	databaseIdFloat, ok := datasetQuery[metabase.DatabaseAttribute].(float64)
+	if !ok {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(databaseIdFloat))
+}
+
+// Replaces the database in the `dataset_query` of the card returned by the Metabase API with the one in the existing
+// definition, or removes it if the definition does not specify a database. This is used when the database is managed
+// using the `database_id` attribute, such that it does not cause a diff on the JSON definition. The card is modified in
+// place.
+func restoreCardDatabase(card map[string]interface{}, existingCard map[string]interface{}) {
+	datasetQuery, ok := card[metabase.DatasetQueryAttribute].(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	existingDatasetQuery, ok := existingCard[metabase.DatasetQueryAttribute].(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	existingDatabase, ok := existingDatasetQuery[metabase.DatabaseAttribute]
+	if !ok {
+		delete(datasetQuery, metabase.DatabaseAttribute)
+		return
+	}
+
+	datasetQuery[metabase.DatabaseAttribute] = existingDatabase
+}
+
+// Checks that the database and tables referenced in the query of a card exist in the Metabase instance, and that the
+// tables belong to the database. Only warnings are returned if this is not the case, as this cannot be guaranteed to be
+// an error (e.g. objects could be created in the same apply).
+// The database ID, if set, overrides the database in the definition.
+func checkCardReferencesExist(ctx context.Context, client metabase.ClientWithResponsesInterface, cardJson string, databaseIdOverride types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var card map[string]interface{}
+	err := json.Unmarshal([]byte(cardJson), &card)
+	if err != nil {
+		return diags
+	}
+
+	setCardDatabaseId(card, databaseIdOverride)
+
+	datasetQuery, ok := card[metabase.DatasetQueryAttribute].(map[string]interface{})
+	if !ok {
+		return diags
+	}
+
+	databaseIdFloat, ok := datasetQuery[metabase.DatabaseAttribute].(float64)
+	if !ok {
+		return diags
+	}
+	databaseId := int(databaseIdFloat)
+
+	getDbResp, err := client.GetDatabaseWithResponse(ctx, databaseId)
+	dbStatus, statusDiags := checkMetabaseResponseStatus(getDbResp, err, []int{200}, "get database")
+	if statusDiags.HasError() {
+		// Failing to fetch the database (e.g. with a non-admin API key) should not prevent planning, as the check is only
+		// informative.
+		tflog.Warn(ctx, "Unable to check whether the database referenced by the card exists.", map[string]interface{}{
+			"diagnostics": statusDiags,
+		})
+		return diags
+	}
+
+	if dbStatus == metabaseResponseNotFound {
+		diags.AddAttributeWarning(
+			path.Root("json"),
+			"The database referenced by the card could not be found.",
+			fmt.Sprintf("Database %d does not exist in the Metabase instance. If several provider aliases are used, make sure the card and the database are managed by the same provider.", databaseId),
+		)
+		return diags
+	}
+
+	tableIds := make(map[int]bool)
+	collectSourceTableIds(datasetQuery, tableIds)
+
+	for tableId := range tableIds {
+		getTableResp, err := client.GetTableMetadataWithResponse(ctx, tableId, &metabase.GetTableMetadataParams{})
+		tableStatus, statusDiags := checkMetabaseResponseStatus(getTableResp, err, []int{200}, "get table metadata")
+		if statusDiags.HasError() {
+			tflog.Warn(ctx, "Unable to check whether the tables referenced by the card exist.", map[string]interface{}{
+				"diagnostics": statusDiags,
+			})
+			return diags
+		}
+
+		if tableStatus == metabaseResponseNotFound {
+			diags.AddAttributeWarning(
+				path.Root("json"),
+				"A table referenced by the card could not be found.",
+				fmt.Sprintf("Table %d does not exist in the Metabase instance. If several provider aliases are used, make sure the card and the table are managed by the same provider.", tableId),
+			)
+			continue
+		}
+
+		if getTableResp.JSON200.DbId != databaseId {
+			diags.AddAttributeWarning(
+				path.Root("json"),
+				"A table referenced by the card is not part of the card's database.",
+				fmt.Sprintf("Table %d belongs to database %d, but the card queries database %d. This could be caused by referencing objects from another Metabase instance.", tableId, getTableResp.JSON200.DbId, databaseId),
+			)
+		}
+	}
+
+	return diags
+}
+
+func (r *CardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan CardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The definition is resolved from the file when planning, such that changes to its content are shown in the plan.
+	// If the path is not known yet, the definition and its hash are left unknown.
+	if plan.JsonFile.IsNull() {
+		plan.JsonFileHash = types.StringNull()
+	} else if !plan.JsonFile.IsUnknown() {
+		cardJson, hash, diags := readCardJsonFile(plan.JsonFile.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(validateCardJson(path.Root("json_file"), cardJson)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		plan.Json = types.StringValue(cardJson)
+		plan.JsonFileHash = types.StringValue(hash)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// References cannot be checked if the provider has not been configured yet.
+	if r.client == nil || plan.Json.IsNull() || plan.Json.IsUnknown() {
+		return
+	}
+
+	// References are only checked when the definition of the card changes, to avoid unnecessary calls to the API.
+	if !req.State.Raw.IsNull() {
+		var state CardResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if state.Json.Equal(plan.Json) && state.DatabaseId.Equal(plan.DatabaseId) {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(checkCardReferencesExist(ctx, r.client, plan.Json.ValueString(), plan.DatabaseId)...)
+}
+
 // Parses the (integer) ID of the card from a raw Card JSON object returned by the Metabase API.
 func getIdFromRawCard(card map[string]interface{}, strResp string) (types.Int64, diag.Diagnostics) {
 	idAny, ok := card["id"]
@@ -98,8 +487,269 @@ func getIdFromRawCard(card map[string]interface{}, strResp string) (types.Int64,
 	return types.Int64Value(int64(idFloat)), diag.Diagnostics{}
 }
 
+// Makes the body sent to the Metabase API when creating or updating a card, from the JSON definition in the model.
+// Server-owned attributes are removed from the definition, as they cannot be set by the provider. The database is
+// overridden by the `database_id` attribute, if it is set.
+func makeCardRequestBody(data CardResourceModel) (*strings.Reader, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var card map[string]interface{}
+	err := json.Unmarshal([]byte(data.Json.ValueString()), &card)
+	if err != nil {
+		diags.AddError("Unable to parse the card JSON definition.", err.Error())
+		return nil, diags
+	}
+
+	for key := range serverOwnedCardAttributes {
+		delete(card, key)
+	}
+
+	setCardDatabaseId(card, data.DatabaseId)
+
+	body, err := json.Marshal(card)
+	if err != nil {
+		diags.AddError("Error serializing the card JSON definition.", err.Error())
+		return nil, diags
+	}
+
+	return strings.NewReader(string(body)), diags
+}
+
+// Normalizes the reference to a field in the `values_source_config` of a card-sourced parameter.
+// Metabase may add the `base-type` option to the reference, e.g. `["field", 12, {"base-type": "type/Text"}]`, which is
+// dropped. Other options (e.g. `join-alias`) are meaningful and kept.
+func normalizeValuesSourceFieldRef(ref interface{}) interface{} {
+	refArray, ok := ref.([]interface{})
+	if !ok || len(refArray) < 2 {
+		return ref
+	}
+
+	return dropFieldRefBaseType(refArray)
+}
+
+// Normalizes the parameters of a card for which values are sourced from another card, such that reformatting of the
+// `values_source_config` by Metabase does not cause a diff. The card is modified in place.
+func normalizeCardSourcedParameters(card map[string]interface{}) {
+	parameters, ok := card["parameters"].([]interface{})
+	if !ok {
+		return
+	}
+
+	for _, p := range parameters {
+		parameter, ok := p.(map[string]interface{})
+		if !ok || parameter["values_source_type"] != "card" {
+			continue
+		}
+
+		config, ok := parameter["values_source_config"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// The card ID may be specified as a string, although Metabase always returns a number.
+		if cardIdStr, ok := config["card_id"].(string); ok {
+			if cardId, err := strconv.ParseFloat(cardIdStr, 64); err == nil {
+				config["card_id"] = cardId
+			}
+		}
+
+		for _, key := range []string{"value_field", "label_field"} {
+			if ref, ok := config[key]; ok {
+				config[key] = normalizeValuesSourceFieldRef(ref)
+			}
+		}
+	}
+}
+
+// Drops the `base-type` option Metabase may add to a field reference, e.g. `["field", 12, {"base-type": "type/Text"}]`.
+// The options are set to null if no other option remains, and are added if they are missing. The reference is modified
+// in place and returned.
+func dropFieldRefBaseType(fieldRef []interface{}) []interface{} {
+	if len(fieldRef) < 3 {
+		fieldRef = append(fieldRef, nil)
+	}
+
+	if options, ok := fieldRef[2].(map[string]interface{}); ok {
+		delete(options, "base-type")
+		if len(options) == 0 {
+			fieldRef[2] = nil
+		}
+	}
+
+	return fieldRef
+}
+
+// Makes the canonical form of a key in the `column_settings` of a card. Keys are field references serialized as JSON,
+// e.g. `["ref",["field",12,null]]`. The key is re-serialized without insignificant whitespace, and the `base-type`
+// option Metabase may add to the field reference is dropped, such that the same reference always yields the same key.
+// Keys which are not references to fields are returned unchanged.
+func normalizeColumnSettingsKey(key string) string {
+	var keyArray []interface{}
+	err := json.Unmarshal([]byte(key), &keyArray)
+	if err != nil || len(keyArray) < 2 || keyArray[0] != metabase.FieldReferenceLiteral {
+		return key
+	}
+
+	fieldRef, ok := keyArray[1].([]interface{})
+	if !ok || len(fieldRef) < 2 || fieldRef[0] != metabase.FieldLiteral {
+		return key
+	}
+	keyArray[1] = dropFieldRefBaseType(fieldRef)
+
+	normalizedKey, err := json.Marshal(keyArray)
+	if err != nil {
+		return key
+	}
+
+	return string(normalizedKey)
+}
+
+// Normalizes the keys of the `column_settings` in the visualization settings of a card, such that the references to
+// fields written in the definition compare equal to the ones returned by Metabase. The card is modified in place.
+func normalizeCardColumnSettings(card map[string]interface{}) {
+	visualizationSettings, ok := card[metabase.VisualizationSettingsAttribute].(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	columnSettings, ok := visualizationSettings[metabase.ColumnSettingsAttribute].(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	normalizedColumnSettings := make(map[string]interface{}, len(columnSettings))
+	for k, v := range columnSettings {
+		normalizedColumnSettings[normalizeColumnSettingsKey(k)] = v
+	}
+
+	visualizationSettings[metabase.ColumnSettingsAttribute] = normalizedColumnSettings
+}
+
+// Removes the attributes of a template tag or parameter which are set to their default value, i.e. a `false`
+// `required` flag and a null `default`. Metabase may either add or omit them when saving a native card.
+func dropDefaultParameterAttributes(obj map[string]interface{}) {
+	if required, ok := obj["required"]; ok && required == false {
+		delete(obj, "required")
+	}
+
+	if defaultValue, ok := obj["default"]; ok && defaultValue == nil {
+		delete(obj, "default")
+	}
+}
+
+// Returns the template tags of a native card, or nil if the card does not define a native query.
+func getCardTemplateTags(card map[string]interface{}) map[string]interface{} {
+	datasetQuery, ok := card[metabase.DatasetQueryAttribute].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	nativeQuery, ok := datasetQuery[metabase.NativeQueryAttribute].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	templateTags, _ := nativeQuery[metabase.TemplateTagsAttribute].(map[string]interface{})
+	return templateTags
+}
+
+// Normalizes the template tags in the native query of a card, and the parameters of the card, such that defaults and
+// field references reformatted by Metabase do not cause a diff. The card is modified in place.
+func normalizeCardTemplateTags(card map[string]interface{}) {
+	for _, t := range getCardTemplateTags(card) {
+		tag, ok := t.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		dropDefaultParameterAttributes(tag)
+
+		if fieldRef, ok := tag["dimension"].([]interface{}); ok && len(fieldRef) >= 2 && fieldRef[0] == metabase.FieldLiteral {
+			tag["dimension"] = dropFieldRefBaseType(fieldRef)
+		}
+	}
+
+	parameters, _ := card[metabase.ParametersAttribute].([]interface{})
+	for _, p := range parameters {
+		if parameter, ok := p.(map[string]interface{}); ok {
+			dropDefaultParameterAttributes(parameter)
+		}
+	}
+}
+
+// Returns whether each parameter of the card corresponds to a template tag in its native query, based on their IDs.
+// When a native card is saved without parameters, Metabase infers them from the template tags.
+func areParametersInferredFromTemplateTags(card map[string]interface{}) bool {
+	parameters, ok := card[metabase.ParametersAttribute].([]interface{})
+	if !ok || len(parameters) == 0 {
+		return false
+	}
+
+	templateTagIds := make(map[string]bool)
+	for _, t := range getCardTemplateTags(card) {
+		if tag, ok := t.(map[string]interface{}); ok {
+			if id, ok := tag["id"].(string); ok {
+				templateTagIds[id] = true
+			}
+		}
+	}
+
+	for _, p := range parameters {
+		parameter, ok := p.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		id, _ := parameter["id"].(string)
+		if !templateTagIds[id] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Compares the card returned by the Metabase API with the one in the Terraform state/plan, after normalizing parts of
+// the definition that Metabase may reformat.
+func areCardsEquivalent(card map[string]interface{}, existingCard map[string]interface{}) bool {
+	if reflect.DeepEqual(card, existingCard) {
+		return true
+	}
+
+	// Normalization is performed on copies, such that the cards themselves are left untouched.
+	var normalizedCards [2]map[string]interface{}
+	for i, c := range []map[string]interface{}{card, existingCard} {
+		cardBytes, err := json.Marshal(c)
+		if err != nil {
+			return false
+		}
+
+		err = json.Unmarshal(cardBytes, &normalizedCards[i])
+		if err != nil {
+			return false
+		}
+
+		normalizeCardSourcedParameters(normalizedCards[i])
+		normalizeCardColumnSettings(normalizedCards[i])
+		normalizeCardTemplateTags(normalizedCards[i])
+	}
+
+	// Parameters inferred by Metabase from the template tags are ignored if the definition does not set any parameter.
+	existingParameters, hasParameters := normalizedCards[1][metabase.ParametersAttribute]
+	if existingParametersList, _ := existingParameters.([]interface{}); len(existingParametersList) == 0 && areParametersInferredFromTemplateTags(normalizedCards[0]) {
+		if hasParameters {
+			normalizedCards[0][metabase.ParametersAttribute] = existingParameters
+		} else {
+			delete(normalizedCards[0], metabase.ParametersAttribute)
+		}
+	}
+
+	return reflect.DeepEqual(normalizedCards[0], normalizedCards[1])
+}
+
 // Updates the given `CardResourceModel` from the `Card` returned by the Metabase API.
-func updateModelFromCardBytes(cardBytes []byte, data *CardResourceModel) diag.Diagnostics {
+// The site URL is used to build the link to the card.
+func updateModelFromCardBytes(cardBytes []byte, siteUrl string, data *CardResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	// Unmarshalling to a map such that we can perform low-level JSON manipulation on the card.
@@ -117,12 +767,12 @@ func updateModelFromCardBytes(cardBytes []byte, data *CardResourceModel) diag.Di
 	}
 	data.Id = idValue
 
-	// Only keeping the attributes that are expected to be found in the Terraform definition (JSON string) provided by the
-	// user. This also removes the `id`, as it is not provided by the user but returned by the Metabase API.
-	for key := range card {
-		if !allowedCardAttributes[key] {
-			delete(card, key)
-		}
+	name, _ := card["name"].(string)
+	data.Url = makeObjectUrl(siteUrl, "question", fmt.Sprint(idValue.ValueInt64()), name)
+
+	data.EntityId = types.StringNull()
+	if entityId, ok := card["entity_id"].(string); ok {
+		data.EntityId = types.StringValue(entityId)
 	}
 
 	// Unmarshals the card from the plan or state, i.e. the known and expected configuration for the card.
@@ -133,6 +783,38 @@ func updateModelFromCardBytes(cardBytes []byte, data *CardResourceModel) diag.Di
 			diags.AddError("Error deserializing existing card JSON value.", err.Error())
 			return diags
 		}
+
+		// Server-owned attributes are not sent to Metabase and should not be compared with the response.
+		for key := range serverOwnedCardAttributes {
+			delete(existingCard, key)
+		}
+	}
+
+	// When the database is managed using `database_id`, the attribute reflects the database returned by Metabase, while
+	// the JSON definition keeps the database it was written with.
+	if !data.DatabaseId.IsNull() {
+		data.DatabaseId = getCardDatabaseId(card)
+
+		if existingCard != nil {
+			restoreCardDatabase(card, existingCard)
+		}
+	}
+
+	// Only keeping the attributes that are expected to be found in the Terraform definition (JSON string) provided by the
+	// user. This also removes the `id`, as it is not provided by the user but returned by the Metabase API.
+	// When unknown attributes are preserved, any attribute in the definition is kept, similarly to optional attributes.
+	preserveUnknownAttributes := data.PreserveUnknownAttributes.ValueBool()
+	for key := range card {
+		if allowedCardAttributes[key] {
+			continue
+		}
+
+		_, isInExistingCard := existingCard[key]
+		if isInExistingCard && (optionalCardAttributes[key] || preserveUnknownAttributes) {
+			continue
+		}
+
+		delete(card, key)
 	}
 
 	// If the existing card is different from the response from the API, updates the JSON string by remarshalling the
@@ -141,8 +823,8 @@ func updateModelFromCardBytes(cardBytes []byte, data *CardResourceModel) diag.Di
 	// - When reading the card, if it has been modified outside of Terraform (in which case an update will be planned).
 	// Any other case (e.g. an inconsistency between the Terraform definition and the Metabase API) will result in a
 	// Terraform error.
-	if existingCard == nil || !reflect.DeepEqual(card, existingCard) {
-		jsonCard, err := json.Marshal(card)
+	if existingCard == nil || !areCardsEquivalent(card, existingCard) {
+		jsonCard, err := marshalOpaqueJson(card, existingCard != nil)
 		if err != nil {
 			diags.AddError("Error serializing new JSON value.", err.Error())
 			return diags
@@ -162,7 +844,12 @@ func (r *CardResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	bodyReader := strings.NewReader(data.Json.ValueString())
+	bodyReader, diags := makeCardRequestBody(*data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createResp, err := r.client.CreateCardWithBodyWithResponse(ctx, "application/json", bodyReader)
 
 	resp.Diagnostics.Append(checkMetabaseResponse(createResp, err, []int{200}, "create card")...)
@@ -170,7 +857,7 @@ func (r *CardResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	resp.Diagnostics.Append(updateModelFromCardBytes(createResp.Body, data)...)
+	resp.Diagnostics.Append(updateModelFromCardBytes(createResp.Body, r.siteUrl, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -188,17 +875,23 @@ func (r *CardResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	getResp, err := r.client.GetCardWithResponse(ctx, int(data.Id.ValueInt64()))
 
-	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200, 404}, "get card")...)
+	status, diags := checkMetabaseResponseStatus(getResp, err, []int{200}, "get card")
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if getResp.StatusCode() == 404 || getResp.JSON200.Archived {
-		resp.State.RemoveResource(ctx)
+	if status == metabaseResponseNotFound {
+		removeMissingResourceFromState(ctx, resp, "card", data.Id.ValueInt64(), "not found")
 		return
 	}
 
-	resp.Diagnostics.Append(updateModelFromCardBytes(getResp.Body, data)...)
+	if getResp.JSON200.Archived {
+		removeMissingResourceFromState(ctx, resp, "card", data.Id.ValueInt64(), "archived")
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromCardBytes(getResp.Body, r.siteUrl, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -214,7 +907,12 @@ func (r *CardResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	bodyReader := strings.NewReader(data.Json.ValueString())
+	bodyReader, diags := makeCardRequestBody(*data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updateResp, err := r.client.UpdateCardWithBodyWithResponse(ctx, int(data.Id.ValueInt64()), "application/json", bodyReader)
 
 	resp.Diagnostics.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update card")...)
@@ -222,7 +920,7 @@ func (r *CardResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	resp.Diagnostics.Append(updateModelFromCardBytes(updateResp.Body, data)...)
+	resp.Diagnostics.Append(updateModelFromCardBytes(updateResp.Body, r.siteUrl, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -230,6 +928,29 @@ func (r *CardResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// Returns an error listing the given dashboards if it is not empty, as the card cannot be archived without breaking
+// them.
+func checkCardNotInDashboards(cardId int64, dashboards []metabase.CardDashboard) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(dashboards) == 0 {
+		return diags
+	}
+
+	names := make([]string, 0, len(dashboards))
+	for _, d := range dashboards {
+		names = append(names, fmt.Sprintf("%s (%d)", d.Name, d.Id))
+	}
+	sort.Strings(names)
+
+	diags.AddError(
+		"Unable to archive a card used by dashboards.",
+		fmt.Sprintf("Card %d is used by the following dashboards: %s. Remove the card from the dashboards first, or set force to true to archive it anyway.", cardId, strings.Join(names, ", ")),
+	)
+
+	return diags
+}
+
 func (r *CardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data *CardResourceModel
 
@@ -238,6 +959,21 @@ func (r *CardResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	if data.PreventArchiveIfInUse.ValueBool() && !data.Force.ValueBool() {
+		// Only the dashboards containing the card are listed, unlike the "related" dashboards which are recommendations.
+		dashboardsResp, err := r.client.GetCardDashboardsWithResponse(ctx, int(data.Id.ValueInt64()))
+
+		resp.Diagnostics.Append(checkMetabaseResponse(dashboardsResp, err, []int{200}, "get card dashboards")...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(checkCardNotInDashboards(data.Id.ValueInt64(), *dashboardsResp.JSON200)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Deletion is deprecated, the card should be archived instead.
 	archived := true
 	updateResp, err := r.client.UpdateCardWithResponse(ctx, int(data.Id.ValueInt64()), metabase.UpdateCardBody{
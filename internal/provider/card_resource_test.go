@@ -2,10 +2,21 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -114,3 +125,395 @@ func TestAccCardResource(t *testing.T) {
 		},
 	})
 }
+
+func TestCollectSourceTableIds(t *testing.T) {
+	var query map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+  "database": 1,
+  "query": {
+    "source-query": { "source-table": 2 },
+    "joins": [{ "source-table": 3 }, { "source-table": "card__4" }]
+  }
+}`), &query)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tableIds := make(map[int]bool)
+	collectSourceTableIds(query, tableIds)
+
+	expected := map[int]bool{2: true, 3: true}
+	if !reflect.DeepEqual(tableIds, expected) {
+		t.Errorf("Expected table IDs %v, got %v.", expected, tableIds)
+	}
+}
+
+func TestCheckCardReferencesExistOnlyWarns(t *testing.T) {
+	testCases := []struct {
+		databaseStatus   int
+		tableStatus      int
+		expectedWarnings int
+	}{
+		{200, 200, 0},
+		{404, 200, 1},
+		{200, 404, 1},
+		{403, 200, 0},
+		{200, 500, 0},
+	}
+
+	for _, tc := range testCases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+
+			switch r.URL.Path {
+			case "/database/1":
+				w.WriteHeader(tc.databaseStatus)
+				w.Write([]byte(`{"id": 1, "name": "Warehouse", "engine": "postgres", "details": {}}`))
+			case "/table/2/query_metadata":
+				w.WriteHeader(tc.tableStatus)
+				w.Write([]byte(`{"id": 2, "db_id": 1, "name": "orders", "display_name": "Orders", "entity_type": "entity/GenericTable", "fields": []}`))
+			default:
+				t.Errorf("Unexpected request: %s %s.", r.Method, r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+		client, err := metabase.NewClientWithResponses(server.URL)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v.", err)
+		}
+
+		diags := checkCardReferencesExist(context.Background(), client, `{"dataset_query": {"database": 1, "query": {"source-table": 2}}}`, types.Int64Null())
+		server.Close()
+
+		if diags.HasError() {
+			t.Errorf("Expected only warnings for statuses %d and %d, got: %v.", tc.databaseStatus, tc.tableStatus, diags)
+		}
+		if diags.WarningsCount() != tc.expectedWarnings {
+			t.Errorf("Expected %d warnings for statuses %d and %d, got: %v.", tc.expectedWarnings, tc.databaseStatus, tc.tableStatus, diags)
+		}
+	}
+}
+
+func TestServerOwnedCardAttributesDoNotCauseDrift(t *testing.T) {
+	definition := `{"name":"Card","description":null,"entity_id":"abcdefghijklmnopqrstu"}`
+	data := CardResourceModel{
+		Json: types.StringValue(definition),
+	}
+
+	body, diags := makeCardRequestBody(data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	bodyBytes, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedBody := `{"description":null,"name":"Card"}`
+	if string(bodyBytes) != expectedBody {
+		t.Errorf("Expected body %s, got %s.", expectedBody, string(bodyBytes))
+	}
+
+	// Metabase returns its own entity ID, which should not be compared to the one in the definition.
+	diags = updateModelFromCardBytes([]byte(`{"id":1,"name":"Card","description":null,"entity_id":"vwxyzabcdefghijklmnop"}`), "https://metabase.example.com", &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	if data.Json.ValueString() != definition {
+		t.Errorf("Expected JSON %s to be unchanged, got %s.", definition, data.Json.ValueString())
+	}
+	if data.EntityId.ValueString() != "vwxyzabcdefghijklmnop" {
+		t.Errorf("Expected the entity ID returned by Metabase, got %s.", data.EntityId.String())
+	}
+}
+
+func TestReadCardJsonFile(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "card.json")
+	err := os.WriteFile(filePath, []byte(`{"name":"Card"}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cardJson, hash, diags := readCardJsonFile(filePath)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	if cardJson != `{"name":"Card"}` {
+		t.Errorf("Expected the content of the file, got %s.", cardJson)
+	}
+
+	expectedHash := "ea3d1a3d40f44e774c0acbc564b2e53220f4d57ca8637fc2d78560c8dd6509b7"
+	if hash != expectedHash {
+		t.Errorf("Expected hash %s, got %s.", expectedHash, hash)
+	}
+
+	_, _, diags = readCardJsonFile(filepath.Join(t.TempDir(), "missing.json"))
+	if !diags.HasError() {
+		t.Errorf("Expected an error when the file does not exist.")
+	}
+}
+
+func TestValidateCardJsonCollectionId(t *testing.T) {
+	validDefinitions := []string{
+		`{"name":"Card","collection_id":null}`,
+		`{"name":"Card","collection_id":12}`,
+		`{"name":"Card"}`,
+	}
+	for _, definition := range validDefinitions {
+		diags := validateCardJson(path.Root("json"), definition)
+		if diags.HasError() {
+			t.Errorf("Expected %s to be valid, got %v.", definition, diags)
+		}
+	}
+
+	invalidDefinitions := []string{
+		`{"name":"Card","collection_id":"root"}`,
+		`{"name":"Card","collection_id":"12"}`,
+		`{"name":"Card","collection_id":0}`,
+		`{"name":"Card","collection_id":1.5}`,
+	}
+	for _, definition := range invalidDefinitions {
+		diags := validateCardJson(path.Root("json"), definition)
+		if !diags.HasError() {
+			t.Errorf("Expected %s to be invalid.", definition)
+		}
+	}
+}
+
+func TestCardSourcedParametersDoNotCauseDrift(t *testing.T) {
+	definition := `{"name":"Card","parameters":[{"id":"abc","values_source_type":"card","values_source_config":{"card_id":"12","value_field":["field",34,null]}}]}`
+	data := CardResourceModel{
+		Json: types.StringValue(definition),
+	}
+
+	// Metabase returns the card ID as a number, and adds options to the field reference.
+	diags := updateModelFromCardBytes([]byte(`{"id":1,"name":"Card","parameters":[{"id":"abc","values_source_type":"card","values_source_config":{"card_id":12,"value_field":["field",34,{"base-type":"type/Text"}]}}]}`), "https://metabase.example.com", &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	if data.Json.ValueString() != definition {
+		t.Errorf("Expected JSON %s to be unchanged, got %s.", definition, data.Json.ValueString())
+	}
+
+	// Referencing another card should still be detected as a change.
+	diags = updateModelFromCardBytes([]byte(`{"id":1,"name":"Card","parameters":[{"id":"abc","values_source_type":"card","values_source_config":{"card_id":13,"value_field":["field",34,null]}}]}`), "https://metabase.example.com", &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	if data.Json.ValueString() == definition {
+		t.Errorf("Expected JSON to reflect the new source card.")
+	}
+
+	// Options other than the base type are meaningful, e.g. to reference a field from a joined table.
+	data.Json = types.StringValue(definition)
+	diags = updateModelFromCardBytes([]byte(`{"id":1,"name":"Card","parameters":[{"id":"abc","values_source_type":"card","values_source_config":{"card_id":12,"value_field":["field",34,{"base-type":"type/Text","join-alias":"Orders"}]}}]}`), "https://metabase.example.com", &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	if data.Json.ValueString() == definition || !strings.Contains(data.Json.ValueString(), "join-alias") {
+		t.Errorf("Expected JSON to reflect the join alias, got %s.", data.Json.ValueString())
+	}
+}
+
+func TestPreserveUnknownCardAttributes(t *testing.T) {
+	definition := `{"name":"Card","new_feature":{"enabled":true}}`
+	response := []byte(`{"id":1,"name":"Card","new_feature":{"enabled":true},"other_feature":1}`)
+
+	data := CardResourceModel{
+		Json:                      types.StringValue(definition),
+		PreserveUnknownAttributes: types.BoolValue(true),
+	}
+
+	diags := updateModelFromCardBytes(response, "https://metabase.example.com", &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	if data.Json.ValueString() != definition {
+		t.Errorf("Expected JSON %s to be unchanged, got %s.", definition, data.Json.ValueString())
+	}
+
+	data = CardResourceModel{
+		Json: types.StringValue(definition),
+	}
+
+	diags = updateModelFromCardBytes(response, "https://metabase.example.com", &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	expected := `{"name":"Card"}`
+	if compactJson(t, data.Json.ValueString()) != expected {
+		t.Errorf("Expected unknown attributes to be dropped by default, got %s.", data.Json.ValueString())
+	}
+}
+
+func TestCardDatabaseIdOverridesDefinition(t *testing.T) {
+	definition := `{"dataset_query":{"type":"native"},"name":"Card"}`
+	data := CardResourceModel{
+		Json:       types.StringValue(definition),
+		DatabaseId: types.Int64Value(3),
+	}
+
+	body, diags := makeCardRequestBody(data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	bodyBytes, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedBody := `{"dataset_query":{"database":3,"type":"native"},"name":"Card"}`
+	if string(bodyBytes) != expectedBody {
+		t.Errorf("Expected body %s, got %s.", expectedBody, string(bodyBytes))
+	}
+
+	diags = updateModelFromCardBytes([]byte(`{"id":1,"name":"Card","dataset_query":{"database":3,"type":"native"}}`), "https://metabase.example.com", &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	if data.Json.ValueString() != definition {
+		t.Errorf("Expected JSON %s to be unchanged, got %s.", definition, data.Json.ValueString())
+	}
+	if data.DatabaseId.ValueInt64() != 3 {
+		t.Errorf("Expected database 3, got %s.", data.DatabaseId.String())
+	}
+
+	// A database changed outside of Terraform is reported on the attribute rather than on the definition.
+	diags = updateModelFromCardBytes([]byte(`{"id":1,"name":"Card","dataset_query":{"database":5,"type":"native"}}`), "https://metabase.example.com", &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	if data.Json.ValueString() != definition {
+		t.Errorf("Expected JSON %s to be unchanged, got %s.", definition, data.Json.ValueString())
+	}
+	if data.DatabaseId.ValueInt64() != 5 {
+		t.Errorf("Expected database 5, got %s.", data.DatabaseId.String())
+	}
+}
+
+func TestCardColumnSettingsDoNotCauseDrift(t *testing.T) {
+	definition := `{"name":"Card","visualization_settings":{"column_settings":{"[\"ref\", [\"field\", 34, null]]":{"column_title":"Total"},"[\"name\",\"count\"]":{"show_mini_bar":true}}}}`
+	data := CardResourceModel{
+		Json: types.StringValue(definition),
+	}
+
+	// Metabase re-serializes the keys without spaces, and adds options to the field reference.
+	diags := updateModelFromCardBytes([]byte(`{"id":1,"name":"Card","visualization_settings":{"column_settings":{"[\"ref\",[\"field\",34,{\"base-type\":\"type/Integer\"}]]":{"column_title":"Total"},"[\"name\",\"count\"]":{"show_mini_bar":true}}}}`), "https://metabase.example.com", &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	if data.Json.ValueString() != definition {
+		t.Errorf("Expected JSON %s to be unchanged, got %s.", definition, data.Json.ValueString())
+	}
+
+	// Settings for another field should still be detected as a change.
+	diags = updateModelFromCardBytes([]byte(`{"id":1,"name":"Card","visualization_settings":{"column_settings":{"[\"ref\",[\"field\",35,null]]":{"column_title":"Total"},"[\"name\",\"count\"]":{"show_mini_bar":true}}}}`), "https://metabase.example.com", &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	if data.Json.ValueString() == definition {
+		t.Errorf("Expected JSON to reflect the settings of the new field.")
+	}
+}
+
+func TestNativeCardWithTemplateTagsDoesNotCauseDrift(t *testing.T) {
+	definition := `{"dataset_query":{"database":1,"native":{"query":"SELECT * FROM orders WHERE {{created_at}} AND status = {{status}} AND total > {{min_total}}","template-tags":{"created_at":{"dimension":["field",12,null],"display-name":"Created at","id":"a1","name":"created_at","type":"dimension","widget-type":"date/all-options"},"min_total":{"default":100,"display-name":"Minimum total","id":"c3","name":"min_total","required":true,"type":"number"},"status":{"display-name":"Status","id":"b2","name":"status","type":"text"}}},"type":"native"},"name":"Card","parameters":[{"id":"a1","name":"Created at","slug":"created_at","target":["dimension",["template-tag","created_at"]],"type":"date/all-options"},{"id":"b2","name":"Status","slug":"status","target":["variable",["template-tag","status"]],"type":"category"},{"default":100,"id":"c3","name":"Minimum total","required":true,"slug":"min_total","target":["variable",["template-tag","min_total"]],"type":"number/="}]}`
+	data := CardResourceModel{
+		Json: types.StringValue(definition),
+	}
+
+	// Metabase adds default attributes to template tags and parameters, and options to the field reference.
+	diags := updateModelFromCardBytes([]byte(`{"id":1,"name":"Card","dataset_query":{"database":1,"type":"native","native":{"query":"SELECT * FROM orders WHERE {{created_at}} AND status = {{status}} AND total > {{min_total}}","template-tags":{"created_at":{"dimension":["field",12,{"base-type":"type/DateTime"}],"display-name":"Created at","id":"a1","name":"created_at","type":"dimension","widget-type":"date/all-options","default":null,"required":false},"min_total":{"default":100,"display-name":"Minimum total","id":"c3","name":"min_total","required":true,"type":"number"},"status":{"display-name":"Status","id":"b2","name":"status","type":"text","default":null}}}},"parameters":[{"id":"a1","name":"Created at","slug":"created_at","target":["dimension",["template-tag","created_at"]],"type":"date/all-options","required":false},{"id":"b2","name":"Status","slug":"status","target":["variable",["template-tag","status"]],"type":"category","default":null},{"default":100,"id":"c3","name":"Minimum total","required":true,"slug":"min_total","target":["variable",["template-tag","min_total"]],"type":"number/="}]}`), "https://metabase.example.com", &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	if data.Json.ValueString() != definition {
+		t.Errorf("Expected JSON %s to be unchanged, got %s.", definition, data.Json.ValueString())
+	}
+
+	// Changing the default value of a parameter should still be detected.
+	diags = updateModelFromCardBytes([]byte(`{"id":1,"name":"Card","dataset_query":{"database":1,"type":"native","native":{"query":"SELECT * FROM orders WHERE {{created_at}} AND status = {{status}} AND total > {{min_total}}","template-tags":{"created_at":{"dimension":["field",12,null],"display-name":"Created at","id":"a1","name":"created_at","type":"dimension","widget-type":"date/all-options"},"min_total":{"default":200,"display-name":"Minimum total","id":"c3","name":"min_total","required":true,"type":"number"},"status":{"display-name":"Status","id":"b2","name":"status","type":"text"}}}},"parameters":[{"id":"a1","name":"Created at","slug":"created_at","target":["dimension",["template-tag","created_at"]],"type":"date/all-options"},{"id":"b2","name":"Status","slug":"status","target":["variable",["template-tag","status"]],"type":"category"},{"default":200,"id":"c3","name":"Minimum total","required":true,"slug":"min_total","target":["variable",["template-tag","min_total"]],"type":"number/="}]}`), "https://metabase.example.com", &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	if data.Json.ValueString() == definition {
+		t.Errorf("Expected JSON to reflect the new default value.")
+	}
+}
+
+func TestNativeCardInferredParametersDoNotCauseDrift(t *testing.T) {
+	definition := `{"dataset_query":{"database":1,"native":{"query":"SELECT * FROM orders WHERE status = {{status}}","template-tags":{"status":{"display-name":"Status","id":"b2","name":"status","type":"text"}}},"type":"native"},"name":"Card","parameters":[]}`
+	data := CardResourceModel{
+		Json: types.StringValue(definition),
+	}
+
+	// Metabase infers the parameters from the template tags when none are set.
+	diags := updateModelFromCardBytes([]byte(`{"id":1,"name":"Card","dataset_query":{"database":1,"type":"native","native":{"query":"SELECT * FROM orders WHERE status = {{status}}","template-tags":{"status":{"display-name":"Status","id":"b2","name":"status","type":"text"}}}},"parameters":[{"id":"b2","name":"Status","slug":"status","target":["variable",["template-tag","status"]],"type":"category"}]}`), "https://metabase.example.com", &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	if data.Json.ValueString() != definition {
+		t.Errorf("Expected JSON %s to be unchanged, got %s.", definition, data.Json.ValueString())
+	}
+}
+
+func TestNormalizeColumnSettingsKey(t *testing.T) {
+	testCases := map[string]string{
+		`["ref", ["field", 34, null]]`:                                        `["ref",["field",34,null]]`,
+		`["ref",["field",34]]`:                                                `["ref",["field",34,null]]`,
+		`["ref",["field",34,{"base-type":"type/Text"}]]`:                      `["ref",["field",34,null]]`,
+		`["ref",["field",34,{"base-type":"type/Text","join-alias":"Users"}]]`: `["ref",["field",34,{"join-alias":"Users"}]]`,
+		`["name","count"]`:                                                    `["name","count"]`,
+		`not json`:                                                            `not json`,
+	}
+
+	for key, expected := range testCases {
+		if normalized := normalizeColumnSettingsKey(key); normalized != expected {
+			t.Errorf("Expected key %s to be normalized to %s, got %s.", key, expected, normalized)
+		}
+	}
+}
+
+func TestCheckCardNotInDashboards(t *testing.T) {
+	diags := checkCardNotInDashboards(3, nil)
+	if diags.HasError() {
+		t.Errorf("Unexpected error for a card not used by dashboards: %v.", diags)
+	}
+
+	// Payload returned by `GET /api/card/:id/dashboards`.
+	var dashboards []metabase.CardDashboard
+	err := json.Unmarshal([]byte(`[
+		{"id": 5, "name": "Sales", "collection_id": 4, "archived": false},
+		{"id": 2, "name": "Overview", "collection_id": null, "archived": false}
+	]`), &dashboards)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diags = checkCardNotInDashboards(3, dashboards)
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("Expected one error for a card used by dashboards, got %d.", diags.ErrorsCount())
+	}
+
+	expected := "Card 3 is used by the following dashboards: Overview (2), Sales (5). Remove the card from the dashboards first, or set force to true to archive it anyway."
+	if diags[0].Detail() != expected {
+		t.Errorf("Expected error detail %q, got %q.", expected, diags[0].Detail())
+	}
+}
@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The type of moderated items corresponding to cards.
+const moderatedItemTypeCard = "card"
+
+// The moderation review status marking an item as verified.
+const moderationReviewStatusVerified = "verified"
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithImportState = &CardVerificationResource{}
+
+// Creates a new card verification resource.
+func NewCardVerificationResource() resource.Resource {
+	return &CardVerificationResource{
+		MetabaseBaseResource{name: "card_verification"},
+	}
+}
+
+// A resource handling the verification of a card, using Metabase moderation reviews.
+// This is only available in paid versions of Metabase.
+type CardVerificationResource struct {
+	MetabaseBaseResource
+}
+
+// The Terraform model for a card verification.
+type CardVerificationResourceModel struct {
+	Id     types.Int64  `tfsdk:"id"`      // The ID of the most recent moderation review.
+	CardId types.Int64  `tfsdk:"card_id"` // The ID of the reviewed card.
+	Status types.String `tfsdk:"status"`  // The status of the review, `verified` or null.
+}
+
+func (r *CardVerificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The verification status of a Metabase card (question), stored by Metabase as a moderation review.
+
+Verification is only available in paid versions of Metabase. Each change to the status creates a new moderation review, and the most recent review determines the status of the card. Destroying the resource removes the verification.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the most recent moderation review for the card.",
+				Computed:            true,
+			},
+			"card_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the reviewed card.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the card. Can be `verified`, or null to explicitly mark the card as not verified.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(moderationReviewStatusVerified),
+				},
+			},
+		},
+	}
+}
+
+// Returns the most recent moderation review from the list returned by the Metabase API, or `nil` if there is none.
+func findMostRecentModerationReview(reviews *[]metabase.ModerationReview) *metabase.ModerationReview {
+	if reviews == nil {
+		return nil
+	}
+
+	for _, review := range *reviews {
+		if review.MostRecent {
+			return &review
+		}
+	}
+
+	return nil
+}
+
+// Updates the given `CardVerificationResourceModel` from the `ModerationReview` returned by the Metabase API.
+func updateModelFromModerationReview(review metabase.ModerationReview, data *CardVerificationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.Int64Value(int64(review.Id))
+	data.CardId = types.Int64Value(int64(review.ModeratedItemId))
+	data.Status = stringValueOrNull(review.Status)
+
+	return diags
+}
+
+// Creates a new moderation review for the card, with the given status.
+func (r *CardVerificationResource) createReview(ctx context.Context, cardId int, status *string) (*metabase.ModerationReview, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	createResp, err := r.client.CreateModerationReviewWithResponse(ctx, metabase.CreateModerationReviewBody{
+		ModeratedItemId:   cardId,
+		ModeratedItemType: moderatedItemTypeCard,
+		Status:            status,
+	})
+
+	diags.Append(checkMetabaseResponse(createResp, err, []int{200, 402, 404}, "create moderation review")...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if metabase.IsPaidFeatureUnavailable(createResp) {
+		diags.AddError("Card verification is not available in this Metabase instance.", "Moderation reviews are only available in paid versions of Metabase (Pro or Enterprise).")
+		return nil, diags
+	}
+
+	// Other 404 responses, e.g. when the card does not exist, are reported as unexpected.
+	diags.Append(checkMetabaseResponse(createResp, nil, []int{200}, "create moderation review")...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return createResp.JSON200, diags
+}
+
+func (r *CardVerificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CardVerificationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	review, diags := r.createReview(ctx, int(data.CardId.ValueInt64()), valueStringOrNull(data.Status))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromModerationReview(*review, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CardVerificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CardVerificationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Moderation reviews are returned along with the card.
+	getResp, err := r.client.GetCardWithResponse(ctx, int(data.CardId.ValueInt64()))
+
+	status, diags := checkMetabaseResponseStatus(getResp, err, []int{200}, "get card")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if status == metabaseResponseNotFound {
+		removeMissingResourceFromState(ctx, resp, "card", data.CardId.ValueInt64(), "not found")
+		return
+	}
+
+	if getResp.JSON200.Archived {
+		removeMissingResourceFromState(ctx, resp, "card", data.CardId.ValueInt64(), "archived")
+		return
+	}
+
+	review := findMostRecentModerationReview(getResp.JSON200.ModerationReviews)
+	if review == nil {
+		removeMissingResourceFromState(ctx, resp, "card verification", data.CardId.ValueInt64(), "no moderation review")
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromModerationReview(*review, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CardVerificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CardVerificationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reviews cannot be modified, a new one is created instead.
+	review, diags := r.createReview(ctx, int(data.CardId.ValueInt64()), valueStringOrNull(data.Status))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromModerationReview(*review, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CardVerificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *CardVerificationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// There is nothing to dismiss if the card is not verified.
+	if data.Status.IsNull() {
+		return
+	}
+
+	// Reviews cannot be deleted, the verification is dismissed by creating a review without status.
+	_, diags := r.createReview(ctx, int(data.CardId.ValueInt64()), nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *CardVerificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The verification is identified by the card, as the ID of the most recent review changes with each update.
+	cardId, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to convert card ID to an integer.", req.ID)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("card_id"), cardId)...)
+}
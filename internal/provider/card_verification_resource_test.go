@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+)
+
+func TestFindMostRecentModerationReview(t *testing.T) {
+	if review := findMostRecentModerationReview(nil); review != nil {
+		t.Errorf("expected no review, got %v", review)
+	}
+
+	status := moderationReviewStatusVerified
+	reviews := []metabase.ModerationReview{
+		{Id: 1, ModeratedItemId: 2, ModeratedItemType: moderatedItemTypeCard, Status: &status},
+		{Id: 3, ModeratedItemId: 2, ModeratedItemType: moderatedItemTypeCard, MostRecent: true},
+	}
+
+	review := findMostRecentModerationReview(&reviews)
+	if review == nil || review.Id != 3 {
+		t.Fatalf("expected review 3, got %v", review)
+	}
+
+	var data CardVerificationResourceModel
+	diags := updateModelFromModerationReview(*review, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if data.CardId.ValueInt64() != 2 || !data.Status.IsNull() {
+		t.Errorf("expected card 2 with a null status, got %v", data)
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/flovouin/terraform-provider-metabase/metabase"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,6 +15,7 @@ import (
 
 // Ensures provider defined types fully satisfy framework interfaces.
 var _ resource.ResourceWithImportState = &CollectionGraphResource{}
+var _ resource.ResourceWithValidateConfig = &CollectionGraphResource{}
 
 // Creates a new collection graph resource.
 func NewCollectionGraphResource() resource.Resource {
@@ -62,7 +62,7 @@ Permissions for the Administrators group cannot be changed. To avoid issues duri
 			},
 			"ignored_groups": schema.SetAttribute{
 				ElementType:         types.Int64Type,
-				MarkdownDescription: "The list of group IDs that should be ignored when reading and updating permissions. By default, this contains the Administrators group (`[2]`).",
+				MarkdownDescription: "The list of group IDs that should be ignored when reading and updating permissions. By default, this contains the Administrators group (`[2]`), unless `manage_admin_permissions` is set in the provider configuration.",
 				Optional:            true,
 			},
 			"permissions": schema.SetNestedAttribute{
@@ -118,12 +118,13 @@ func makePermissionObjectFromPermission(ctx context.Context, groupId string, col
 }
 
 // Updates the given `CollectionGraphResourceModel` from the `CollectionPermissionsGraph` returned by the Metabase API.
-func updateModelFromCollectionPermissionsGraph(ctx context.Context, g metabase.CollectionPermissionsGraph, data *CollectionGraphResourceModel) diag.Diagnostics {
+// `manageAdminPermissions` is the provider setting determining whether the Administrators group is ignored by default.
+func updateModelFromCollectionPermissionsGraph(ctx context.Context, g metabase.CollectionPermissionsGraph, manageAdminPermissions bool, data *CollectionGraphResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	data.Revision = types.Int64Value(int64(g.Revision))
 
-	ignoredGroups, groupsDiags := getIgnoredPermissionsGroups(ctx, data.IgnoredGroups)
+	ignoredGroups, groupsDiags := getIgnoredPermissionsGroups(ctx, data.IgnoredGroups, manageAdminPermissions)
 	diags.Append(groupsDiags...)
 	if diags.HasError() {
 		return diags
@@ -259,6 +260,22 @@ func makeCollectionPermissionsGraphFromModel(ctx context.Context, data Collectio
 	}, diags
 }
 
+func (r *CollectionGraphResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CollectionGraphResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The provider may not be configured yet, in which case the warning is raised regardless of the provider setting.
+	if r.manageAdminPermissions {
+		return
+	}
+
+	resp.Diagnostics.Append(checkIgnoredGroupsContainAdministrators(ctx, data.IgnoredGroups)...)
+}
+
 func (r *CollectionGraphResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	resp.Diagnostics.AddError("Creating the permissions graph is not allowed, import it instead.", "")
 }
@@ -278,7 +295,7 @@ func (r *CollectionGraphResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	resp.Diagnostics.Append(updateModelFromCollectionPermissionsGraph(ctx, *getResp.JSON200, data)...)
+	resp.Diagnostics.Append(updateModelFromCollectionPermissionsGraph(ctx, *getResp.JSON200, r.manageAdminPermissions, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -307,14 +324,23 @@ func (r *CollectionGraphResource) Update(ctx context.Context, req resource.Updat
 			return
 		}
 
-		updateResp, err := r.client.ReplaceCollectionPermissionsGraphWithResponse(ctx, *body)
-
-		resp.Diagnostics.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update collection graph")...)
+		updateResp, diags := updateGraphWithRevisionRetry(
+			ctx,
+			r.client,
+			body.Revision,
+			func(revision int) (*metabase.ReplaceCollectionPermissionsGraphResponse, error) {
+				body.Revision = revision
+				return r.client.ReplaceCollectionPermissionsGraphWithResponse(ctx, *body)
+			},
+			getCollectionGraphRevision,
+			"update collection graph",
+		)
+		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 
-		resp.Diagnostics.Append(updateModelFromCollectionPermissionsGraph(ctx, *updateResp.JSON200, data)...)
+		resp.Diagnostics.Append(updateModelFromCollectionPermissionsGraph(ctx, *updateResp.JSON200, r.manageAdminPermissions, data)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -333,12 +359,17 @@ func (r *CollectionGraphResource) Delete(ctx context.Context, req resource.Delet
 	)
 }
 
-func (r *CollectionGraphResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	revision, err := strconv.Atoi(req.ID)
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to convert revision to an integer.", req.ID)
-		return
+// Fetches the current revision of the collection permissions graph.
+func getCollectionGraphRevision(ctx context.Context, client metabase.ClientWithResponsesInterface) (int, diag.Diagnostics) {
+	getResp, err := client.GetCollectionPermissionsGraphWithResponse(ctx)
+	diags := checkMetabaseResponse(getResp, err, []int{200}, "read collection graph")
+	if diags.HasError() {
+		return 0, diags
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("revision"), revision)...)
+	return getResp.JSON200.Revision, diags
+}
+
+func (r *CollectionGraphResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateGraphRevision(ctx, r.client, req, resp, getCollectionGraphRevision)
 }
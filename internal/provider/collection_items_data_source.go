@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CollectionItemsDataSource{}
+
+// Creates a new collection items data source.
+func NewCollectionItemsDataSource() datasource.DataSource {
+	return &CollectionItemsDataSource{}
+}
+
+// A data source listing the items (cards, dashboards, sub-collections, etc) in a single Metabase collection.
+type CollectionItemsDataSource struct {
+	// The Metabase API client.
+	client *metabase.ClientWithResponses
+}
+
+// The Terraform model for the items in a collection.
+type CollectionItemsDataSourceModel struct {
+	CollectionId types.String `tfsdk:"collection_id"` // The ID of the collection.
+	Models       types.List   `tfsdk:"models"`        // The types of items to list.
+	Archived     types.Bool   `tfsdk:"archived"`      // Whether archived items should be listed instead of active ones.
+	Items        types.List   `tfsdk:"items"`         // The list of items in the collection.
+}
+
+// The object type for a single item in a collection.
+var collectionItemObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":          types.Int64Type,
+		"model":       types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+		"entity_id":   types.StringType,
+	},
+}
+
+func (d *CollectionItemsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection_items"
+}
+
+func (d *CollectionItemsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The items in a single Metabase collection, e.g. its cards, dashboards, and sub-collections.
+
+All the items are listed, by requesting successive pages from the Metabase API.`,
+
+		Attributes: map[string]schema.Attribute{
+			"collection_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the collection. This can be `root` for the root collection.",
+				Required:            true,
+			},
+			"models": schema.ListAttribute{
+				MarkdownDescription: "The types of items to list, e.g. `card`, `dataset` (models), `dashboard`, or `collection`. All types are listed by default.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(
+						string(metabase.CollectionItemModelCard),
+						string(metabase.CollectionItemModelCollection),
+						string(metabase.CollectionItemModelDashboard),
+						string(metabase.CollectionItemModelDataset),
+						string(metabase.CollectionItemModelNoModels),
+						string(metabase.CollectionItemModelPulse),
+						string(metabase.CollectionItemModelSnippet),
+						string(metabase.CollectionItemModelTimeline),
+					)),
+				},
+			},
+			"archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether archived items should be listed instead of active ones. Defaults to `false`.",
+				Optional:            true,
+			},
+			"items": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of items in the collection, in the order returned by Metabase.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the item.",
+							Computed:            true,
+						},
+						"model": schema.StringAttribute{
+							MarkdownDescription: "The type of the item, e.g. `card` or `dashboard`.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the item.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "A description for the item.",
+							Computed:            true,
+						},
+						"entity_id": schema.StringAttribute{
+							MarkdownDescription: "A unique string identifier for the item, used by Metabase serialization.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CollectionItemsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*metabase.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected client type when configuring Metabase data source.",
+			fmt.Sprintf("Expected *metabase.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Makes the parameters used to list the items in a collection from the data source configuration.
+func makeListCollectionItemsParams(ctx context.Context, data CollectionItemsDataSourceModel) (*metabase.ListCollectionItemsParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	params := metabase.ListCollectionItemsParams{
+		Archived: valueBoolOrNull(data.Archived),
+	}
+
+	if !data.Models.IsNull() {
+		var models []metabase.CollectionItemModel
+		diags.Append(data.Models.ElementsAs(ctx, &models, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		params.Models = &models
+	}
+
+	return &params, diags
+}
+
+// Updates the given `CollectionItemsDataSourceModel` from the list of items returned by the Metabase API.
+func updateModelFromCollectionItems(items []metabase.CollectionItem, data *CollectionItemsDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	itemsList := make([]attr.Value, 0, len(items))
+	for _, item := range items {
+		itemObject, objectDiags := types.ObjectValue(collectionItemObjectType.AttrTypes, map[string]attr.Value{
+			"id":          types.Int64Value(int64(item.Id)),
+			"model":       types.StringValue(string(item.Model)),
+			"name":        types.StringValue(item.Name),
+			"description": stringValueOrNull(item.Description),
+			"entity_id":   types.StringValue(item.EntityId),
+		})
+		diags.Append(objectDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		itemsList = append(itemsList, itemObject)
+	}
+
+	itemsValue, listDiags := types.ListValue(collectionItemObjectType, itemsList)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Items = itemsValue
+
+	return diags
+}
+
+func (d *CollectionItemsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CollectionItemsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := makeListCollectionItemsParams(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, err := metabase.ListAllCollectionItems(ctx, d.client, data.CollectionId.ValueString(), *params)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected error while calling the Metabase API for operation 'list collection items'.", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromCollectionItems(items, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUpdateModelFromCollectionItems(t *testing.T) {
+	var items []metabase.CollectionItem
+	err := json.Unmarshal([]byte(`[
+		{"id": 4, "model": "dashboard", "name": "Overview", "description": null, "entity_id": "abc"},
+		{"id": 2, "model": "card", "name": "Revenue", "description": "Monthly revenue", "entity_id": "def"}
+	]`), &items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var data CollectionItemsDataSourceModel
+	diags := updateModelFromCollectionItems(items, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	elements := data.Items.Elements()
+	if len(elements) != 2 {
+		t.Fatalf("Expected 2 items, got %d.", len(elements))
+	}
+
+	first := elements[0].(types.Object).Attributes()
+	if !first["model"].Equal(types.StringValue("dashboard")) || !first["description"].IsNull() {
+		t.Errorf("Expected the first item to be a dashboard without description, got %v.", first)
+	}
+}
+
+func TestMakeListCollectionItemsParams(t *testing.T) {
+	ctx := context.Background()
+
+	params, diags := makeListCollectionItemsParams(ctx, CollectionItemsDataSourceModel{
+		Models:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("dataset")}),
+		Archived: types.BoolNull(),
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if params.Models == nil || len(*params.Models) != 1 || (*params.Models)[0] != metabase.CollectionItemModelDataset {
+		t.Errorf("Expected the models filter to be set, got %v.", params.Models)
+	}
+	if params.Archived != nil {
+		t.Errorf("Expected the archived filter not to be set.")
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -20,6 +21,7 @@ import (
 
 // Ensures provider defined types fully satisfy framework interfaces.
 var _ resource.ResourceWithImportState = &CollectionResource{}
+var _ resource.ResourceWithModifyPlan = &CollectionResource{}
 
 // Creates a new collection resource.
 func NewCollectionResource() resource.Resource {
@@ -35,18 +37,29 @@ type CollectionResource struct {
 
 // The Terraform model for a collection.
 type CollectionResourceModel struct {
-	Id          types.String `tfsdk:"id"`          // The ID of the collection.
-	Name        types.String `tfsdk:"name"`        // The name of the collection.
-	Description types.String `tfsdk:"description"` // A description for the collection.
-	Slug        types.String `tfsdk:"slug"`        // The slug used in URLs.
-	EntityId    types.String `tfsdk:"entity_id"`   // A unique string identifier.
-	Location    types.String `tfsdk:"location"`    // A path-like location, useful for sub-collections.
-	ParentId    types.Int64  `tfsdk:"parent_id"`   // The ID of the parent collection, if any.
+	Id              types.String `tfsdk:"id"`                // The ID of the collection.
+	Name            types.String `tfsdk:"name"`              // The name of the collection.
+	Description     types.String `tfsdk:"description"`       // A description for the collection.
+	Slug            types.String `tfsdk:"slug"`              // The slug used in URLs.
+	EntityId        types.String `tfsdk:"entity_id"`         // A unique string identifier.
+	Location        types.String `tfsdk:"location"`          // A path-like location, useful for sub-collections.
+	ParentId        types.Int64  `tfsdk:"parent_id"`         // The ID of the parent collection, if any.
+	Url             types.String `tfsdk:"url"`               // The URL to the collection in the Metabase UI.
+	Archived        types.Bool   `tfsdk:"archived"`          // Whether the collection is archived (in the trash).
+	PersonalOwnerId types.Int64  `tfsdk:"personal_owner_id"` // The ID of the user owning the collection, if it is a personal collection.
+	Type            types.String `tfsdk:"type"`              // The type of the collection, only set for special collections managed by Metabase.
+	AllowPersonal   types.Bool   `tfsdk:"allow_personal"`    // Whether a personal collection can be managed by the resource.
 }
 
 func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "A Metabase collection.",
+		MarkdownDescription: `A Metabase collection.
+
+Deleting the resource archives the collection, i.e. moves it to the trash. If a collection managed by Terraform is archived outside of Terraform, it is restored (unarchived) during the next apply, rather than being recreated. A collection can also be archived explicitly by setting ` + "`archived`" + ` to ` + "`true`" + `.
+
+To avoid taking over the personal collection of a user by mistake (e.g. when importing the wrong ID), the plan fails when the collection is a personal collection, unless ` + "`allow_personal`" + ` is set.
+
+Special collections managed by Metabase itself (e.g. the instance analytics collection) have a ` + "`type`" + `, and cannot be managed by this resource.`,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -82,16 +95,44 @@ func (r *CollectionResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 			"parent_id": schema.Int64Attribute{
-				MarkdownDescription: "The ID of the parent collection, if any.",
+				MarkdownDescription: "The ID of the parent collection, if any. Setting this to `null` moves the collection to the root.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL to the collection in the Metabase UI, built from the provider endpoint.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					planmodifiers.UseStateForUnknownIfAttributeUnchanged[types.String](path.Root("name")),
+				},
+			},
+			"archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether the collection is archived, i.e. in the trash. Defaults to `false`, which restores the collection if it has been archived outside of Terraform.",
 				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"personal_owner_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the user owning the collection, if it is a personal collection.",
+				Computed:            true,
 				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the collection, e.g. `instance-analytics`. This is null for regular collections, and only set for special collections managed by Metabase.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"allow_personal": schema.BoolAttribute{
+				MarkdownDescription: "Whether the resource can manage a personal collection. Defaults to `false`, in which case the plan fails if the collection belongs to a user.",
+				Optional:            true,
+			},
 		},
 	}
 }
 
 // Updates the given `CollectionResourceModel` from the `Collection` returned by the Metabase API.
-func updateModelFromCollection(col metabase.Collection, data *CollectionResourceModel) diag.Diagnostics {
+// The site URL is used to build the link to the collection.
+func updateModelFromCollection(col metabase.Collection, siteUrl string, data *CollectionResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	// The ID can be a string because of the "root" collection.
@@ -107,10 +148,14 @@ func updateModelFromCollection(col metabase.Collection, data *CollectionResource
 	}
 
 	data.Name = types.StringValue(col.Name)
+	data.Url = makeObjectUrl(siteUrl, "collection", data.Id.ValueString(), col.Name)
 	data.Description = stringValueOrNull(col.Description)
 	data.Slug = stringValueOrNull(col.Slug)
 	data.EntityId = stringValueOrNull(col.EntityId)
 	data.Location = stringValueOrNull(col.Location)
+	data.Archived = types.BoolValue(col.Archived != nil && *col.Archived)
+	data.PersonalOwnerId = int64ValueOrNull(col.PersonalOwnerId)
+	data.Type = stringValueOrNull(col.Type)
 
 	// The parent ID is used when posting to the API, but it is not returned.
 	// However, it can be inferred from the `location`, which is also a way of checking that the parent was correctly
@@ -140,6 +185,63 @@ func updateModelFromCollection(col metabase.Collection, data *CollectionResource
 	return diags
 }
 
+// Returns an error if the collection is a personal collection and managing it has not been explicitly allowed.
+func checkPersonalCollectionAllowed(personalOwnerId types.Int64, allowPersonal types.Bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if personalOwnerId.IsNull() || personalOwnerId.IsUnknown() || allowPersonal.ValueBool() {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		path.Root("allow_personal"),
+		"Refusing to manage a personal collection.",
+		fmt.Sprintf("The collection is the personal collection of user %d. Set allow_personal to true if this is intended, or remove the resource from the state otherwise.", personalOwnerId.ValueInt64()),
+	)
+
+	return diags
+}
+
+// Returns an error if the collection is a special collection managed by Metabase, e.g. the instance analytics
+// collection. Such collections are created and updated by Metabase itself, and should not be managed by Terraform.
+func checkSpecialCollectionNotManaged(collectionType types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if collectionType.IsNull() || collectionType.IsUnknown() {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		path.Root("type"),
+		"Refusing to manage a special collection.",
+		fmt.Sprintf("The collection has type %s and is managed by Metabase itself. Remove the resource from the state.", collectionType.ValueString()),
+	)
+
+	return diags
+}
+
+func (r *CollectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being created (a new collection is never personal nor special) or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state CollectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan CollectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkPersonalCollectionAllowed(state.PersonalOwnerId, plan.AllowPersonal)...)
+	resp.Diagnostics.Append(checkSpecialCollectionNotManaged(state.Type)...)
+}
+
 func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *CollectionResourceModel
 
@@ -159,7 +261,28 @@ func (r *CollectionResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	resp.Diagnostics.Append(updateModelFromCollection(*createResp.JSON200, data)...)
+	collection := *createResp.JSON200
+
+	// A collection cannot be archived when it is created, which requires a second call.
+	// The full body is sent, as omitted attributes such as the parent ID would otherwise be reset.
+	if data.Archived.ValueBool() {
+		collectionId, err := collection.Id.AsCollectionId1()
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to parse collection ID.", err.Error())
+			return
+		}
+
+		updateResp, err := r.client.UpdateCollectionWithResponse(ctx, fmt.Sprint(collectionId), makeUpdateCollectionBody(*data))
+
+		resp.Diagnostics.Append(checkMetabaseResponse(updateResp, err, []int{200}, "archive collection")...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		collection = *updateResp.JSON200
+	}
+
+	resp.Diagnostics.Append(updateModelFromCollection(collection, r.siteUrl, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -177,19 +300,20 @@ func (r *CollectionResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	getResp, err := r.client.GetCollectionWithResponse(ctx, data.Id.ValueString())
 
-	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200, 404}, "get collection")...)
+	status, diags := checkMetabaseResponseStatus(getResp, err, []int{200}, "get collection")
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Collections are still accessible by their ID after being archived. However we should treat them as deleted, as this
-	// is what the delete operation does.
-	if getResp.StatusCode() == 404 || *getResp.JSON200.Archived {
-		resp.State.RemoveResource(ctx)
+	// Collections are still accessible by their ID after being archived. They are kept in the state, such that they can
+	// be restored (unarchived) by the next update rather than being recreated.
+	if status == metabaseResponseNotFound {
+		removeMissingResourceFromState(ctx, resp, "collection", data.Id.ValueString(), "not found")
 		return
 	}
 
-	resp.Diagnostics.Append(updateModelFromCollection(*getResp.JSON200, data)...)
+	resp.Diagnostics.Append(updateModelFromCollection(*getResp.JSON200, r.siteUrl, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -197,6 +321,55 @@ func (r *CollectionResource) Read(ctx context.Context, req resource.ReadRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// Makes the body used to update a collection from the planned model.
+// The parent ID is always sent, as an explicit `null` is how Metabase is told to move the collection to the root.
+// The archived flag is also always sent, which restores the collection if it has been archived outside of Terraform.
+// This is supported by versions of Metabase both before and after the introduction of the trash.
+func makeUpdateCollectionBody(data CollectionResourceModel) metabase.UpdateCollectionBody {
+	collectionName := data.Name.ValueString()
+	archived := data.Archived.ValueBool()
+
+	return metabase.UpdateCollectionBody{
+		Name:        &collectionName,
+		Description: valueStringOrNull(data.Description),
+		ParentId:    valueInt64OrNull(data.ParentId),
+		Archived:    &archived,
+	}
+}
+
+// Ensures the collection described by the model has been moved to the expected parent after an update.
+// The update response may not reflect the move, in which case the collection is fetched again, such that the
+// `location` in the state is the one actually stored by Metabase.
+func checkCollectionMoved(ctx context.Context, client metabase.ClientWithResponsesInterface, siteUrl string, expectedParentId types.Int64, data *CollectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.ParentId.Equal(expectedParentId) {
+		return diags
+	}
+
+	getResp, err := client.GetCollectionWithResponse(ctx, data.Id.ValueString())
+
+	diags.Append(checkMetabaseResponse(getResp, err, []int{200}, "get collection")...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(updateModelFromCollection(*getResp.JSON200, siteUrl, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !data.ParentId.Equal(expectedParentId) {
+		diags.AddAttributeError(
+			path.Root("parent_id"),
+			"The collection was not moved to the expected parent.",
+			fmt.Sprintf("Expected parent %s, but the collection is located at %s.", expectedParentId, data.Location.ValueString()),
+		)
+	}
+
+	return diags
+}
+
 func (r *CollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data *CollectionResourceModel
 
@@ -205,19 +378,21 @@ func (r *CollectionResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	collectionName := data.Name.ValueString()
-	updateResp, err := r.client.UpdateCollectionWithResponse(ctx, data.Id.ValueString(), metabase.UpdateCollectionBody{
-		Name:        &collectionName,
-		Description: valueStringOrNull(data.Description),
-		ParentId:    valueInt64OrNull(data.ParentId),
-	})
+	updateResp, err := r.client.UpdateCollectionWithResponse(ctx, data.Id.ValueString(), makeUpdateCollectionBody(*data))
 
 	resp.Diagnostics.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update collection")...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(updateModelFromCollection(*updateResp.JSON200, data)...)
+	expectedParentId := data.ParentId
+
+	resp.Diagnostics.Append(updateModelFromCollection(*updateResp.JSON200, r.siteUrl, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkCollectionMoved(ctx, r.client, r.siteUrl, expectedParentId, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -235,8 +410,12 @@ func (r *CollectionResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	archived := true
 	// A collection cannot be deleted, but it can be archived.
+	// The parent and description are sent as well, as `null` values would otherwise move the collection to the root and
+	// clear its description.
 	updateResp, err := r.client.UpdateCollectionWithResponse(ctx, data.Id.ValueString(), metabase.UpdateCollectionBody{
-		Archived: &archived,
+		Description: valueStringOrNull(data.Description),
+		ParentId:    valueInt64OrNull(data.ParentId),
+		Archived:    &archived,
 	})
 
 	resp.Diagnostics.Append(checkMetabaseResponse(updateResp, err, []int{200}, "delete (archive) collection")...)
@@ -2,9 +2,18 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -111,6 +120,195 @@ func TestAccCollectionResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("metabase_collection.child", "parent_id"),
 				),
 			},
+			{
+				Config: providerConfig +
+					testAccCollectionResource("test", "🎁 Updated", "❓ Other", "null") +
+					testAccCollectionResource("child", "🧒 Child", "🌴 Nested", "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCollectionExists("metabase_collection.child"),
+					resource.TestCheckResourceAttr("metabase_collection.child", "location", "/"),
+					resource.TestCheckNoResourceAttr("metabase_collection.child", "parent_id"),
+				),
+			},
 		},
 	})
 }
+
+func TestUpdateModelFromArchivedCollection(t *testing.T) {
+	var col metabase.Collection
+	err := json.Unmarshal([]byte(`{"id": 12, "name": "Reports", "location": "/3/", "archived": true}`), &col)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var data CollectionResourceModel
+	diags := updateModelFromCollection(col, "https://metabase.example.com", &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if !data.Archived.ValueBool() {
+		t.Errorf("Expected the collection to be archived.")
+	}
+	if data.ParentId.ValueInt64() != 3 {
+		t.Errorf("Expected parent 3, got %s.", data.ParentId.String())
+	}
+}
+
+func TestCheckPersonalCollectionAllowed(t *testing.T) {
+	diags := checkPersonalCollectionAllowed(types.Int64Null(), types.BoolNull())
+	if diags.HasError() {
+		t.Errorf("Expected a regular collection to be allowed, got: %v", diags)
+	}
+
+	diags = checkPersonalCollectionAllowed(types.Int64Value(4), types.BoolNull())
+	if !diags.HasError() {
+		t.Errorf("Expected a personal collection to be refused by default.")
+	}
+
+	diags = checkPersonalCollectionAllowed(types.Int64Value(4), types.BoolValue(true))
+	if diags.HasError() {
+		t.Errorf("Expected a personal collection to be allowed explicitly, got: %v", diags)
+	}
+}
+
+func TestCheckSpecialCollectionNotManaged(t *testing.T) {
+	diags := checkSpecialCollectionNotManaged(types.StringNull())
+	if diags.HasError() {
+		t.Errorf("Expected a regular collection to be allowed, got: %v", diags)
+	}
+
+	diags = checkSpecialCollectionNotManaged(types.StringValue("instance-analytics"))
+	if !diags.HasError() {
+		t.Errorf("Expected a special collection to be refused.")
+	}
+}
+
+func TestMakeUpdateCollectionBodyMovesToRoot(t *testing.T) {
+	body, err := json.Marshal(makeUpdateCollectionBody(CollectionResourceModel{
+		Name:     types.StringValue("Reports"),
+		ParentId: types.Int64Null(),
+		Archived: types.BoolValue(false),
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(string(body), `"parent_id":null`) {
+		t.Errorf("Expected an explicit null parent ID to move the collection to the root, got: %s", body)
+	}
+}
+
+func TestCheckCollectionMovedToRoot(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 12, "name": "Reports", "location": "/"}`))
+	}))
+	defer server.Close()
+
+	client, err := metabase.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The update response still locates the collection under its previous parent.
+	var col metabase.Collection
+	err = json.Unmarshal([]byte(`{"id": 12, "name": "Reports", "location": "/3/"}`), &col)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var data CollectionResourceModel
+	diags := updateModelFromCollection(col, "", &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	diags = checkCollectionMoved(context.Background(), client, "", types.Int64Null(), &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if data.Location.ValueString() != "/" {
+		t.Errorf("Expected the collection to be located at the root, got: %s.", data.Location.ValueString())
+	}
+	if !data.ParentId.IsNull() {
+		t.Errorf("Expected a null parent ID, got: %s.", data.ParentId)
+	}
+}
+
+func TestCreateArchivedChildCollection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/collection":
+			w.Write([]byte(`{"id": 12, "name": "Reports", "description": "Monthly", "location": "/3/"}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/collection/12":
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// The collection would be moved to the root and lose its description if those were sent as null.
+			if body["parent_id"] != float64(3) || body["description"] != "Monthly" {
+				t.Errorf("Expected the parent and description to be kept when archiving, got: %v", body)
+			}
+
+			w.Write([]byte(`{"id": 12, "name": "Reports", "description": "Monthly", "location": "/3/", "archived": true}`))
+		default:
+			t.Errorf("Unexpected request: %s %s.", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := metabase.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx := context.Background()
+	r := &CollectionResource{MetabaseBaseResource{client: client}}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := plan.Set(ctx, CollectionResourceModel{
+		Id:              types.StringUnknown(),
+		Name:            types.StringValue("Reports"),
+		Description:     types.StringValue("Monthly"),
+		Slug:            types.StringUnknown(),
+		EntityId:        types.StringUnknown(),
+		Location:        types.StringUnknown(),
+		ParentId:        types.Int64Value(3),
+		Url:             types.StringUnknown(),
+		Archived:        types.BoolValue(true),
+		PersonalOwnerId: types.Int64Unknown(),
+		Type:            types.StringUnknown(),
+		AllowPersonal:   types.BoolNull(),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var data CollectionResourceModel
+	diags = resp.State.Get(ctx, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !data.Archived.ValueBool() || data.ParentId.ValueInt64() != 3 || data.Description.ValueString() != "Monthly" {
+		t.Errorf("Expected an archived child collection of 3, got: archived %s, parent %s, description %s.", data.Archived, data.ParentId, data.Description)
+	}
+}
@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CollectionTreeDataSource{}
+
+// Creates a new collection tree data source.
+func NewCollectionTreeDataSource() datasource.DataSource {
+	return &CollectionTreeDataSource{}
+}
+
+// A data source listing all the collections in Metabase, along with their position in the hierarchy.
+// This is mostly useful to assign permissions dynamically, without looking up collections one by one.
+type CollectionTreeDataSource struct {
+	// The Metabase API client.
+	client *metabase.ClientWithResponses
+}
+
+// The Terraform model for the collection tree.
+type CollectionTreeDataSourceModel struct {
+	IncludeArchived types.Bool `tfsdk:"include_archived"` // Whether archived collections should be listed.
+	IncludeSpecial  types.Bool `tfsdk:"include_special"`  // Whether special collections managed by Metabase should be listed.
+	Collections     types.List `tfsdk:"collections"`      // The list of collections, sorted by ID.
+}
+
+// The object type for a single collection in the tree.
+var collectionTreeItemObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":        types.StringType,
+		"name":      types.StringType,
+		"location":  types.StringType,
+		"parent_id": types.StringType,
+		"archived":  types.BoolType,
+		"type":      types.StringType,
+	},
+}
+
+func (d *CollectionTreeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collection_tree"
+}
+
+func (d *CollectionTreeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The tree of all Metabase collections.
+
+This data source lists all collections in a single call, along with their parent. It can be used to assign permissions dynamically, e.g. by iterating over the collections in a ` + "`metabase_collection_graph`" + ` resource. The root collection is not part of the list.`,
+
+		Attributes: map[string]schema.Attribute{
+			"include_archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether archived collections should also be listed. Defaults to `false`.",
+				Optional:            true,
+			},
+			"include_special": schema.BoolAttribute{
+				MarkdownDescription: "Whether special collections managed by Metabase itself (e.g. instance analytics) should also be listed. Defaults to `false`.",
+				Optional:            true,
+			},
+			"collections": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of collections, sorted by ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the collection.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the collection.",
+							Computed:            true,
+						},
+						"location": schema.StringAttribute{
+							MarkdownDescription: "The path-like location of the collection, made of the IDs of its ancestors, e.g. `/1/2/`.",
+							Computed:            true,
+						},
+						"parent_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the parent collection, or null if the collection is at the root.",
+							Computed:            true,
+						},
+						"archived": schema.BoolAttribute{
+							MarkdownDescription: "Whether the collection is archived. This can only be `true` if `include_archived` is set.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the collection, e.g. `instance-analytics`. This is null for regular collections, and can only be set if `include_special` is set.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CollectionTreeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*metabase.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected client type when configuring Metabase data source.",
+			fmt.Sprintf("Expected *metabase.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Returns the ID of the parent collection from a collection's location, e.g. `/1/2/` returns `2`.
+// `nil` is returned if the collection is at the root.
+func getParentIdFromLocation(location string) *string {
+	ancestors := strings.Split(strings.Trim(location, "/"), "/")
+	parentId := ancestors[len(ancestors)-1]
+	if parentId == "" {
+		return nil
+	}
+
+	return &parentId
+}
+
+// Updates the given `CollectionTreeDataSourceModel` from the list of collections returned by the Metabase API.
+// The root collection, which has a string ID, is ignored. Archived and special collections are also ignored, unless
+// respectively `include_archived` and `include_special` are set in the model.
+func updateModelFromCollectionList(collections []metabase.Collection, data *CollectionTreeDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	collectionIds := make([]int, 0, len(collections))
+	collectionsById := make(map[int]metabase.Collection, len(collections))
+	for _, c := range collections {
+		id, err := c.Id.AsCollectionId1()
+		if err != nil {
+			continue
+		}
+
+		archived := c.Archived != nil && *c.Archived
+		if archived && !data.IncludeArchived.ValueBool() {
+			continue
+		}
+
+		if c.Type != nil && !data.IncludeSpecial.ValueBool() {
+			continue
+		}
+
+		// A collection could be returned twice when listing both live and archived collections.
+		if _, exists := collectionsById[id]; exists {
+			continue
+		}
+
+		collectionIds = append(collectionIds, id)
+		collectionsById[id] = c
+	}
+	sort.Ints(collectionIds)
+
+	collectionsList := make([]attr.Value, 0, len(collectionIds))
+	for _, id := range collectionIds {
+		c := collectionsById[id]
+
+		location := "/"
+		if c.Location != nil {
+			location = *c.Location
+		}
+
+		var parentId *string
+		if c.ParentId != nil {
+			parentIdStr := fmt.Sprint(*c.ParentId)
+			parentId = &parentIdStr
+		} else {
+			parentId = getParentIdFromLocation(location)
+		}
+
+		collectionObject, objectDiags := types.ObjectValue(collectionTreeItemObjectType.AttrTypes, map[string]attr.Value{
+			"id":        types.StringValue(fmt.Sprint(id)),
+			"name":      types.StringValue(c.Name),
+			"location":  types.StringValue(location),
+			"parent_id": stringValueOrNull(parentId),
+			"archived":  types.BoolValue(c.Archived != nil && *c.Archived),
+			"type":      stringValueOrNull(c.Type),
+		})
+		diags.Append(objectDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		collectionsList = append(collectionsList, collectionObject)
+	}
+
+	collectionsValue, listDiags := types.ListValue(collectionTreeItemObjectType, collectionsList)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Collections = collectionsValue
+
+	return diags
+}
+
+func (d *CollectionTreeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CollectionTreeDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listResp, err := d.client.ListCollectionsWithResponse(ctx, &metabase.ListCollectionsParams{})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(listResp, err, []int{200}, "list collections")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collections := *listResp.JSON200
+
+	// Metabase only returns either live or archived collections, which requires a second call to list both.
+	if data.IncludeArchived.ValueBool() {
+		archived := true
+		listArchivedResp, err := d.client.ListCollectionsWithResponse(ctx, &metabase.ListCollectionsParams{
+			Archived: &archived,
+		})
+
+		resp.Diagnostics.Append(checkMetabaseResponse(listArchivedResp, err, []int{200}, "list archived collections")...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		collections = append(collections, *listArchivedResp.JSON200...)
+	}
+
+	resp.Diagnostics.Append(updateModelFromCollectionList(collections, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
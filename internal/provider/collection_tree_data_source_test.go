@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUpdateModelFromCollectionList(t *testing.T) {
+	var collections []metabase.Collection
+	err := json.Unmarshal([]byte(`[
+		{"id": "root", "name": "Our analytics"},
+		{"id": 3, "name": "Child", "location": "/1/"},
+		{"id": 1, "name": "Parent", "location": "/"},
+		{"id": 4, "name": "Grandchild", "location": "/1/3/", "parent_id": 3},
+		{"id": 5, "name": "Archived", "location": "/", "archived": true}
+	]`), &collections)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var data CollectionTreeDataSourceModel
+	diags := updateModelFromCollectionList(collections, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	elements := data.Collections.Elements()
+	if len(elements) != 3 {
+		t.Fatalf("Expected 3 collections, got %d.", len(elements))
+	}
+
+	expectedParents := []string{"<null>", `"1"`, `"3"`}
+	for i, e := range elements {
+		parentId := e.(types.Object).Attributes()["parent_id"].String()
+		if parentId != expectedParents[i] {
+			t.Errorf("Expected parent %s for collection %d, got %s.", expectedParents[i], i, parentId)
+		}
+	}
+}
+
+func TestUpdateModelFromCollectionListIncludingArchived(t *testing.T) {
+	var collections []metabase.Collection
+	err := json.Unmarshal([]byte(`[
+		{"id": 1, "name": "Live", "location": "/", "archived": false},
+		{"id": 2, "name": "Archived", "location": "/", "archived": true}
+	]`), &collections)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := CollectionTreeDataSourceModel{IncludeArchived: types.BoolValue(true)}
+	diags := updateModelFromCollectionList(collections, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	elements := data.Collections.Elements()
+	if len(elements) != 2 {
+		t.Fatalf("Expected 2 collections, got %d.", len(elements))
+	}
+	if !elements[1].(types.Object).Attributes()["archived"].Equal(types.BoolValue(true)) {
+		t.Errorf("Expected the second collection to be archived.")
+	}
+}
+
+func TestUpdateModelFromCollectionListIncludingSpecial(t *testing.T) {
+	var collections []metabase.Collection
+	err := json.Unmarshal([]byte(`[
+		{"id": 1, "name": "Regular", "location": "/", "type": null},
+		{"id": 2, "name": "Metabase analytics", "location": "/", "type": "instance-analytics"}
+	]`), &collections)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var data CollectionTreeDataSourceModel
+	diags := updateModelFromCollectionList(collections, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if len(data.Collections.Elements()) != 1 {
+		t.Fatalf("Expected special collections to be skipped by default, got %d collections.", len(data.Collections.Elements()))
+	}
+
+	data = CollectionTreeDataSourceModel{IncludeSpecial: types.BoolValue(true)}
+	diags = updateModelFromCollectionList(collections, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	elements := data.Collections.Elements()
+	if len(elements) != 2 {
+		t.Fatalf("Expected 2 collections, got %d.", len(elements))
+	}
+	if !elements[1].(types.Object).Attributes()["type"].Equal(types.StringValue("instance-analytics")) {
+		t.Errorf("Expected the second collection to have the instance-analytics type.")
+	}
+}
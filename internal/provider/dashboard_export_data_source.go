@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DashboardExportDataSource{}
+
+// Creates a new dashboard export data source.
+func NewDashboardExportDataSource() datasource.DataSource {
+	return &DashboardExportDataSource{}
+}
+
+// A data source returning the full definition of a dashboard, as returned by the Metabase API.
+// This is mostly useful to back up dashboards, as no attribute is removed from the response.
+type DashboardExportDataSource struct {
+	// The Metabase API client.
+	client *metabase.ClientWithResponses
+}
+
+// The Terraform model for the dashboard export.
+type DashboardExportDataSourceModel struct {
+	Id      types.Int64  `tfsdk:"id"`       // The ID of the dashboard.
+	RawJson types.String `tfsdk:"raw_json"` // The response of the Metabase API for the dashboard, as is.
+}
+
+func (d *DashboardExportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard_export"
+}
+
+func (d *DashboardExportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The full definition of a Metabase dashboard, as returned by the API.
+
+Unlike the ` + "`metabase_dashboard`" + ` resource, no attribute is removed from the definition. This can be used to snapshot dashboards to files for archival purposes, e.g. using the ` + "`local_file`" + ` resource.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the dashboard.",
+				Required:            true,
+			},
+			"raw_json": schema.StringAttribute{
+				MarkdownDescription: "The full definition of the dashboard, as a JSON string returned by the Metabase API. This includes the cards, tabs, and all server-owned attributes.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DashboardExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*metabase.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected client type when configuring Metabase data source.",
+			fmt.Sprintf("Expected *metabase.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DashboardExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DashboardExportDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResp, err := d.client.GetDashboardWithResponse(ctx, int(data.Id.ValueInt64()))
+
+	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200}, "get dashboard")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.RawJson = types.StringValue(string(getResp.Body))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
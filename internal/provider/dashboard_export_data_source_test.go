@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDashboardExportKeepsTheFullDefinition(t *testing.T) {
+	// Server-owned attributes, which the dashboard resource removes, are expected in the export.
+	dashboardJson := `{"id":3,"name":"Overview","creator_id":1,"updated_at":"2024-06-01T12:00:00Z","dashcards":[{"id":10,"card_id":4}],"tabs":[]}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/dashboard/3" {
+			t.Errorf("Unexpected request: %s %s.", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(dashboardJson))
+	}))
+	defer server.Close()
+
+	client, err := metabase.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v.", err)
+	}
+
+	ctx := context.Background()
+	d := &DashboardExportDataSource{client: client}
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":       tftypes.NewValue(tftypes.Number, 3),
+			"raw_json": tftypes.NewValue(tftypes.String, nil),
+		}),
+	}
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v.", resp.Diagnostics)
+	}
+
+	var data DashboardExportDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v.", resp.Diagnostics)
+	}
+
+	if data.RawJson.ValueString() != dashboardJson {
+		t.Errorf("Expected the dashboard definition to be exported as is, got %s.", data.RawJson.String())
+	}
+}
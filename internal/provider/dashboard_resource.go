@@ -4,19 +4,33 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"reflect"
+	"sort"
 
+	"github.com/flovouin/terraform-provider-metabase/internal/planmodifiers"
 	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensures provider defined types fully satisfy framework interfaces.
 var _ resource.ResourceWithImportState = &DashboardResource{}
+var _ resource.ResourceWithValidateConfig = &DashboardResource{}
+var _ resource.ResourceWithModifyPlan = &DashboardResource{}
 
 // Creates a new dashboard resource.
 func NewDashboardResource() resource.Resource {
@@ -35,14 +49,20 @@ type DashboardResource struct {
 // Cards contain more attributes that can change depending on their type (e.g. text vs. question), and there's no point
 // to trying modelling all of them.
 type DashboardResourceModel struct {
-	Id                 types.Int64  `tfsdk:"id"`                  // The ID of the dashboard.
-	Name               types.String `tfsdk:"name"`                // The name of the dashboard.
-	CacheTtl           types.Int64  `tfsdk:"cache_ttl"`           // The cache TTL.
-	CollectionId       types.Int64  `tfsdk:"collection_id"`       // The ID of the collection in which the dashboard is placed.
-	CollectionPosition types.Int64  `tfsdk:"collection_position"` // The position of the dashboard in the collection.
-	Description        types.String `tfsdk:"description"`         // A description for the dashboard.
-	ParametersJson     types.String `tfsdk:"parameters_json"`     // A list of parameters for the dashboard, that the user can tweak, as a JSON string.
-	CardsJson          types.String `tfsdk:"cards_json"`          // The list of cards in the dashboard, as a JSON string.
+	Id                                types.Int64  `tfsdk:"id"`                                   // The ID of the dashboard.
+	Name                              types.String `tfsdk:"name"`                                 // The name of the dashboard.
+	CacheTtl                          types.Int64  `tfsdk:"cache_ttl"`                            // The cache TTL.
+	CollectionId                      types.Int64  `tfsdk:"collection_id"`                        // The ID of the collection in which the dashboard is placed.
+	CollectionPosition                types.Int64  `tfsdk:"collection_position"`                  // The position of the dashboard in the collection.
+	Description                       types.String `tfsdk:"description"`                          // A description for the dashboard.
+	ParametersJson                    types.String `tfsdk:"parameters_json"`                      // A list of parameters for the dashboard, that the user can tweak, as a JSON string.
+	CardsJson                         types.String `tfsdk:"cards_json"`                           // The list of cards in the dashboard, as a JSON string.
+	TabsJson                          types.String `tfsdk:"tabs_json"`                            // The list of tabs in the dashboard, as a JSON string.
+	EnableEmbedding                   types.Bool   `tfsdk:"enable_embedding"`                     // Whether the dashboard can be embedded using signed embeds.
+	EmbeddingParams                   types.Map    `tfsdk:"embedding_params"`                     // How each parameter can be used in signed embeds, keyed by parameter slug.
+	Url                               types.String `tfsdk:"url"`                                  // The URL to the dashboard in the Metabase UI.
+	EntityId                          types.String `tfsdk:"entity_id"`                            // A unique string identifier, used by serialization.
+	PreserveUnknownDashcardAttributes types.Bool   `tfsdk:"preserve_unknown_dashcard_attributes"` // Whether unknown attributes in dashcards should be managed.
 }
 
 // The list of JSON attributes in a dashcard that should be persisted in the state.
@@ -56,6 +76,25 @@ var allowedDashcardAttributes = map[string]bool{
 	"series":                 true,
 	"parameter_mappings":     true,
 	"visualization_settings": true,
+	"dashboard_tab_id":       true,
+}
+
+// The list of JSON attributes in a dashcard that are set by Metabase. They are never persisted in the state, even when
+// unknown dashcard attributes are preserved.
+var serverOwnedDashcardAttributes = map[string]bool{
+	"id":           true,
+	"dashboard_id": true,
+	"card":         true,
+	"created_at":   true,
+	"updated_at":   true,
+	"entity_id":    true,
+}
+
+// The list of JSON attributes in a dashboard tab that should be persisted in the state.
+// Those are also the attributes that users should specify in `tabs_json`.
+var allowedDashboardTabAttributes = map[string]bool{
+	"id":   true,
+	"name": true,
 }
 
 func (r *DashboardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -75,12 +114,15 @@ Although a dashboard object is even more complex than a card (question), basic p
 				Required:            true,
 			},
 			"cache_ttl": schema.Int64Attribute{
-				MarkdownDescription: "The cache TTL.",
+				MarkdownDescription: "The cache TTL. This is ignored by Metabase 50 and later, which use the cache configuration instead. A warning is shown when planning a change to this attribute on such an instance.",
 				Optional:            true,
 			},
 			"collection_id": schema.Int64Attribute{
-				MarkdownDescription: "The ID of the collection in which the dashboard is placed.",
+				MarkdownDescription: "The ID of the collection in which the dashboard is placed. The dashboard is placed in the root collection when this is null, as the `\"root\"` string used by collections is not accepted.",
 				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
 			},
 			"collection_position": schema.Int64Attribute{
 				MarkdownDescription: "The position of the dashboard in the collection.",
@@ -91,17 +133,304 @@ Although a dashboard object is even more complex than a card (question), basic p
 				Optional:            true,
 			},
 			"parameters_json": schema.StringAttribute{
-				MarkdownDescription: "A list of parameters for the dashboard, that the user can tweak, as a JSON string.",
+				MarkdownDescription: "A list of parameters for the dashboard, that the user can tweak, as a JSON string. Parameter IDs must be unique, and required parameters should have a default value.",
 				Optional:            true,
 			},
 			"cards_json": schema.StringAttribute{
-				MarkdownDescription: "The list of cards in the dashboard, as a JSON string.",
+				MarkdownDescription: "The list of cards in the dashboard, as a JSON string. When the dashboard has tabs, each card can set `dashboard_tab_id` to the `id` of a tab in `tabs_json`. Cards are recreated with new IDs in Metabase whenever the dashboard is updated, even if this attribute does not change.",
 				Required:            true,
 			},
+			"tabs_json": schema.StringAttribute{
+				MarkdownDescription: "The list of tabs in the dashboard, as a JSON string. Each tab should have an `id` and a `name`, and tabs are displayed in the order of the list. The `id` is only used to reference the tab from cards, and does not need to match the ID of the tab in Metabase. Every `dashboard_tab_id` in `cards_json` must reference a tab defined in this list.",
+				Optional:            true,
+			},
+			"enable_embedding": schema.BoolAttribute{
+				MarkdownDescription: "Whether the dashboard can be embedded using signed embeds. Embedding must also be enabled in the Metabase settings. If not set, the value in Metabase is kept, which is `false` for new dashboards.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"embedding_params": schema.MapAttribute{
+				MarkdownDescription: "A map where keys are parameter slugs and values define how the parameter can be used in signed embeds: `disabled`, `enabled` (the parameter can be set by the user viewing the embed), or `locked` (the parameter is set when signing the embed). Each key must match the `slug` of a parameter in `parameters_json`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf(
+						string(metabase.Disabled),
+						string(metabase.Enabled),
+						string(metabase.Locked),
+					)),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL to the dashboard in the Metabase UI, built from the provider endpoint.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					planmodifiers.UseStateForUnknownIfAttributeUnchanged[types.String](path.Root("name")),
+				},
+			},
+			"entity_id": schema.StringAttribute{
+				MarkdownDescription: "A unique string identifier for the dashboard, used by Metabase serialization.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"preserve_unknown_dashcard_attributes": schema.BoolAttribute{
+				MarkdownDescription: "Whether attributes of the cards in `cards_json` which are not known by the provider should be managed, rather than being dropped when reading the dashboard. This allows managing dashcard attributes introduced by newer versions of Metabase. Attributes set by Metabase (e.g. `id` or `created_at`) are still ignored. Defaults to `false`.",
+				Optional:            true,
+			},
 		},
 	}
 }
 
+// Checks the dashboard parameters, returning an error if several parameters share the same ID, and a warning for each
+// required parameter without a default value. The dashboard cannot be rendered until such a parameter is set.
+func validateDashboardParameters(parameters []interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	parameterIds := make(map[string]bool, len(parameters))
+	for _, p := range parameters {
+		parameter, ok := p.(map[string]interface{})
+		if !ok {
+			diags.AddAttributeError(path.Root("parameters_json"), "Unexpected dashboard parameter format.", "Each parameter should be a JSON object.")
+			continue
+		}
+
+		id, _ := parameter["id"].(string)
+		if parameterIds[id] {
+			diags.AddAttributeError(path.Root("parameters_json"), "Found duplicate dashboard parameter ID.", fmt.Sprintf("Parameter ID: %s.", id))
+		}
+		parameterIds[id] = true
+
+		required, _ := parameter["required"].(bool)
+		if required && parameter["default"] == nil {
+			diags.AddAttributeWarning(
+				path.Root("parameters_json"),
+				"Found a required dashboard parameter without a default value.",
+				fmt.Sprintf("The parameter with ID %s is required but has no default value. The dashboard will not render until the parameter is set.", id),
+			)
+		}
+	}
+
+	return diags
+}
+
+// Checks that each parameter referenced in `embedding_params` is defined in the dashboard parameters, using its slug.
+func validateDashboardEmbeddingParams(parameters []interface{}, embeddingParams types.Map) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if embeddingParams.IsNull() || embeddingParams.IsUnknown() {
+		return diags
+	}
+
+	parameterSlugs := make(map[string]bool, len(parameters))
+	for _, p := range parameters {
+		parameter, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		slug, _ := parameter["slug"].(string)
+		parameterSlugs[slug] = true
+	}
+
+	slugs := make([]string, 0, len(embeddingParams.Elements()))
+	for slug := range embeddingParams.Elements() {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+
+	for _, slug := range slugs {
+		if !parameterSlugs[slug] {
+			diags.AddAttributeError(
+				path.Root("embedding_params"),
+				"Found an embedding parameter which is not defined in the dashboard parameters.",
+				fmt.Sprintf("No parameter in parameters_json has the slug %s.", slug),
+			)
+		}
+	}
+
+	return diags
+}
+
+// Returns whether updating the dashboard from the state to the plan will recreate the dashcards even though
+// `cards_json` does not change. This happens because the dashcards are always sent with new IDs when updating the
+// dashboard.
+func dashcardsRecreatedByUpdate(state DashboardResourceModel, plan DashboardResourceModel) bool {
+	if plan.CardsJson.IsNull() || plan.CardsJson.IsUnknown() || !state.CardsJson.Equal(plan.CardsJson) {
+		return false
+	}
+
+	return !state.Name.Equal(plan.Name) ||
+		!state.CacheTtl.Equal(plan.CacheTtl) ||
+		!state.CollectionId.Equal(plan.CollectionId) ||
+		!state.CollectionPosition.Equal(plan.CollectionPosition) ||
+		!state.Description.Equal(plan.Description) ||
+		!state.ParametersJson.Equal(plan.ParametersJson) ||
+		!state.TabsJson.Equal(plan.TabsJson) ||
+		!state.EnableEmbedding.Equal(plan.EnableEmbedding) ||
+		!state.EmbeddingParams.Equal(plan.EmbeddingParams) ||
+		!state.PreserveUnknownDashcardAttributes.Equal(plan.PreserveUnknownDashcardAttributes)
+}
+
+func (r *DashboardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan DashboardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *DashboardResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if state != nil && dashcardsRecreatedByUpdate(*state, plan) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("cards_json"),
+			"The dashboard cards will be recreated.",
+			"Although cards_json does not change, updating the dashboard replaces all its cards with new ones, which get new IDs in Metabase. References to individual dashboard cards, e.g. in subscriptions or links, may break.",
+		)
+	}
+
+	r.warnIfCacheTtlIgnored(ctx, state, plan, resp)
+}
+
+// Adds a warning to the plan if the cache TTL changes but is ignored by the Metabase instance.
+func (r *DashboardResource) warnIfCacheTtlIgnored(ctx context.Context, state *DashboardResourceModel, plan DashboardResourceModel, resp *resource.ModifyPlanResponse) {
+	// The version cannot be checked before the provider is configured.
+	if r.client == nil || plan.CacheTtl.IsNull() || plan.CacheTtl.IsUnknown() {
+		return
+	}
+
+	// The version is only checked when the TTL changes, to avoid unnecessary calls to the API.
+	if state != nil && state.CacheTtl.Equal(plan.CacheTtl) {
+		return
+	}
+
+	granularCaching, diags := usesGranularCaching(ctx, r.client)
+	if diags.HasError() {
+		// Failing to determine the version should not prevent planning, as the warning is only informative.
+		tflog.Warn(ctx, "Unable to determine whether the Metabase instance uses granular caching.", map[string]interface{}{
+			"diagnostics": diags,
+		})
+		return
+	}
+
+	if granularCaching {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("cache_ttl"),
+			"The cache TTL is ignored by this Metabase instance.",
+			fmt.Sprintf("Metabase %d and later use the cache configuration (Admin settings > Performance) rather than the cache_ttl attribute of dashboards. This attribute will be sent to Metabase but will not have any effect. A metabase_cache_config resource is not available yet, and caching should be configured in Metabase directly.", granularCachingMajorVersion),
+		)
+	}
+}
+
+func (r *DashboardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DashboardResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ParametersJson.IsUnknown() {
+		parameters, diags := makeOpaqueParametersFromTerraform(data.ParametersJson)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(validateDashboardParameters(parameters)...)
+		resp.Diagnostics.Append(validateDashboardEmbeddingParams(parameters, data.EmbeddingParams)...)
+	}
+
+	if data.TabsJson.IsUnknown() || data.CardsJson.IsNull() || data.CardsJson.IsUnknown() {
+		return
+	}
+
+	tabs, diags := makeOpaqueTabsFromTerraform(data.TabsJson)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var cards []interface{}
+	err := json.Unmarshal([]byte(data.CardsJson.ValueString()), &cards)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("cards_json"), "Unable to parse cards JSON.", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(validateDashboardTabs(tabs, cards)...)
+}
+
+// Returns whether the given JSON value can be used as a tab ID in `tabs_json`, i.e. whether it is a number or a string.
+func isValidDashboardTabId(id interface{}) bool {
+	switch id.(type) {
+	case float64, string:
+		return true
+	default:
+		return false
+	}
+}
+
+// Checks the dashboard tabs, returning an error if a tab has no valid ID, if several tabs share the same ID, or if a
+// card references a tab which does not exist. Such cards would otherwise disappear from the dashboard when the tab is
+// removed.
+func validateDashboardTabs(tabs []interface{}, cards []interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tabIds := make(map[interface{}]bool, len(tabs))
+	for _, t := range tabs {
+		tab, ok := t.(map[string]interface{})
+		if !ok {
+			diags.AddAttributeError(path.Root("tabs_json"), "Unexpected dashboard tab format.", "Each tab should be a JSON object.")
+			continue
+		}
+
+		id := tab["id"]
+		if !isValidDashboardTabId(id) {
+			diags.AddAttributeError(path.Root("tabs_json"), "Found a dashboard tab without a valid ID.", "Each tab should have an id, either a number or a string.")
+			continue
+		}
+
+		if tabIds[id] {
+			diags.AddAttributeError(path.Root("tabs_json"), "Found duplicate dashboard tab ID.", fmt.Sprintf("Tab ID: %v.", id))
+		}
+		tabIds[id] = true
+	}
+
+	for i, c := range cards {
+		card, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		tabId, ok := card["dashboard_tab_id"]
+		if !ok || tabId == nil {
+			continue
+		}
+
+		if !isValidDashboardTabId(tabId) || !tabIds[tabId] {
+			diags.AddAttributeError(
+				path.Root("cards_json"),
+				"Found a card referencing a tab that does not exist.",
+				fmt.Sprintf("The card at index %d references the tab with ID %v, which is not defined in tabs_json. The card should be assigned to an existing tab.", i, tabId),
+			)
+		}
+	}
+
+	return diags
+}
+
 // Returns a raw unmarshalled parameters list from its JSON representation stored in Terraform.
 // If the JSON string is null, an empty list is returned.
 func makeOpaqueParametersFromTerraform(parametersJson types.String) ([]interface{}, diag.Diagnostics) {
@@ -121,6 +450,25 @@ func makeOpaqueParametersFromTerraform(parametersJson types.String) ([]interface
 	return parameters, diags
 }
 
+// Returns a raw unmarshalled tabs list from its JSON representation stored in Terraform.
+// If the JSON string is null, an empty list is returned.
+func makeOpaqueTabsFromTerraform(tabsJson types.String) ([]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if tabsJson.IsNull() {
+		return []interface{}{}, diags
+	}
+
+	var tabs []interface{}
+	err := json.Unmarshal([]byte(tabsJson.ValueString()), &tabs)
+	if err != nil {
+		diags.AddError("Failed to deserialize dashboard tabs list.", err.Error())
+		return nil, diags
+	}
+
+	return tabs, diags
+}
+
 // Returns a raw unmarshalled parameters list and the corresponding JSON string from a list of typed parameters.
 func makeOpaqueParametersFromTyped(parameters []metabase.DashboardParameter) ([]interface{}, *string, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -143,16 +491,26 @@ func makeOpaqueParametersFromTyped(parameters []metabase.DashboardParameter) ([]
 }
 
 // Updates the given `DashboardResourceModel` from the `Dashboard` returned by the Metabase API.
-// This includes the update of the `cards_json` attribute, which requires the raw response from the Metabase API.
-func updateModelFromDashboardAndRawBody(d metabase.Dashboard, body []byte, data *DashboardResourceModel) diag.Diagnostics {
+// This includes the update of the `cards_json` attribute, which requires the raw response from the Metabase API. The
+// site URL is used to build the link to the dashboard.
+func updateModelFromDashboardAndRawBody(d metabase.Dashboard, body []byte, siteUrl string, data *DashboardResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	data.Id = types.Int64Value(int64(d.Id))
 	data.Name = types.StringValue(d.Name)
+	data.Url = makeObjectUrl(siteUrl, "dashboard", fmt.Sprint(d.Id), d.Name)
+	data.EntityId = stringValueOrNull(d.EntityId)
 	data.CacheTtl = int64ValueOrNull(d.CacheTtl)
 	data.CollectionId = int64ValueOrNull(d.CollectionId)
 	data.CollectionPosition = int64ValueOrNull(d.CollectionPosition)
 	data.Description = stringValueOrNull(d.Description)
+	data.EnableEmbedding = types.BoolValue(d.EnableEmbedding != nil && *d.EnableEmbedding)
+
+	embeddingParamsDiags := updateEmbeddingParamsFromDashboard(d, data)
+	diags.Append(embeddingParamsDiags...)
+	if diags.HasError() {
+		return diags
+	}
 
 	// Both the state JSON string and the received typed parameters are converted to untyped parameters lists and compared
 	// using `reflect.`
@@ -174,7 +532,13 @@ func updateModelFromDashboardAndRawBody(d metabase.Dashboard, body []byte, data
 		data.ParametersJson = types.StringValue(*marshalledNewParameters)
 	}
 
-	cardsDiag := updateCardsFromRawBody(body, data)
+	tabIds, tabsDiags := updateTabsFromRawBody(body, data)
+	diags.Append(tabsDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	cardsDiag := updateCardsFromRawBody(body, data, tabIds)
 	diags.Append(cardsDiag...)
 	if diags.HasError() {
 		return diags
@@ -183,8 +547,193 @@ func updateModelFromDashboardAndRawBody(d metabase.Dashboard, body []byte, data
 	return diags
 }
 
+// Updates the `embedding_params` attribute in the `DashboardResourceModel` from the `Dashboard` returned by the
+// Metabase API. Metabase can return an empty object rather than null, in which case an unset attribute is left as is.
+func updateEmbeddingParamsFromDashboard(d metabase.Dashboard, data *DashboardResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.EmbeddingParams == nil || len(*d.EmbeddingParams) == 0 {
+		if data.EmbeddingParams.IsNull() || len(data.EmbeddingParams.Elements()) > 0 {
+			data.EmbeddingParams = types.MapNull(types.StringType)
+		}
+		return diags
+	}
+
+	embeddingParams := make(map[string]attr.Value, len(*d.EmbeddingParams))
+	for slug, state := range *d.EmbeddingParams {
+		embeddingParams[slug] = types.StringValue(string(state))
+	}
+
+	embeddingParamsValue, mapDiags := types.MapValue(types.StringType, embeddingParams)
+	diags.Append(mapDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.EmbeddingParams = embeddingParamsValue
+
+	return diags
+}
+
+// Returns the embedding parameters to send to the Metabase API. `nil` is returned if the attribute is not set.
+func makeEmbeddingParamsFromModel(ctx context.Context, embeddingParams types.Map) (*metabase.DashboardEmbeddingParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if embeddingParams.IsNull() {
+		return nil, diags
+	}
+
+	params := make(metabase.DashboardEmbeddingParams, len(embeddingParams.Elements()))
+	diags.Append(embeddingParams.ElementsAs(ctx, &params, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &params, diags
+}
+
+// Updates the `tabs_json` attribute in the `DashboardResourceModel` using the raw response from the Metabase API.
+// The IDs of the tabs in Metabase are replaced by the IDs used in the Terraform model, matching tabs by position. The
+// returned map can be used to convert the `dashboard_tab_id` of cards from Metabase IDs to Terraform IDs.
+func updateTabsFromRawBody(bytes []byte, data *DashboardResourceModel) (map[float64]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var jsonResponse map[string]interface{}
+	err := json.Unmarshal(bytes, &jsonResponse)
+	if err != nil {
+		diags.AddError("Unable to parse get dashboard response.", err.Error())
+		return nil, diags
+	}
+
+	// Older versions of Metabase do not support tabs, in which case the dashboard is considered to have none.
+	tabs := []interface{}{}
+	if tabsAny, ok := jsonResponse["tabs"]; ok && tabsAny != nil {
+		tabs, ok = tabsAny.([]interface{})
+		if !ok {
+			diags.AddError("Unable to parse tabs as a list from get dashboard response.", string(bytes))
+			return nil, diags
+		}
+	}
+
+	existingTabs, tabsDiags := makeOpaqueTabsFromTerraform(data.TabsJson)
+	diags.Append(tabsDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	typedTabs := make([]map[string]interface{}, 0, len(tabs))
+	for _, t := range tabs {
+		tab, ok := t.(map[string]interface{})
+		if !ok {
+			diags.AddError("Could not parse dashboard tab as object.", string(bytes))
+			return nil, diags
+		}
+
+		typedTabs = append(typedTabs, tab)
+	}
+
+	sort.SliceStable(typedTabs, func(i, j int) bool {
+		iPosition, _ := typedTabs[i]["position"].(float64)
+		jPosition, _ := typedTabs[j]["position"].(float64)
+		return iPosition < jPosition
+	})
+
+	tabIds := make(map[float64]interface{}, len(typedTabs))
+	newTabs := make([]interface{}, 0, len(typedTabs))
+	for i, tab := range typedTabs {
+		metabaseId, ok := tab["id"].(float64)
+		if !ok {
+			diags.AddError("Could not find the ID of a dashboard tab.", string(bytes))
+			return nil, diags
+		}
+
+		// Tabs are always sent in the order of the Terraform model. If the number of tabs matches, the IDs from the model
+		// can be used. Otherwise (e.g. when the tabs have been modified outside of Terraform), IDs are simply positions.
+		var terraformId interface{} = float64(i + 1)
+		if len(existingTabs) == len(typedTabs) {
+			if existingTab, ok := existingTabs[i].(map[string]interface{}); ok && isValidDashboardTabId(existingTab["id"]) {
+				terraformId = existingTab["id"]
+			}
+		}
+
+		tabIds[metabaseId] = terraformId
+		tab["id"] = terraformId
+
+		for key := range tab {
+			if !allowedDashboardTabAttributes[key] {
+				delete(tab, key)
+			}
+		}
+
+		newTabs = append(newTabs, tab)
+	}
+
+	// Similarly to cards, the JSON string is only updated if "real" changes are detected.
+	if !reflect.DeepEqual(existingTabs, newTabs) {
+		// `makeOpaqueTabsFromTerraform` returns an empty list rather than `nil` for null tabs, hence the explicit check.
+		replacesExisting := !data.TabsJson.IsNull() && data.TabsJson.ValueString() != ""
+		tabsJson, err := marshalOpaqueJson(newTabs, replacesExisting)
+		if err != nil {
+			diags.AddError("Error serializing new JSON value.", err.Error())
+			return nil, diags
+		}
+
+		data.TabsJson = types.StringValue(string(tabsJson))
+	}
+
+	return tabIds, diags
+}
+
+// Returns whether a dashcard attribute has the value set by Metabase when it is not specified, e.g. the `null` `card_id`
+// of virtual (text) cards.
+func isDefaultDashcardValue(key string, value interface{}) bool {
+	switch key {
+	case "card_id":
+		return value == nil
+	case "series", "parameter_mappings":
+		list, ok := value.([]interface{})
+		return ok && len(list) == 0
+	default:
+		return false
+	}
+}
+
+// Returns a copy of the given dashcards, without attributes set to their default value.
+func removeDefaultDashcardValues(dashcards []interface{}) []interface{} {
+	cleaned := make([]interface{}, 0, len(dashcards))
+	for _, c := range dashcards {
+		card, ok := c.(map[string]interface{})
+		if !ok {
+			cleaned = append(cleaned, c)
+			continue
+		}
+
+		cleanedCard := make(map[string]interface{}, len(card))
+		for key, value := range card {
+			if !isDefaultDashcardValue(key, value) {
+				cleanedCard[key] = value
+			}
+		}
+		cleaned = append(cleaned, cleanedCard)
+	}
+
+	return cleaned
+}
+
+// Compares the dashcards returned by the Metabase API with the ones in the Terraform state/plan.
+// Attributes set to their default value are ignored, such that e.g. text cards can omit `card_id`, or set it to `null`.
+func areDashcardsEquivalent(dashcards []interface{}, existingCards []interface{}) bool {
+	// A `nil` list means `cards_json` is not set yet in the state, e.g. when importing the dashboard.
+	if existingCards == nil {
+		return false
+	}
+
+	return reflect.DeepEqual(removeDefaultDashcardValues(dashcards), removeDefaultDashcardValues(existingCards))
+}
+
 // Updates the `cards_json` attribute in the `DashboardResourceModel` using the raw response from the Metabase API.
-func updateCardsFromRawBody(bytes []byte, data *DashboardResourceModel) diag.Diagnostics {
+// The `dashboard_tab_id` of cards is converted to the IDs used in the Terraform model using `tabIds`.
+func updateCardsFromRawBody(bytes []byte, data *DashboardResourceModel, tabIds map[float64]interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	var jsonResponse map[string]interface{}
@@ -196,41 +745,72 @@ func updateCardsFromRawBody(bytes []byte, data *DashboardResourceModel) diag.Dia
 
 	dashcardsAny, ok := jsonResponse["dashcards"]
 	if !ok {
-		diags.AddError("Unable to retrieve dashcards from get dashboard response.", string(bytes))
+		// Older versions of Metabase return the cards using the legacy `ordered_cards` key. Both are handled the same way
+		// afterwards.
+		dashcardsAny, ok = jsonResponse["ordered_cards"]
+	}
+	if !ok {
+		diags.AddError("Unable to retrieve dashcards (or ordered_cards) from get dashboard response.", string(bytes))
 		return diags
 	}
 
 	// Cards must be cast as a list of `interface{}` and not directly a list of maps.
 	dashcards, ok := dashcardsAny.([]interface{})
 	if !ok {
-		diags.AddError("Unable to parse ordered_cards as a list from get dashboard response.", string(bytes))
+		diags.AddError("Unable to parse dashcards as a list from get dashboard response.", string(bytes))
 		return diags
 	}
 
+	// Unmarshalling `cards_json` from the Terraform state/plan such that it can be compared to Metabase's response.
+	var existingCards []interface{}
+	if !data.CardsJson.IsNull() {
+		err = json.Unmarshal([]byte(data.CardsJson.ValueString()), &existingCards)
+		if err != nil {
+			diags.AddError("Error deserializing existing cards JSON value.", err.Error())
+			return diags
+		}
+	}
+
 	// Parsing each card individually to remove unhandled attributes within them.
-	for _, c := range dashcards {
+	preserveUnknownAttributes := data.PreserveUnknownDashcardAttributes.ValueBool()
+	for i, c := range dashcards {
 		card, ok := c.(map[string]interface{})
 		if !ok {
 			diags.AddError("Could not parse dashcard as object.", string(bytes))
 			return diags
 		}
 
+		// Cards are not assigned to a tab when the dashboard has none, in which case the attribute is not expected in the
+		// Terraform model.
+		if tabId, ok := card["dashboard_tab_id"]; ok {
+			metabaseTabId, isNumber := tabId.(float64)
+			terraformTabId, found := tabIds[metabaseTabId]
+			if isNumber && found {
+				card["dashboard_tab_id"] = terraformTabId
+			} else {
+				delete(card, "dashboard_tab_id")
+			}
+		}
+
+		// Cards are compared by position. When unknown attributes are preserved, the ones set in the corresponding card of
+		// the Terraform model are kept.
+		var existingCard map[string]interface{}
+		if preserveUnknownAttributes && i < len(existingCards) {
+			existingCard, _ = existingCards[i].(map[string]interface{})
+		}
+
 		// Removing all unhandled attributes such that the cards returned by the Metabase API can be compared with the
 		// `cards_json` in the Terraform state.
 		for key := range card {
-			if !allowedDashcardAttributes[key] {
-				delete(card, key)
+			if allowedDashcardAttributes[key] {
+				continue
 			}
-		}
-	}
 
-	// Unmarshalling `cards_json` from the Terraform state/plan such that it can be compared to Metabase's response.
-	var existingCards []interface{}
-	if !data.CardsJson.IsNull() {
-		err = json.Unmarshal([]byte(data.CardsJson.ValueString()), &existingCards)
-		if err != nil {
-			diags.AddError("Error deserializing existing cards JSON value.", err.Error())
-			return diags
+			if _, isInExistingCard := existingCard[key]; isInExistingCard && !serverOwnedDashcardAttributes[key] {
+				continue
+			}
+
+			delete(card, key)
 		}
 	}
 
@@ -238,8 +818,8 @@ func updateCardsFromRawBody(bytes []byte, data *DashboardResourceModel) diag.Dia
 	// state. There is a high chance this will cause an error in Terraform because `cards_json` should not be modified by
 	// create / update operations (as it is specified by the user). However this error will make it clear what has
 	// happened.
-	if !reflect.DeepEqual(dashcards, existingCards) {
-		cardsJson, err := json.Marshal(dashcards)
+	if !areDashcardsEquivalent(dashcards, existingCards) {
+		cardsJson, err := marshalOpaqueJson(dashcards, existingCards != nil)
 		if err != nil {
 			diags.AddError("Error serializing new JSON value.", err.Error())
 			return diags
@@ -269,9 +849,43 @@ func makeParametersFromModel(ctx context.Context, model types.String) (*[]metaba
 	return &parameters, diags
 }
 
+// Constructs the list of dashboard tabs as a type-less list of maps that can be serialized to JSON.
+// Similarly to cards, the IDs of the tabs are set to negative values, which will cause the Metabase API to create new
+// tabs (and replace the existing ones). The returned map converts the IDs in the Terraform model to the sent IDs.
+func makeTabsFromModel(model types.String) ([]map[string]interface{}, map[interface{}]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	opaqueTabs, tabsDiags := makeOpaqueTabsFromTerraform(model)
+	diags.Append(tabsDiags...)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	tabs := make([]map[string]interface{}, 0, len(opaqueTabs))
+	tabIds := make(map[interface{}]int, len(opaqueTabs))
+	for i, t := range opaqueTabs {
+		tab, ok := t.(map[string]interface{})
+		if !ok || !isValidDashboardTabId(tab["id"]) {
+			diags.AddError("Unable to parse tabs JSON.", "Each tab should be an object with a valid id.")
+			return nil, nil, diags
+		}
+
+		// Negative IDs must be non-zero for Metabase to consider the tab as new.
+		newId := -(i + 1)
+		tabIds[tab["id"]] = newId
+		tab["id"] = newId
+		tab["position"] = i
+
+		tabs = append(tabs, tab)
+	}
+
+	return tabs, tabIds, diags
+}
+
 // Constructs the list of dashboard cards as a type-less list of maps that can be serialized to JSON.
 // The IDs of the cards are set to negative values, which will cause the Metabase API to create new cards (and replace the existing ones).
-func makeCardsFromModel(model types.String) ([]map[string]interface{}, diag.Diagnostics) {
+// The `dashboard_tab_id` of cards is converted to the ID of the tab sent to Metabase using `tabIds`.
+func makeCardsFromModel(model types.String, tabIds map[interface{}]int) ([]map[string]interface{}, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	cardsJson := model.ValueString()
@@ -287,6 +901,23 @@ func makeCardsFromModel(model types.String) ([]map[string]interface{}, diag.Diag
 	// For simplicity, new (negative) IDs are used, which will simply replace the existing cards.
 	for id, c := range cards {
 		c["id"] = -id
+
+		tabId, ok := c["dashboard_tab_id"]
+		if !ok || tabId == nil {
+			continue
+		}
+
+		if !isValidDashboardTabId(tabId) {
+			diags.AddError("Unexpected tab ID referenced by a card.", fmt.Sprintf("Tab ID: %v.", tabId))
+			return nil, diags
+		}
+
+		newTabId, found := tabIds[tabId]
+		if !found {
+			diags.AddError("Unable to find the tab referenced by a card.", fmt.Sprintf("Tab ID: %v.", tabId))
+			return nil, diags
+		}
+		c["dashboard_tab_id"] = newTabId
 	}
 
 	return cards, diags
@@ -328,7 +959,7 @@ func (r *DashboardResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	// The entire model can then simply be populated from the update response.
-	resp.Diagnostics.Append(updateModelFromDashboardAndRawBody(*updateResp.JSON200, updateResp.Body, data)...)
+	resp.Diagnostics.Append(updateModelFromDashboardAndRawBody(*updateResp.JSON200, updateResp.Body, r.siteUrl, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -347,12 +978,24 @@ func makeUpdateFromModel(ctx context.Context, client metabase.ClientWithResponse
 		return nil, diags
 	}
 
-	dashcards, cardsDiags := makeCardsFromModel(data.CardsJson)
+	tabs, tabIds, tabsDiags := makeTabsFromModel(data.TabsJson)
+	diags.Append(tabsDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	dashcards, cardsDiags := makeCardsFromModel(data.CardsJson, tabIds)
 	diags.Append(cardsDiags...)
 	if diags.HasError() {
 		return nil, diags
 	}
 
+	embeddingParams, embeddingParamsDiags := makeEmbeddingParamsFromModel(ctx, data.EmbeddingParams)
+	diags.Append(embeddingParamsDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
 	updatePayload := map[string]interface{}{
 		"name":                valueStringOrNull(data.Name),
 		"description":         valueStringOrNull(data.Description),
@@ -361,6 +1004,12 @@ func makeUpdateFromModel(ctx context.Context, client metabase.ClientWithResponse
 		"collection_position": valueInt64OrNull(data.CollectionPosition),
 		"parameters":          parameters,
 		"dashcards":           dashcards,
+		"tabs":                tabs,
+		"embedding_params":    embeddingParams,
+	}
+	// Embedding is only sent when known, such that the value set in Metabase is kept when the attribute is not configured.
+	if !data.EnableEmbedding.IsNull() && !data.EnableEmbedding.IsUnknown() {
+		updatePayload["enable_embedding"] = data.EnableEmbedding.ValueBool()
 	}
 	updateBuffer, err := json.Marshal(updatePayload)
 	if err != nil {
@@ -387,17 +1036,23 @@ func (r *DashboardResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	getResp, err := r.client.GetDashboardWithResponse(ctx, int(data.Id.ValueInt64()))
-	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200, 404}, "get dashboard")...)
+	status, diags := checkMetabaseResponseStatus(getResp, err, []int{200}, "get dashboard")
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if getResp.StatusCode() == 404 || getResp.JSON200.Archived {
-		resp.State.RemoveResource(ctx)
+	if status == metabaseResponseNotFound {
+		removeMissingResourceFromState(ctx, resp, "dashboard", data.Id.ValueInt64(), "not found")
+		return
+	}
+
+	if getResp.JSON200.Archived {
+		removeMissingResourceFromState(ctx, resp, "dashboard", data.Id.ValueInt64(), "archived")
 		return
 	}
 
-	resp.Diagnostics.Append(updateModelFromDashboardAndRawBody(*getResp.JSON200, getResp.Body, data)...)
+	resp.Diagnostics.Append(updateModelFromDashboardAndRawBody(*getResp.JSON200, getResp.Body, r.siteUrl, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -425,7 +1080,7 @@ func (r *DashboardResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	resp.Diagnostics.Append(updateModelFromDashboardAndRawBody(*updateResp.JSON200, updateResp.Body, data)...)
+	resp.Diagnostics.Append(updateModelFromDashboardAndRawBody(*updateResp.JSON200, updateResp.Body, r.siteUrl, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -6,6 +6,9 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -178,3 +181,330 @@ func TestAccDashboardResource(t *testing.T) {
 		},
 	})
 }
+
+func TestUpdateCardsFromRawBodyWithOrderedCards(t *testing.T) {
+	body := []byte(`{"id":1,"ordered_cards":[{"id":3,"card_id":2,"col":0,"row":0,"size_x":4,"size_y":3,"dashboard_id":1}]}`)
+	data := DashboardResourceModel{
+		CardsJson: types.StringValue(`[{"card_id":2,"col":0,"row":0,"size_x":4,"size_y":3}]`),
+	}
+	expectedCardsJson := data.CardsJson
+
+	diags := updateCardsFromRawBody(body, &data, nil)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error when parsing legacy ordered_cards: %v", diags)
+	}
+
+	if !data.CardsJson.Equal(expectedCardsJson) {
+		t.Errorf("Expected cards JSON to be unchanged, got %s.", data.CardsJson.ValueString())
+	}
+}
+
+func TestValidateDashboardParameters(t *testing.T) {
+	parameters := []interface{}{
+		map[string]interface{}{"id": "a", "required": true, "default": "2024-02"},
+		map[string]interface{}{"id": "b", "required": true},
+		map[string]interface{}{"id": "a"},
+	}
+
+	diags := validateDashboardParameters(parameters)
+
+	if diags.ErrorsCount() != 1 {
+		t.Errorf("Expected one error for the duplicate parameter ID, got %d.", diags.ErrorsCount())
+	}
+	if diags.WarningsCount() != 1 {
+		t.Errorf("Expected one warning for the required parameter without default, got %d.", diags.WarningsCount())
+	}
+}
+
+func TestValidateDashboardEmbeddingParams(t *testing.T) {
+	parameters := []interface{}{
+		map[string]interface{}{"id": "a", "slug": "month"},
+		map[string]interface{}{"id": "b", "slug": "country"},
+	}
+
+	embeddingParams := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"month":   types.StringValue("locked"),
+		"country": types.StringValue("enabled"),
+	})
+	diags := validateDashboardEmbeddingParams(parameters, embeddingParams)
+	if diags.HasError() {
+		t.Errorf("Unexpected error for valid embedding parameters: %v.", diags)
+	}
+
+	embeddingParams = types.MapValueMust(types.StringType, map[string]attr.Value{
+		"month": types.StringValue("locked"),
+		"city":  types.StringValue("enabled"),
+	})
+	diags = validateDashboardEmbeddingParams(parameters, embeddingParams)
+	if diags.ErrorsCount() != 1 {
+		t.Errorf("Expected one error for the undefined parameter slug, got %d.", diags.ErrorsCount())
+	}
+}
+
+func TestDashboardEmbeddingRoundTrip(t *testing.T) {
+	embeddingParams := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"month": types.StringValue("locked"),
+	})
+
+	params, diags := makeEmbeddingParamsFromModel(context.Background(), embeddingParams)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+	if params == nil || (*params)["month"] != metabase.Locked {
+		t.Errorf("Expected the month parameter to be locked, got %v.", params)
+	}
+
+	enabled := true
+	data := DashboardResourceModel{EmbeddingParams: embeddingParams}
+	diags = updateEmbeddingParamsFromDashboard(metabase.Dashboard{EnableEmbedding: &enabled, EmbeddingParams: params}, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+	if !data.EmbeddingParams.Equal(embeddingParams) {
+		t.Errorf("Expected embedding parameters to be unchanged, got %v.", data.EmbeddingParams)
+	}
+
+	// Metabase returns an empty object for dashboards that have never been embedded.
+	data = DashboardResourceModel{EmbeddingParams: types.MapNull(types.StringType)}
+	diags = updateEmbeddingParamsFromDashboard(metabase.Dashboard{EmbeddingParams: &metabase.DashboardEmbeddingParams{}}, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+	if !data.EmbeddingParams.IsNull() {
+		t.Errorf("Expected embedding parameters to be null, got %v.", data.EmbeddingParams)
+	}
+}
+
+func TestValidateDashboardTabs(t *testing.T) {
+	tabs := []interface{}{
+		map[string]interface{}{"id": float64(1), "name": "First"},
+		map[string]interface{}{"id": "second", "name": "Second"},
+	}
+	cards := []interface{}{
+		map[string]interface{}{"card_id": float64(1), "dashboard_tab_id": float64(1)},
+		map[string]interface{}{"card_id": float64(2), "dashboard_tab_id": "second"},
+		map[string]interface{}{"card_id": float64(3)},
+	}
+
+	diags := validateDashboardTabs(tabs, cards)
+	if diags.HasError() {
+		t.Errorf("Unexpected error for valid tabs: %v.", diags)
+	}
+
+	// Removing the second tab should be reported, as the card referencing it would be orphaned.
+	diags = validateDashboardTabs(tabs[:1], cards)
+	if diags.ErrorsCount() != 1 {
+		t.Errorf("Expected one error for the card referencing a removed tab, got %d.", diags.ErrorsCount())
+	}
+
+	diags = validateDashboardTabs(append(tabs, map[string]interface{}{"id": float64(1), "name": "Duplicate"}), cards)
+	if diags.ErrorsCount() != 1 {
+		t.Errorf("Expected one error for the duplicate tab ID, got %d.", diags.ErrorsCount())
+	}
+}
+
+func TestDashboardTabsRoundTrip(t *testing.T) {
+	data := DashboardResourceModel{
+		TabsJson:  types.StringValue(`[{"id":"overview","name":"Overview"},{"id":"details","name":"Details"}]`),
+		CardsJson: types.StringValue(`[{"card_id":1,"dashboard_tab_id":"details"},{"card_id":2,"dashboard_tab_id":"overview"}]`),
+	}
+	expectedTabsJson := data.TabsJson
+	expectedCardsJson := data.CardsJson
+
+	tabs, tabIds, diags := makeTabsFromModel(data.TabsJson)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+	if tabs[0]["id"] != -1 || tabs[1]["id"] != -2 {
+		t.Errorf("Expected tabs to be sent with new (negative) IDs, got %v.", tabs)
+	}
+
+	cards, diags := makeCardsFromModel(data.CardsJson, tabIds)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+	if cards[0]["dashboard_tab_id"] != -2 || cards[1]["dashboard_tab_id"] != -1 {
+		t.Errorf("Expected cards to reference the sent tab IDs, got %v.", cards)
+	}
+
+	// Metabase replaces the negative IDs with its own IDs.
+	body := []byte(`{
+  "id": 1,
+  "tabs": [
+    {"id": 11, "name": "Details", "position": 1, "dashboard_id": 1},
+    {"id": 10, "name": "Overview", "position": 0, "dashboard_id": 1}
+  ],
+  "dashcards": [
+    {"id": 20, "card_id": 1, "dashboard_tab_id": 11},
+    {"id": 21, "card_id": 2, "dashboard_tab_id": 10}
+  ]
+}`)
+
+	metabaseTabIds, diags := updateTabsFromRawBody(body, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	diags = updateCardsFromRawBody(body, &data, metabaseTabIds)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	if !data.TabsJson.Equal(expectedTabsJson) {
+		t.Errorf("Expected tabs JSON to be unchanged, got %s.", data.TabsJson.ValueString())
+	}
+	if !data.CardsJson.Equal(expectedCardsJson) {
+		t.Errorf("Expected cards JSON to be unchanged, got %s.", data.CardsJson.ValueString())
+	}
+}
+
+func TestDashboardTabsWithNullTabsAreCompact(t *testing.T) {
+	body := []byte(`{"id":1,"tabs":[{"id":10,"name":"Overview","position":0,"dashboard_id":1}],"dashcards":[]}`)
+	data := DashboardResourceModel{
+		TabsJson: types.StringNull(),
+	}
+
+	_, diags := updateTabsFromRawBody(body, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	// Tabs which were not previously set (e.g. when importing) are not indented, which matches `jsonencode`.
+	if data.TabsJson.ValueString() != `[{"id":1,"name":"Overview"}]` {
+		t.Errorf("Expected compact tabs JSON, got %s.", data.TabsJson.ValueString())
+	}
+}
+
+func TestDashboardWithoutTabsKeepsNullTabs(t *testing.T) {
+	body := []byte(`{"id":1,"tabs":[],"dashcards":[{"id":3,"card_id":2,"dashboard_tab_id":null}]}`)
+	data := DashboardResourceModel{
+		TabsJson:  types.StringNull(),
+		CardsJson: types.StringValue(`[{"card_id":2}]`),
+	}
+
+	tabIds, diags := updateTabsFromRawBody(body, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	diags = updateCardsFromRawBody(body, &data, tabIds)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	if !data.TabsJson.IsNull() {
+		t.Errorf("Expected tabs JSON to be null, got %s.", data.TabsJson.ValueString())
+	}
+	if data.CardsJson.ValueString() != `[{"card_id":2}]` {
+		t.Errorf("Expected cards JSON to be unchanged, got %s.", data.CardsJson.ValueString())
+	}
+}
+
+func TestTextOnlyDashboardHasNoDiff(t *testing.T) {
+	// Metabase sets `card_id` to `null` and returns empty lists for virtual cards, even if they are omitted.
+	body := []byte(`{"id":1,"dashcards":[{"id":3,"card_id":null,"col":0,"row":0,"size_x":6,"size_y":3,"series":[],"parameter_mappings":[],"visualization_settings":{"virtual_card":{"display":"text"},"text":"🎉"}}]}`)
+
+	for _, cardsJson := range []string{
+		`[{"card_id":null,"col":0,"row":0,"size_x":6,"size_y":3,"series":[],"parameter_mappings":[],"visualization_settings":{"virtual_card":{"display":"text"},"text":"🎉"}}]`,
+		`[{"col":0,"row":0,"size_x":6,"size_y":3,"visualization_settings":{"virtual_card":{"display":"text"},"text":"🎉"}}]`,
+	} {
+		data := DashboardResourceModel{
+			CardsJson: types.StringValue(cardsJson),
+		}
+
+		diags := updateCardsFromRawBody(body, &data, nil)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v.", diags)
+		}
+
+		if data.CardsJson.ValueString() != cardsJson {
+			t.Errorf("Expected cards JSON to be unchanged, got %s.", data.CardsJson.ValueString())
+		}
+	}
+}
+
+func TestTextCardChangeIsDetected(t *testing.T) {
+	body := []byte(`{"id":1,"dashcards":[{"id":3,"card_id":null,"col":0,"row":0,"size_x":6,"size_y":3,"visualization_settings":{"text":"🐶"}}]}`)
+	data := DashboardResourceModel{
+		CardsJson: types.StringValue(`[{"col":0,"row":0,"size_x":6,"size_y":3,"visualization_settings":{"text":"🎉"}}]`),
+	}
+
+	diags := updateCardsFromRawBody(body, &data, nil)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	if compactJson(t, data.CardsJson.ValueString()) != `[{"card_id":null,"col":0,"row":0,"size_x":6,"size_y":3,"visualization_settings":{"text":"🐶"}}]` {
+		t.Errorf("Expected cards JSON to be updated, got %s.", data.CardsJson.ValueString())
+	}
+}
+
+func TestPreserveUnknownDashcardAttributes(t *testing.T) {
+	body := []byte(`{"id":1,"dashcards":[{"id":3,"dashboard_id":1,"card_id":null,"col":0,"row":0,"size_x":6,"size_y":3,"inline_parameters":["abc"],"visualization_settings":{"text":"🎉"}}]}`)
+	cardsJson := `[{"id":3,"col":0,"row":0,"size_x":6,"size_y":3,"inline_parameters":["abc"],"visualization_settings":{"text":"🎉"}}]`
+
+	data := DashboardResourceModel{
+		CardsJson:                         types.StringValue(cardsJson),
+		PreserveUnknownDashcardAttributes: types.BoolValue(true),
+	}
+
+	diags := updateCardsFromRawBody(body, &data, nil)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	// The server-owned `id` is never kept, even if it is part of the definition.
+	expected := `[{"card_id":null,"col":0,"inline_parameters":["abc"],"row":0,"size_x":6,"size_y":3,"visualization_settings":{"text":"🎉"}}]`
+	if compactJson(t, data.CardsJson.ValueString()) != expected {
+		t.Errorf("Expected unknown attributes to be kept, got %s.", data.CardsJson.ValueString())
+	}
+
+	data = DashboardResourceModel{
+		CardsJson: types.StringValue(`[{"col":0,"row":0,"size_x":6,"size_y":3,"inline_parameters":["abc"],"visualization_settings":{"text":"🎉"}}]`),
+	}
+
+	diags = updateCardsFromRawBody(body, &data, nil)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v.", diags)
+	}
+
+	if compactJson(t, data.CardsJson.ValueString()) != `[{"card_id":null,"col":0,"row":0,"size_x":6,"size_y":3,"visualization_settings":{"text":"🎉"}}]` {
+		t.Errorf("Expected unknown attributes to be dropped by default, got %s.", data.CardsJson.ValueString())
+	}
+}
+
+func TestDashcardsRecreatedByUpdate(t *testing.T) {
+	state := DashboardResourceModel{
+		Name:            types.StringValue("🐶"),
+		CardsJson:       types.StringValue(`[{"card_id":2}]`),
+		EnableEmbedding: types.BoolValue(false),
+		EmbeddingParams: types.MapNull(types.StringType),
+	}
+
+	if dashcardsRecreatedByUpdate(state, state) {
+		t.Errorf("Expected dashcards not to be recreated when nothing changes.")
+	}
+
+	plan := state
+	plan.Name = types.StringValue("🐱")
+	if !dashcardsRecreatedByUpdate(state, plan) {
+		t.Errorf("Expected dashcards to be recreated when the name changes.")
+	}
+
+	plan.CardsJson = types.StringValue(`[{"card_id":3}]`)
+	if dashcardsRecreatedByUpdate(state, plan) {
+		t.Errorf("Expected no warning when the cards change.")
+	}
+
+	plan = state
+	plan.EnableEmbedding = types.BoolValue(true)
+	if !dashcardsRecreatedByUpdate(state, plan) {
+		t.Errorf("Expected dashcards to be recreated when embedding is enabled.")
+	}
+
+	plan = state
+	plan.EmbeddingParams = types.MapValueMust(types.StringType, map[string]attr.Value{"region": types.StringValue("enabled")})
+	if !dashcardsRecreatedByUpdate(state, plan) {
+		t.Errorf("Expected dashcards to be recreated when embedding parameters change.")
+	}
+}
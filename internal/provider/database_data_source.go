@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigValidators = &DatabaseDataSource{}
+
+// Creates a new database data source.
+func NewDatabaseDataSource() datasource.DataSource {
+	return &DatabaseDataSource{}
+}
+
+// A data source obtaining details about a database, e.g. one that is not managed by Terraform.
+// The engine and features can be used to adapt the configuration to the type of database.
+type DatabaseDataSource struct {
+	// The Metabase API client.
+	client *metabase.ClientWithResponses
+}
+
+// The Terraform model for a database.
+type DatabaseDataSourceModel struct {
+	Id                types.Int64  `tfsdk:"id"`                  // The ID of the database.
+	Name              types.String `tfsdk:"name"`                // The name of the database.
+	Engine            types.String `tfsdk:"engine"`              // The engine used to connect to the database.
+	IsSample          types.Bool   `tfsdk:"is_sample"`           // Whether this is the sample database.
+	InitialSyncStatus types.String `tfsdk:"initial_sync_status"` // The status of the first sync of the database.
+	Features          types.List   `tfsdk:"features"`            // The features supported by the database engine.
+}
+
+func (d *DatabaseDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database"
+}
+
+func (d *DatabaseDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A Metabase database, looked up using its ID or its name.
+
+This data source can be useful to reference databases which are not managed by Terraform, or to adapt the configuration depending on the engine or the features supported by a database.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the database. Exactly one of `id` or `name` should be specified.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the database. Exactly one of `id` or `name` should be specified.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"engine": schema.StringAttribute{
+				MarkdownDescription: "The engine used to connect to the database, e.g. `bigquery-cloud-sdk` or `postgres`.",
+				Computed:            true,
+			},
+			"is_sample": schema.BoolAttribute{
+				MarkdownDescription: "Whether this is the sample database shipped with Metabase.",
+				Computed:            true,
+			},
+			"initial_sync_status": schema.StringAttribute{
+				MarkdownDescription: "The status of the first sync of the database, e.g. `incomplete` or `complete`.",
+				Computed:            true,
+			},
+			"features": schema.ListAttribute{
+				MarkdownDescription: "The list of features supported by the database engine, e.g. `nested-queries`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DatabaseDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *DatabaseDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*metabase.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected client type when configuring Metabase data source.",
+			fmt.Sprintf("Expected *metabase.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Finds the ID of the database with the given name in the list returned by the Metabase API.
+func findDatabaseIdByName(databases []metabase.Database, name string) (*int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var databaseId *int
+	for _, db := range databases {
+		if db.Name != name {
+			continue
+		}
+
+		if databaseId != nil {
+			diags.AddError("Found several databases matching the name.", name)
+			return nil, diags
+		}
+
+		id := db.Id
+		databaseId = &id
+	}
+
+	if databaseId == nil {
+		diags.AddError("Unable to find a database matching the name.", name)
+		return nil, diags
+	}
+
+	return databaseId, diags
+}
+
+// Updates the given `DatabaseDataSourceModel` from the `Database` returned by the Metabase API.
+func updateDataSourceModelFromDatabase(db metabase.Database, data *DatabaseDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.Int64Value(int64(db.Id))
+	data.Name = types.StringValue(db.Name)
+	data.Engine = types.StringValue(string(db.Engine))
+	data.IsSample = types.BoolValue(db.IsSample != nil && *db.IsSample)
+	data.InitialSyncStatus = stringValueOrNull(db.InitialSyncStatus)
+
+	features := []attr.Value{}
+	if db.Features != nil {
+		for _, f := range *db.Features {
+			features = append(features, types.StringValue(f))
+		}
+	}
+
+	featuresValue, listDiags := types.ListValue(types.StringType, features)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	data.Features = featuresValue
+
+	return diags
+}
+
+func (d *DatabaseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DatabaseDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	databaseId := int(data.Id.ValueInt64())
+	if data.Id.IsNull() {
+		listResp, err := d.client.ListDatabasesWithResponse(ctx, &metabase.ListDatabasesParams{})
+
+		resp.Diagnostics.Append(checkMetabaseResponse(listResp, err, []int{200}, "list databases")...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		id, diags := findDatabaseIdByName(listResp.JSON200.Data, data.Name.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		databaseId = *id
+	}
+
+	// The database is always fetched individually, as the list response does not contain all its details.
+	getResp, err := d.client.GetDatabaseWithResponse(ctx, databaseId)
+
+	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200}, "get database")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateDataSourceModelFromDatabase(*getResp.JSON200, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
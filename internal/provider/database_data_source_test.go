@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+)
+
+func TestFindDatabaseIdByName(t *testing.T) {
+	databases := []metabase.Database{
+		{Id: 1, Name: "Sample Database"},
+		{Id: 2, Name: "Warehouse"},
+		{Id: 3, Name: "Duplicate"},
+		{Id: 4, Name: "Duplicate"},
+	}
+
+	id, diags := findDatabaseIdByName(databases, "Warehouse")
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if *id != 2 {
+		t.Errorf("Expected database 2, got %d.", *id)
+	}
+
+	_, diags = findDatabaseIdByName(databases, "Duplicate")
+	if !diags.HasError() {
+		t.Errorf("Expected an error when several databases match the name.")
+	}
+
+	_, diags = findDatabaseIdByName(databases, "Missing")
+	if !diags.HasError() {
+		t.Errorf("Expected an error when no database matches the name.")
+	}
+}
+
+func TestUpdateDataSourceModelFromDatabase(t *testing.T) {
+	var db metabase.Database
+	err := json.Unmarshal([]byte(`{
+		"id": 2,
+		"name": "Warehouse",
+		"engine": "postgres",
+		"details": {},
+		"initial_sync_status": "complete",
+		"features": ["nested-queries", "left-join"]
+	}`), &db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var data DatabaseDataSourceModel
+	diags := updateDataSourceModelFromDatabase(db, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if data.Engine.ValueString() != "postgres" {
+		t.Errorf("Expected engine postgres, got %s.", data.Engine.String())
+	}
+	if data.IsSample.IsNull() || data.IsSample.ValueBool() {
+		t.Errorf("Expected a missing is_sample to be false, got %s.", data.IsSample.String())
+	}
+	if len(data.Features.Elements()) != 2 {
+		t.Errorf("Expected 2 features, got %d.", len(data.Features.Elements()))
+	}
+}
@@ -3,21 +3,38 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// The interval between two checks of the initial sync status of a database.
+var initialSyncPollInterval = 5 * time.Second
+
+// The default number of minutes to wait for the initial sync of a database to complete.
+const defaultInitialSyncTimeoutMinutes = 30
+
 // Ensures provider defined types fully satisfy framework interfaces.
 var _ resource.ResourceWithImportState = &DatabaseResource{}
+var _ resource.ResourceWithConfigValidators = &DatabaseResource{}
+var _ resource.ResourceWithValidateConfig = &DatabaseResource{}
 
 // Creates a new database resource.
 func NewDatabaseResource() resource.Resource {
@@ -33,10 +50,18 @@ type DatabaseResource struct {
 
 // The Terraform model for a database.
 type DatabaseResourceModel struct {
-	Id              types.Int64  `tfsdk:"id"`               // The ID of the database.
-	Name            types.String `tfsdk:"name"`             // A displayable name for the database.
-	BigQueryDetails types.Object `tfsdk:"bigquery_details"` // The configuration for a BigQuery database.
-	CustomDetails   types.Object `tfsdk:"custom_details"`   // The configuration for a database not supported by the provider.
+	Id                    types.Int64  `tfsdk:"id"`                      // The ID of the database.
+	Name                  types.String `tfsdk:"name"`                    // A displayable name for the database.
+	BigQueryDetails       types.Object `tfsdk:"bigquery_details"`        // The configuration for a BigQuery database.
+	ClickHouseDetails     types.Object `tfsdk:"clickhouse_details"`      // The configuration for a ClickHouse database.
+	CustomDetails         types.Object `tfsdk:"custom_details"`          // The configuration for a database not supported by the provider.
+	WaitForInitialSync    types.Bool   `tfsdk:"wait_for_initial_sync"`   // Whether to wait for the initial sync of the database to complete when creating it.
+	InitialSyncTimeout    types.Int64  `tfsdk:"initial_sync_timeout"`    // The number of minutes to wait for the initial sync of the database.
+	ValidateConnection    types.Bool   `tfsdk:"validate_connection"`     // Whether to check the connection details before creating or updating the database.
+	DetectCredentialDrift types.Bool   `tfsdk:"detect_credential_drift"` // Whether credentials should be sent again when the database is modified outside of Terraform.
+	UpdatedAt             types.String `tfsdk:"updated_at"`              // The last time the database was modified in Metabase.
+	AdoptSample           types.Bool   `tfsdk:"adopt_sample"`            // Whether the resource manages the existing sample database rather than creating one.
+	SettingsJson          types.String `tfsdk:"settings_json"`           // The database-level settings, as a JSON string.
 }
 
 // The content of the `bigquery_details` attribute to set up a BigQuery connection.
@@ -47,6 +72,17 @@ type BigQueryDetails struct {
 	DatasetFiltersPatterns types.String `tfsdk:"dataset_filters_patterns"` // The pattern when filtering datasets.
 }
 
+// The content of the `clickhouse_details` attribute to set up a ClickHouse connection.
+type ClickHouseDetails struct {
+	Host              types.String `tfsdk:"host"`               // The host name or IP address of the ClickHouse server.
+	Port              types.Int64  `tfsdk:"port"`               // The HTTP port of the ClickHouse server.
+	User              types.String `tfsdk:"user"`               // The user name used to connect to the database.
+	Password          types.String `tfsdk:"password"`           // The password used to connect to the database.
+	DbName            types.String `tfsdk:"dbname"`             // The name of the database.
+	Ssl               types.Bool   `tfsdk:"ssl"`                // Whether to use TLS when connecting to the server.
+	AdditionalOptions types.String `tfsdk:"additional_options"` // Additional options appended to the JDBC connection string.
+}
+
 // The content of the `custom_details` attribute to set up a database not supported by this provider.
 type CustomDetails struct {
 	Engine             types.String `tfsdk:"engine"`              // The name of the engine, as defined by Metabase.
@@ -64,6 +100,19 @@ var bigQueryDetailsObjectType = types.ObjectType{
 	},
 }
 
+// The object type for ClickHouse details.
+var clickHouseDetailsObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"host":               types.StringType,
+		"port":               types.Int64Type,
+		"user":               types.StringType,
+		"password":           types.StringType,
+		"dbname":             types.StringType,
+		"ssl":                types.BoolType,
+		"additional_options": types.StringType,
+	},
+}
+
 // The object type for custom details.
 var customDetailsObjectType = types.ObjectType{
 	AttrTypes: map[string]attr.Type{
@@ -77,7 +126,11 @@ var customDetailsObjectType = types.ObjectType{
 
 func (r *DatabaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: `A database Metabase can connect to. Currently only BigQuery has a dedicated attribute, but any engine can be set up using the custom_details attribute.
+		MarkdownDescription: `A database Metabase can connect to. Currently only BigQuery and ClickHouse have dedicated attributes, but any engine can be set up using the custom_details attribute. Exactly one of the details attributes must be set.
+
+Alternatively, setting adopt_sample brings the Sample Database shipped with Metabase under management, such that it can be renamed. In this case, no details attribute should be set, and the database is left intact when the resource is destroyed. Importing the sample database also adopts it.
+
+Database-level settings (e.g. database-enable-actions) can be managed using settings_json. Only the settings listed in the JSON are compared to the Metabase response, such that settings added by Metabase do not cause a diff.
 
 The configuration of this resource requires passing sensitive credentials to the Metabase API. Those credentials will also be stored in the Terraform state. Ensure those values are not checked into a repository nor are being displayed during Terraform operations.`,
 
@@ -96,7 +149,7 @@ The configuration of this resource requires passing sensitive credentials to the
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
 					"service_account_key": schema.StringAttribute{
-						MarkdownDescription: "The content of the service account key file.",
+						MarkdownDescription: "The content of the service account key file. When the key changes, the connection is always validated using the new key before updating the database, even if `validate_connection` is not set. This avoids losing access to BigQuery when rotating keys.",
 						Required:            true,
 						Sensitive:           true,
 					},
@@ -107,13 +160,85 @@ The configuration of this resource requires passing sensitive credentials to the
 					"dataset_filters_type": schema.StringAttribute{
 						MarkdownDescription: "The behavior of how BigQuery datasets should be selected. Can be `inclusion`, `exclusion`, or `all`.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(metabase.Inclusion),
+								string(metabase.Exclusion),
+								string(metabase.All),
+							),
+						},
 					},
 					"dataset_filters_patterns": schema.StringAttribute{
-						MarkdownDescription: "The pattern used by the `dataset-filters-type`.",
+						MarkdownDescription: "The pattern used by the `dataset-filters-type`. This can only be set when `dataset_filters_type` is `inclusion` or `exclusion`.",
 						Optional:            true,
 					},
 				},
 			},
+			"clickhouse_details": schema.SingleNestedAttribute{
+				MarkdownDescription: "Connection details when setting up a ClickHouse database. This requires the ClickHouse driver to be installed in Metabase.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						MarkdownDescription: "The host name or IP address of the ClickHouse server.",
+						Required:            true,
+					},
+					"port": schema.Int64Attribute{
+						MarkdownDescription: "The HTTP port of the ClickHouse server.",
+						Optional:            true,
+					},
+					"user": schema.StringAttribute{
+						MarkdownDescription: "The user name used to connect to the database.",
+						Optional:            true,
+					},
+					"password": schema.StringAttribute{
+						MarkdownDescription: "The password used to connect to the database.",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"dbname": schema.StringAttribute{
+						MarkdownDescription: "The name of the database.",
+						Optional:            true,
+					},
+					"ssl": schema.BoolAttribute{
+						MarkdownDescription: "Whether to use TLS when connecting to the server.",
+						Optional:            true,
+					},
+					"additional_options": schema.StringAttribute{
+						MarkdownDescription: "Additional options appended to the JDBC connection string, e.g. `socket_timeout=300000`. The value is passed through to Metabase as is.",
+						Optional:            true,
+					},
+				},
+			},
+			"wait_for_initial_sync": schema.BoolAttribute{
+				MarkdownDescription: "Whether the creation of the database should wait for Metabase to complete the initial sync of the database. This ensures tables and fields are known to Metabase when they are looked up by other resources and data sources in the same apply. Defaults to `false`.",
+				Optional:            true,
+			},
+			"initial_sync_timeout": schema.Int64Attribute{
+				MarkdownDescription: "The number of minutes to wait for the initial sync of the database to complete when `wait_for_initial_sync` is set. Defaults to `30`.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"validate_connection": schema.BoolAttribute{
+				MarkdownDescription: "Whether Metabase should check that it can connect to the database before creating it or updating its details. If the connection fails, the operation fails with the error returned by the driver. Defaults to `false`.",
+				Optional:            true,
+			},
+			"detect_credential_drift": schema.BoolAttribute{
+				MarkdownDescription: "Whether to assume credentials may have been rotated when the database is modified outside of Terraform, i.e. when `updated_at` changes. Because Metabase redacts credentials, the provider otherwise keeps the values from the state and cannot detect such a change. When this is enabled and a change is detected, a warning is raised and the next apply sends the configured credentials again. Metabase may also modify the database itself (e.g. during a sync), which will cause the credentials to be sent again. Defaults to `false`.",
+				Optional:            true,
+			},
+			"adopt_sample": schema.BoolAttribute{
+				MarkdownDescription: "Whether this resource manages the Sample Database shipped with Metabase rather than creating a new database. The sample database is looked up instead of being created, only its `name` is managed, and it is not deleted when the resource is destroyed. None of the details attributes can be set. Defaults to `false`.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+			},
+			"settings_json": schema.StringAttribute{
+				MarkdownDescription: "The database-level settings, as a JSON object string, e.g. `jsonencode({ database-enable-actions = true })`. Only the listed settings are managed, and removing a setting from the JSON does not reset it in Metabase.",
+				Optional:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The last time the database was modified in Metabase, as an ISO 8601 timestamp.",
+				Computed:            true,
+			},
 			"custom_details": schema.SingleNestedAttribute{
 				MarkdownDescription: "Connection details when setting up a database which is not supported by this provider.",
 				Optional:            true,
@@ -137,6 +262,105 @@ The configuration of this resource requires passing sensitive credentials to the
 	}
 }
 
+func (r *DatabaseResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		// The engine is determined by the details attribute, such that only one of them can be set.
+		// Whether one of them is required depends on `adopt_sample`, which is checked by `ValidateConfig`.
+		resourcevalidator.Conflicting(
+			path.MatchRoot("bigquery_details"),
+			path.MatchRoot("clickhouse_details"),
+			path.MatchRoot("custom_details"),
+		),
+	}
+}
+
+// Checks that a details attribute is set, unless the sample database is adopted, in which case none can be set.
+func validateDatabaseDetailsAttributes(data DatabaseResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.AdoptSample.IsUnknown() || data.BigQueryDetails.IsUnknown() || data.ClickHouseDetails.IsUnknown() || data.CustomDetails.IsUnknown() {
+		return diags
+	}
+
+	detailsAreSet := !data.BigQueryDetails.IsNull() || !data.ClickHouseDetails.IsNull() || !data.CustomDetails.IsNull()
+
+	if data.AdoptSample.ValueBool() {
+		if detailsAreSet {
+			diags.AddAttributeError(
+				path.Root("adopt_sample"),
+				"Details cannot be set when adopting the sample database.",
+				"The connection to the sample database is managed by Metabase. bigquery_details, clickhouse_details, and custom_details should be omitted.",
+			)
+		}
+		return diags
+	}
+
+	if !detailsAreSet {
+		diags.AddError(
+			"Missing database details.",
+			"Exactly one of bigquery_details, clickhouse_details, or custom_details must be set, unless adopt_sample is true.",
+		)
+	}
+
+	return diags
+}
+
+// Checks that BigQuery dataset filter patterns are only set when datasets are filtered by inclusion or exclusion.
+func validateBigQueryDatasetFilters(bqd BigQueryDetails) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if bqd.DatasetFiltersType.IsUnknown() || bqd.DatasetFiltersPatterns.IsNull() {
+		return diags
+	}
+
+	filtersType := bqd.DatasetFiltersType.ValueString()
+	if filtersType == string(metabase.Inclusion) || filtersType == string(metabase.Exclusion) {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		path.Root("bigquery_details").AtName("dataset_filters_patterns"),
+		"Dataset filter patterns cannot be set when all datasets are selected.",
+		"dataset_filters_patterns can only be set when dataset_filters_type is inclusion or exclusion. It should be omitted when dataset_filters_type is all or not set.",
+	)
+
+	return diags
+}
+
+func (r *DatabaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DatabaseResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateDatabaseDetailsAttributes(data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.SettingsJson.IsUnknown() {
+		_, diags := makeDatabaseSettingsFromModel(data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if data.BigQueryDetails.IsNull() || data.BigQueryDetails.IsUnknown() {
+		return
+	}
+
+	var bqd BigQueryDetails
+	resp.Diagnostics.Append(data.BigQueryDetails.As(ctx, &bqd, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateBigQueryDatasetFilters(bqd)...)
+}
+
 // Makes the Terraform object for the `bigquery_details` field.
 func makeBigQueryDetailsFromDatabase(ctx context.Context, db metabase.Database, data *DatabaseResourceModel) (*basetypes.ObjectValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -163,11 +387,65 @@ func makeBigQueryDetailsFromDatabase(ctx context.Context, db metabase.Database,
 		serviceAccountKey = bqd.ServiceAccountKey.ValueString()
 	}
 
+	// Patterns are meaningless when all datasets are selected, even though Metabase may return a default value.
+	datasetFiltersPatterns := ddbq.DatasetFiltersPatterns
+	if ddbq.DatasetFiltersType == nil || *ddbq.DatasetFiltersType == metabase.All {
+		datasetFiltersPatterns = nil
+	}
+
 	details, objectDiags := types.ObjectValue(bigQueryDetailsObjectType.AttrTypes, map[string]attr.Value{
 		"service_account_key":      types.StringValue(serviceAccountKey),
 		"project_id":               stringValueOrNull(ddbq.ProjectId),
 		"dataset_filters_type":     stringValueOrNull(ddbq.DatasetFiltersType),
-		"dataset_filters_patterns": stringValueOrNull(ddbq.DatasetFiltersPatterns),
+		"dataset_filters_patterns": stringValueOrNull(datasetFiltersPatterns),
+	})
+	diags.Append(objectDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &details, diags
+}
+
+// Makes the Terraform object for the `clickhouse_details` field.
+func makeClickHouseDetailsFromDatabase(ctx context.Context, db metabase.Database, data *DatabaseResourceModel) (*basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ddch, err := db.Details.AsDatabaseDetailsClickHouse()
+	if err != nil {
+		diags.AddError("Unable to parse database details for ClickHouse engine.", err.Error())
+		return nil, diags
+	}
+
+	// Metabase returns a redacted value for this field, which is only used when the resource is imported.
+	password := stringValueOrNull(ddch.Password)
+
+	// If available, retrieve the existing database configuration to use it instead of the redacted value returned by
+	// the Metabase API.
+	if !data.ClickHouseDetails.IsNull() {
+		var chd ClickHouseDetails
+		diags.Append(data.ClickHouseDetails.As(ctx, &chd, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		password = chd.Password
+	}
+
+	// Metabase may return an empty string when no additional option is set, which is equivalent to not setting it.
+	additionalOptions := stringValueOrNull(ddch.AdditionalOptions)
+	if ddch.AdditionalOptions != nil && *ddch.AdditionalOptions == "" {
+		additionalOptions = types.StringNull()
+	}
+
+	details, objectDiags := types.ObjectValue(clickHouseDetailsObjectType.AttrTypes, map[string]attr.Value{
+		"host":               types.StringValue(ddch.Host),
+		"port":               int64ValueOrNull(ddch.Port),
+		"user":               stringValueOrNull(ddch.User),
+		"password":           password,
+		"dbname":             stringValueOrNull(ddch.Dbname),
+		"ssl":                boolValueOrNull(ddch.Ssl),
+		"additional_options": additionalOptions,
 	})
 	diags.Append(objectDiags...)
 	if diags.HasError() {
@@ -261,12 +539,85 @@ func makeCustomDetailsFromResponseBody(ctx context.Context, db metabase.Database
 	return &details, diags
 }
 
+// Parses the database settings from the `settings_json` attribute. `nil` is returned if the attribute is not set.
+func makeDatabaseSettingsFromModel(data DatabaseResourceModel) (*metabase.DatabaseSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if data.SettingsJson.IsNull() {
+		return nil, diags
+	}
+
+	var settings metabase.DatabaseSettings
+	err := json.Unmarshal([]byte(data.SettingsJson.ValueString()), &settings)
+	if err != nil || settings == nil {
+		detail := "The settings should be a JSON object."
+		if err != nil {
+			detail = err.Error()
+		}
+
+		diags.AddAttributeError(path.Root("settings_json"), "Unable to parse the database settings JSON.", detail)
+		return nil, diags
+	}
+
+	return &settings, diags
+}
+
+// Makes the value of the `settings_json` attribute from the settings returned by the Metabase API.
+// Settings which are not part of the existing value are ignored, as Metabase may add its own settings to the database.
+// If the attribute is not set, it is left null, such that settings are only managed when explicitly configured.
+func makeSettingsJsonFromDatabase(db metabase.Database, data DatabaseResourceModel) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	existingSettings, settingsDiags := makeDatabaseSettingsFromModel(data)
+	diags.Append(settingsDiags...)
+	if diags.HasError() || existingSettings == nil {
+		return data.SettingsJson, diags
+	}
+
+	settings := metabase.DatabaseSettings{}
+	if db.Settings != nil {
+		for key, value := range *db.Settings {
+			if _, exists := (*existingSettings)[key]; exists {
+				settings[key] = value
+			}
+		}
+	}
+
+	if reflect.DeepEqual(*existingSettings, settings) {
+		return data.SettingsJson, diags
+	}
+
+	settingsBytes, err := json.Marshal(settings)
+	if err != nil {
+		diags.AddError("Error serializing new JSON value for database settings.", err.Error())
+		return data.SettingsJson, diags
+	}
+
+	return types.StringValue(string(settingsBytes)), diags
+}
+
 // Updates the given `DatabaseResourceModel` from the `Database` returned by the Metabase API.
 func updateModelFromDatabase(ctx context.Context, db metabase.Database, data *DatabaseResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	data.Id = types.Int64Value(int64(db.Id))
 	data.Name = types.StringValue(db.Name)
+	data.UpdatedAt = stringValueOrNull(db.UpdatedAt)
+
+	settingsJson, settingsDiags := makeSettingsJsonFromDatabase(db, *data)
+	diags.Append(settingsDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	data.SettingsJson = settingsJson
+
+	// The connection to the sample database is managed by Metabase, and is not exposed by the resource.
+	if data.AdoptSample.ValueBool() {
+		data.BigQueryDetails = types.ObjectNull(bigQueryDetailsObjectType.AttrTypes)
+		data.ClickHouseDetails = types.ObjectNull(clickHouseDetailsObjectType.AttrTypes)
+		data.CustomDetails = types.ObjectNull(customDetailsObjectType.AttrTypes)
+		return diags
+	}
 
 	switch db.Engine {
 	case metabase.BigqueryCloudSdk:
@@ -277,6 +628,17 @@ func updateModelFromDatabase(ctx context.Context, db metabase.Database, data *Da
 		}
 
 		data.BigQueryDetails = *details
+		data.ClickHouseDetails = types.ObjectNull(clickHouseDetailsObjectType.AttrTypes)
+		data.CustomDetails = types.ObjectNull(customDetailsObjectType.AttrTypes)
+	case metabase.Clickhouse:
+		details, chDiags := makeClickHouseDetailsFromDatabase(ctx, db, data)
+		diags.Append(chDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		data.BigQueryDetails = types.ObjectNull(bigQueryDetailsObjectType.AttrTypes)
+		data.ClickHouseDetails = *details
 		data.CustomDetails = types.ObjectNull(customDetailsObjectType.AttrTypes)
 	default:
 		details, customDiags := makeCustomDetailsFromResponseBody(ctx, db, data)
@@ -286,12 +648,74 @@ func updateModelFromDatabase(ctx context.Context, db metabase.Database, data *Da
 		}
 
 		data.BigQueryDetails = types.ObjectNull(bigQueryDetailsObjectType.AttrTypes)
+		data.ClickHouseDetails = types.ObjectNull(clickHouseDetailsObjectType.AttrTypes)
 		data.CustomDetails = *details
 	}
 
 	return diags
 }
 
+// Removes the credentials from the details in the model, such that the redacted values returned by Metabase are used
+// instead when the model is updated from the API response. For custom details, redacted attributes are removed from
+// the JSON details altogether.
+func forgetDatabaseCredentials(ctx context.Context, data *DatabaseResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.BigQueryDetails = types.ObjectNull(bigQueryDetailsObjectType.AttrTypes)
+	data.ClickHouseDetails = types.ObjectNull(clickHouseDetailsObjectType.AttrTypes)
+
+	if data.CustomDetails.IsNull() {
+		return diags
+	}
+
+	var cd CustomDetails
+	diags.Append(data.CustomDetails.As(ctx, &cd, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if cd.RedactedAttributes.IsNull() || cd.DetailsJson.IsNull() {
+		return diags
+	}
+
+	var redactedAttributes []string
+	diags.Append(cd.RedactedAttributes.ElementsAs(ctx, &redactedAttributes, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var details map[string]interface{}
+	err := json.Unmarshal([]byte(cd.DetailsJson.ValueString()), &details)
+	if err != nil {
+		diags.AddError("Error deserializing existing custom details JSON.", err.Error())
+		return diags
+	}
+
+	for _, attribute := range redactedAttributes {
+		delete(details, attribute)
+	}
+
+	detailsBytes, err := json.Marshal(details)
+	if err != nil {
+		diags.AddError("Error serializing new JSON value for database details.", err.Error())
+		return diags
+	}
+
+	customDetails, objectDiags := types.ObjectValue(customDetailsObjectType.AttrTypes, map[string]attr.Value{
+		"engine":              cd.Engine,
+		"details_json":        types.StringValue(string(detailsBytes)),
+		"redacted_attributes": cd.RedactedAttributes,
+	})
+	diags.Append(objectDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.CustomDetails = customDetails
+
+	return diags
+}
+
 // Contains the two fields fully describing the connection to a database.
 // This can then be used to populate payloads when making requests against the database API.
 type DatabaseEngineAndDetails struct {
@@ -327,6 +751,28 @@ func makeEngineAndDetailsFromModel(ctx context.Context, data DatabaseResourceMod
 			diags.AddError("Failed to prepare database payload from Terraform model.", err.Error())
 			return nil, diags
 		}
+	} else if !data.ClickHouseDetails.IsNull() {
+		var chd ClickHouseDetails
+		diags.Append(data.ClickHouseDetails.As(ctx, &chd, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		engine = metabase.Clickhouse
+
+		err := details.FromDatabaseDetailsClickHouse(metabase.DatabaseDetailsClickHouse{
+			Host:              chd.Host.ValueString(),
+			Port:              valueInt64OrNull(chd.Port),
+			User:              valueStringOrNull(chd.User),
+			Password:          valueStringOrNull(chd.Password),
+			Dbname:            valueStringOrNull(chd.DbName),
+			Ssl:               valueBoolOrNull(chd.Ssl),
+			AdditionalOptions: valueStringOrNull(chd.AdditionalOptions),
+		})
+		if err != nil {
+			diags.AddError("Failed to prepare database payload from Terraform model.", err.Error())
+			return nil, diags
+		}
 	} else if !data.CustomDetails.IsNull() {
 		var cd CustomDetails
 		diags.Append(data.CustomDetails.As(ctx, &cd, basetypes.ObjectAsOptions{})...)
@@ -362,6 +808,177 @@ func makeEngineAndDetailsFromModel(ctx context.Context, data DatabaseResourceMod
 	}, diags
 }
 
+// Returns whether the BigQuery service account key differs between the plan and the state, e.g. when it is rotated.
+func isBigQueryServiceAccountKeyChanged(ctx context.Context, data DatabaseResourceModel, state DatabaseResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if data.BigQueryDetails.IsNull() || data.BigQueryDetails.IsUnknown() || state.BigQueryDetails.IsNull() {
+		return false, diags
+	}
+
+	var planDetails BigQueryDetails
+	diags.Append(data.BigQueryDetails.As(ctx, &planDetails, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	var stateDetails BigQueryDetails
+	diags.Append(state.BigQueryDetails.As(ctx, &stateDetails, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return !planDetails.ServiceAccountKey.Equal(stateDetails.ServiceAccountKey), diags
+}
+
+// Checks that Metabase can connect to the database using the given engine and details.
+// Metabase either returns a 400 error containing the driver error, or a result with `valid` set to `false`.
+func validateDatabaseConnection(ctx context.Context, client metabase.ClientWithResponsesInterface, engineAndDetails DatabaseEngineAndDetails) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	validateResp, err := client.ValidateDatabaseWithResponse(ctx, metabase.ValidateDatabaseBody{
+		Details: metabase.ValidateDatabaseDetails{
+			Engine:  engineAndDetails.Engine,
+			Details: engineAndDetails.Details,
+		},
+	})
+
+	diags.Append(checkMetabaseResponse(validateResp, err, []int{200, 400}, "validate database")...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if validateResp.StatusCode() == 400 {
+		diags.AddError("Metabase could not connect to the database.", validateResp.BodyString())
+		return diags
+	}
+
+	if !validateResp.JSON200.Valid {
+		message := "No error message was returned by Metabase."
+		if validateResp.JSON200.Message != nil {
+			message = *validateResp.JSON200.Message
+		}
+
+		diags.AddError("Metabase could not connect to the database.", message)
+		return diags
+	}
+
+	return diags
+}
+
+// Polls the Metabase API until the initial sync of the given database is complete.
+// An error is returned if the sync is aborted or if it does not complete before the timeout. Only a warning is returned
+// if Metabase does not report the sync status, e.g. for older versions.
+func waitForDatabaseInitialSync(ctx context.Context, client metabase.ClientWithResponsesInterface, databaseId int, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	deadline := time.Now().Add(timeout)
+	for {
+		getResp, err := client.GetDatabaseWithResponse(ctx, databaseId)
+		diags.Append(checkMetabaseResponse(getResp, err, []int{200}, "get database")...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if getResp.JSON200.InitialSyncStatus == nil {
+			diags.AddWarning(
+				"Unable to wait for the initial sync of the database.",
+				fmt.Sprintf("Metabase did not return the initial sync status of database %d, which may be caused by an older version of Metabase. Tables and fields may not be available yet.", databaseId),
+			)
+			return diags
+		}
+
+		status := *getResp.JSON200.InitialSyncStatus
+
+		switch status {
+		case "complete":
+			tflog.Info(ctx, "Initial sync of the database is complete.", map[string]interface{}{
+				"database_id": databaseId,
+			})
+			return diags
+		case "aborted":
+			diags.AddError("The initial sync of the database has been aborted.", fmt.Sprintf("Database ID: %d.", databaseId))
+			return diags
+		}
+
+		if time.Now().After(deadline) {
+			diags.AddError(
+				"Timed out while waiting for the initial sync of the database to complete.",
+				fmt.Sprintf("Database ID: %d, last status: %s.", databaseId, status),
+			)
+			return diags
+		}
+
+		tflog.Info(ctx, "Waiting for the initial sync of the database to complete.", map[string]interface{}{
+			"database_id": databaseId,
+			"status":      status,
+		})
+
+		select {
+		case <-ctx.Done():
+			diags.AddError("Interrupted while waiting for the initial sync of the database to complete.", ctx.Err().Error())
+			return diags
+		case <-time.After(initialSyncPollInterval):
+		}
+	}
+}
+
+// Finds the sample database shipped with Metabase in the given list.
+func findSampleDatabase(databases []metabase.Database) (*metabase.Database, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for _, db := range databases {
+		if db.IsSample != nil && *db.IsSample {
+			return &db, diags
+		}
+	}
+
+	diags.AddAttributeError(
+		path.Root("adopt_sample"),
+		"Unable to find the sample database.",
+		"The sample database may have been deleted from the Metabase instance.",
+	)
+	return nil, diags
+}
+
+// Looks up the sample database and sets its name, rather than creating a new database.
+func (r *DatabaseResource) adoptSampleDatabase(ctx context.Context, data *DatabaseResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	listResp, err := r.client.ListDatabasesWithResponse(ctx, &metabase.ListDatabasesParams{})
+
+	diags.Append(checkMetabaseResponse(listResp, err, []int{200}, "list databases")...)
+	if diags.HasError() {
+		return diags
+	}
+
+	sample, findDiags := findSampleDatabase(listResp.JSON200.Data)
+	diags.Append(findDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	settings, settingsDiags := makeDatabaseSettingsFromModel(*data)
+	diags.Append(settingsDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Only the name and settings are sent, such that the connection to the sample database is left untouched.
+	updateResp, err := r.client.UpdateDatabaseWithResponse(ctx, sample.Id, metabase.UpdateDatabaseBody{
+		Name:     valueStringOrNull(data.Name),
+		Settings: settings,
+	})
+
+	diags.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update sample database")...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(updateModelFromDatabase(ctx, *updateResp.JSON200, data)...)
+	return diags
+}
+
 func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *DatabaseResourceModel
 
@@ -370,16 +987,40 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	if data.AdoptSample.ValueBool() {
+		resp.Diagnostics.Append(r.adoptSampleDatabase(ctx, data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
 	engineAndDetails, diags := makeEngineAndDetailsFromModel(ctx, *data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if data.ValidateConnection.ValueBool() {
+		resp.Diagnostics.Append(validateDatabaseConnection(ctx, r.client, *engineAndDetails)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	settings, diags := makeDatabaseSettingsFromModel(*data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createResp, err := r.client.CreateDatabaseWithResponse(ctx, metabase.CreateDatabaseBody{
-		Name:    data.Name.ValueString(),
-		Engine:  engineAndDetails.Engine,
-		Details: engineAndDetails.Details,
+		Name:     data.Name.ValueString(),
+		Engine:   engineAndDetails.Engine,
+		Details:  engineAndDetails.Details,
+		Settings: settings,
 	})
 
 	resp.Diagnostics.Append(checkMetabaseResponse(createResp, err, []int{200}, "create database")...)
@@ -392,7 +1033,20 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	// The state is saved before waiting, such that the database is not lost if the sync does not complete in time.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WaitForInitialSync.ValueBool() {
+		timeout := defaultInitialSyncTimeoutMinutes * time.Minute
+		if !data.InitialSyncTimeout.IsNull() {
+			timeout = time.Duration(data.InitialSyncTimeout.ValueInt64()) * time.Minute
+		}
+
+		resp.Diagnostics.Append(waitForDatabaseInitialSync(ctx, r.client, createResp.JSON200.Id, timeout)...)
+	}
 }
 
 func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -405,16 +1059,33 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	getResp, err := r.client.GetDatabaseWithResponse(ctx, int(data.Id.ValueInt64()))
 
-	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200, 404}, "get database")...)
+	status, diags := checkMetabaseResponseStatus(getResp, err, []int{200}, "get database")
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if getResp.StatusCode() == 404 {
-		resp.State.RemoveResource(ctx)
+	if status == metabaseResponseNotFound {
+		removeMissingResourceFromState(ctx, resp, "database", data.Id.ValueInt64(), "not found")
 		return
 	}
 
+	// Modifications made by Terraform are saved in the state, such that a different timestamp means the database has
+	// been modified outside of Terraform.
+	if data.DetectCredentialDrift.ValueBool() &&
+		!data.UpdatedAt.IsNull() &&
+		!data.UpdatedAt.Equal(stringValueOrNull(getResp.JSON200.UpdatedAt)) {
+		resp.Diagnostics.AddWarning(
+			"The database has been modified outside of Terraform.",
+			fmt.Sprintf("Database %d has been modified since it was last updated by Terraform. Its credentials may have been rotated, and they will be sent again during the next apply.", getResp.JSON200.Id),
+		)
+
+		resp.Diagnostics.Append(forgetDatabaseCredentials(ctx, data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(updateModelFromDatabase(ctx, *getResp.JSON200, data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -423,6 +1094,42 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// Makes the body used to update a database from the plan and the current state.
+// Database details are only sent if they have changed, in which case they are also returned. This avoids unnecessarily
+// passing credentials in API calls, and ensures a change of name cannot reset the connection details. Settings are
+// always sent when they are configured.
+func makeUpdateDatabaseBody(ctx context.Context, data DatabaseResourceModel, state DatabaseResourceModel) (*metabase.UpdateDatabaseBody, *DatabaseEngineAndDetails, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	settings, settingsDiags := makeDatabaseSettingsFromModel(data)
+	diags.Append(settingsDiags...)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	body := metabase.UpdateDatabaseBody{
+		Name:     valueStringOrNull(data.Name),
+		Settings: settings,
+	}
+
+	if state.BigQueryDetails.Equal(data.BigQueryDetails) &&
+		state.ClickHouseDetails.Equal(data.ClickHouseDetails) &&
+		state.CustomDetails.Equal(data.CustomDetails) {
+		return &body, nil, diags
+	}
+
+	engineAndDetails, engineDiags := makeEngineAndDetailsFromModel(ctx, data)
+	diags.Append(engineDiags...)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	body.Engine = &engineAndDetails.Engine
+	body.Details = &engineAndDetails.Details
+
+	return &body, engineAndDetails, diags
+}
+
 func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data *DatabaseResourceModel
 	var state *DatabaseResourceModel
@@ -436,24 +1143,28 @@ func (r *DatabaseResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	body := metabase.UpdateDatabaseBody{
-		Name: valueStringOrNull(data.Name),
+	body, engineAndDetails, diags := makeUpdateDatabaseBody(ctx, *data, *state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Only updating database details if they have changed. This avoids unnecessarily passing credentials in API calls.
-	if !state.BigQueryDetails.Equal(data.BigQueryDetails) ||
-		!state.CustomDetails.Equal(data.CustomDetails) {
-		engineAndDetails, diags := makeEngineAndDetailsFromModel(ctx, *data)
-		resp.Diagnostics.Append(diags...)
+	// A new service account key is always validated before being sent, such that a bad key does not replace a working
+	// one. As the state is not updated when the validation fails, the previous key is kept.
+	keyChanged, diags := isBigQueryServiceAccountKeyChanged(ctx, *data, *state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if engineAndDetails != nil && (data.ValidateConnection.ValueBool() || keyChanged) {
+		resp.Diagnostics.Append(validateDatabaseConnection(ctx, r.client, *engineAndDetails)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-
-		body.Engine = &engineAndDetails.Engine
-		body.Details = &engineAndDetails.Details
 	}
 
-	updateResp, err := r.client.UpdateDatabaseWithResponse(ctx, int(data.Id.ValueInt64()), body)
+	updateResp, err := r.client.UpdateDatabaseWithResponse(ctx, int(data.Id.ValueInt64()), *body)
 
 	resp.Diagnostics.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update database")...)
 	if resp.Diagnostics.HasError() {
@@ -476,6 +1187,11 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	if data.AdoptSample.ValueBool() {
+		resp.Diagnostics.AddWarning("The sample database is not deleted.", "The sample database has only been adopted by Terraform, and will be left intact.")
+		return
+	}
+
 	deleteResp, err := r.client.DeleteDatabaseWithResponse(ctx, int(data.Id.ValueInt64()))
 
 	resp.Diagnostics.Append(checkMetabaseResponse(deleteResp, err, []int{204}, "delete database")...)
@@ -486,4 +1202,25 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 
 func (r *DatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	importStatePassthroughIntegerId(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var id types.Int64
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResp, err := r.client.GetDatabaseWithResponse(ctx, int(id.ValueInt64()))
+
+	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200}, "get database")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The sample database is always adopted when imported, such that a later destroy does not delete it.
+	if getResp.JSON200.IsSample != nil && *getResp.JSON200.IsSample {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("adopt_sample"), true)...)
+	}
 }
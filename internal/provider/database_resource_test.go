@@ -2,11 +2,21 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"reflect"
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -129,3 +139,480 @@ func TestAccDatabaseResource(t *testing.T) {
 		},
 	})
 }
+
+func TestUpdateModelFromClickHouseDatabaseKeepsPassword(t *testing.T) {
+	ctx := context.Background()
+
+	redacted := "**MetabasePass**"
+	port := 8443
+	ssl := true
+	additionalOptions := "socket_timeout=300000"
+	var details metabase.DatabaseDetails
+	err := details.FromDatabaseDetailsClickHouse(metabase.DatabaseDetailsClickHouse{
+		Host:              "clickhouse.example.com",
+		Port:              &port,
+		Password:          &redacted,
+		Ssl:               &ssl,
+		AdditionalOptions: &additionalOptions,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := DatabaseResourceModel{
+		BigQueryDetails: types.ObjectNull(bigQueryDetailsObjectType.AttrTypes),
+		ClickHouseDetails: types.ObjectValueMust(clickHouseDetailsObjectType.AttrTypes, map[string]attr.Value{
+			"host":               types.StringValue("clickhouse.example.com"),
+			"port":               types.Int64Value(8443),
+			"user":               types.StringNull(),
+			"password":           types.StringValue("secret"),
+			"dbname":             types.StringNull(),
+			"ssl":                types.BoolValue(true),
+			"additional_options": types.StringValue("socket_timeout=300000"),
+		}),
+		CustomDetails: types.ObjectNull(customDetailsObjectType.AttrTypes),
+	}
+	expected := data.ClickHouseDetails
+
+	diags := updateModelFromDatabase(ctx, metabase.Database{
+		Id:      1,
+		Name:    "ClickHouse",
+		Engine:  metabase.Clickhouse,
+		Details: details,
+	}, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !data.ClickHouseDetails.Equal(expected) {
+		t.Fatalf("expected %s, got %s", expected.String(), data.ClickHouseDetails.String())
+	}
+	if !data.CustomDetails.IsNull() || !data.BigQueryDetails.IsNull() {
+		t.Fatal("expected other details to be null")
+	}
+}
+
+func TestRenamingDatabaseDoesNotSendDetails(t *testing.T) {
+	ctx := context.Background()
+
+	bigQueryDetails := types.ObjectValueMust(bigQueryDetailsObjectType.AttrTypes, map[string]attr.Value{
+		"service_account_key":      types.StringValue(`{"type":"service_account"}`),
+		"project_id":               types.StringValue("gcp-project"),
+		"dataset_filters_type":     types.StringNull(),
+		"dataset_filters_patterns": types.StringNull(),
+	})
+	state := DatabaseResourceModel{
+		Id:                types.Int64Value(1),
+		Name:              types.StringValue("Old name"),
+		BigQueryDetails:   bigQueryDetails,
+		ClickHouseDetails: types.ObjectNull(clickHouseDetailsObjectType.AttrTypes),
+		CustomDetails:     types.ObjectNull(customDetailsObjectType.AttrTypes),
+	}
+	plan := state
+	plan.Name = types.StringValue("New name")
+
+	body, engineAndDetails, diags := makeUpdateDatabaseBody(ctx, plan, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if engineAndDetails != nil {
+		t.Fatal("expected details not to be sent when only the name changes")
+	}
+
+	var receivedBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = json.Unmarshal(bodyBytes, &receivedBody)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":1,"name":"New name","engine":"bigquery-cloud-sdk","details":{"service-account-json":"**MetabasePass**","project-id":"gcp-project"}}`))
+	}))
+	defer server.Close()
+
+	client, err := metabase.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updateResp, err := client.UpdateDatabaseWithResponse(ctx, 1, *body)
+	diags = checkMetabaseResponse(updateResp, err, []int{200}, "update database")
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expectedBody := map[string]interface{}{"name": "New name"}
+	if !reflect.DeepEqual(receivedBody, expectedBody) {
+		t.Fatalf("expected body %v, got %v", expectedBody, receivedBody)
+	}
+
+	diags = updateModelFromDatabase(ctx, *updateResp.JSON200, &plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !plan.BigQueryDetails.Equal(bigQueryDetails) {
+		t.Fatalf("expected the service account key to be kept, got %s", plan.BigQueryDetails.String())
+	}
+}
+
+func TestForgetDatabaseCredentialsRemovesRedactedAttributes(t *testing.T) {
+	ctx := context.Background()
+
+	var details metabase.DatabaseDetails
+	err := details.FromDatabaseDetailsCustom(map[string]interface{}{
+		"host":     "postgres.example.com",
+		"password": "**MetabasePass**",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := DatabaseResourceModel{
+		BigQueryDetails:   types.ObjectNull(bigQueryDetailsObjectType.AttrTypes),
+		ClickHouseDetails: types.ObjectNull(clickHouseDetailsObjectType.AttrTypes),
+		CustomDetails: types.ObjectValueMust(customDetailsObjectType.AttrTypes, map[string]attr.Value{
+			"engine":              types.StringValue("postgres"),
+			"details_json":        types.StringValue(`{"host":"postgres.example.com","password":"secret"}`),
+			"redacted_attributes": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("password")}),
+		}),
+	}
+
+	diags := forgetDatabaseCredentials(ctx, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	diags = updateModelFromDatabase(ctx, metabase.Database{
+		Id:      1,
+		Name:    "Postgres",
+		Engine:  "postgres",
+		Details: details,
+	}, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	var cd CustomDetails
+	diags = data.CustomDetails.As(ctx, &cd, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// The password is no longer part of the state, such that the configured one will be sent during the next apply.
+	if cd.DetailsJson.ValueString() != `{"host":"postgres.example.com"}` {
+		t.Errorf("unexpected details: %s", cd.DetailsJson.ValueString())
+	}
+}
+
+func TestValidateBigQueryDatasetFilters(t *testing.T) {
+	testCases := []struct {
+		filtersType types.String
+		patterns    types.String
+		valid       bool
+	}{
+		{types.StringValue("all"), types.StringNull(), true},
+		{types.StringValue("all"), types.StringValue("analytics_*"), false},
+		{types.StringNull(), types.StringNull(), true},
+		{types.StringNull(), types.StringValue("analytics_*"), false},
+		{types.StringValue("inclusion"), types.StringValue("analytics_*"), true},
+		{types.StringValue("exclusion"), types.StringValue("tmp_*"), true},
+		{types.StringUnknown(), types.StringValue("analytics_*"), true},
+	}
+
+	for _, tc := range testCases {
+		diags := validateBigQueryDatasetFilters(BigQueryDetails{
+			DatasetFiltersType:     tc.filtersType,
+			DatasetFiltersPatterns: tc.patterns,
+		})
+		if diags.HasError() == tc.valid {
+			t.Errorf("unexpected validation result for type %s and patterns %s: %v", tc.filtersType, tc.patterns, diags)
+		}
+	}
+}
+
+func TestBigQueryDatasetFiltersPatternsIgnoredWithAll(t *testing.T) {
+	ctx := context.Background()
+
+	for _, filtersType := range []metabase.DatabaseDetailsBigQueryDatasetFiltersType{metabase.All, metabase.Inclusion, metabase.Exclusion} {
+		filtersType := filtersType
+		patterns := "analytics_*"
+		var details metabase.DatabaseDetails
+		err := details.FromDatabaseDetailsBigQuery(metabase.DatabaseDetailsBigQuery{
+			ServiceAccountJson:     "**MetabasePass**",
+			DatasetFiltersType:     &filtersType,
+			DatasetFiltersPatterns: &patterns,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data := DatabaseResourceModel{
+			BigQueryDetails:   types.ObjectNull(bigQueryDetailsObjectType.AttrTypes),
+			ClickHouseDetails: types.ObjectNull(clickHouseDetailsObjectType.AttrTypes),
+			CustomDetails:     types.ObjectNull(customDetailsObjectType.AttrTypes),
+		}
+
+		bqd, diags := makeBigQueryDetailsFromDatabase(ctx, metabase.Database{Details: details}, &data)
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		patternsValue := bqd.Attributes()["dataset_filters_patterns"]
+		if filtersType == metabase.All && !patternsValue.IsNull() {
+			t.Errorf("expected patterns to be ignored with type all, got %s", patternsValue)
+		}
+		if filtersType != metabase.All && !patternsValue.Equal(types.StringValue(patterns)) {
+			t.Errorf("expected patterns to be kept with type %s, got %s", filtersType, patternsValue)
+		}
+	}
+}
+
+func TestIsBigQueryServiceAccountKeyChanged(t *testing.T) {
+	ctx := context.Background()
+
+	makeModel := func(key string) DatabaseResourceModel {
+		return DatabaseResourceModel{
+			BigQueryDetails: types.ObjectValueMust(bigQueryDetailsObjectType.AttrTypes, map[string]attr.Value{
+				"service_account_key":      types.StringValue(key),
+				"project_id":               types.StringNull(),
+				"dataset_filters_type":     types.StringNull(),
+				"dataset_filters_patterns": types.StringNull(),
+			}),
+		}
+	}
+
+	changed, diags := isBigQueryServiceAccountKeyChanged(ctx, makeModel("old-key"), makeModel("old-key"))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if changed {
+		t.Errorf("expected the key not to be considered changed")
+	}
+
+	changed, diags = isBigQueryServiceAccountKeyChanged(ctx, makeModel("new-key"), makeModel("old-key"))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !changed {
+		t.Errorf("expected the key to be considered changed")
+	}
+
+	changed, diags = isBigQueryServiceAccountKeyChanged(ctx, makeModel("new-key"), DatabaseResourceModel{
+		BigQueryDetails: types.ObjectNull(bigQueryDetailsObjectType.AttrTypes),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if changed {
+		t.Errorf("expected switching to BigQuery not to be considered a key change")
+	}
+}
+
+func TestValidateDatabaseDetailsAttributes(t *testing.T) {
+	customDetails := types.ObjectValueMust(customDetailsObjectType.AttrTypes, map[string]attr.Value{
+		"engine":              types.StringValue("postgres"),
+		"details_json":        types.StringValue(`{}`),
+		"redacted_attributes": types.SetNull(types.StringType),
+	})
+	noCustomDetails := types.ObjectNull(customDetailsObjectType.AttrTypes)
+
+	testCases := []struct {
+		adoptSample   types.Bool
+		customDetails types.Object
+		valid         bool
+	}{
+		{types.BoolNull(), customDetails, true},
+		{types.BoolNull(), noCustomDetails, false},
+		{types.BoolValue(false), noCustomDetails, false},
+		{types.BoolValue(true), noCustomDetails, true},
+		{types.BoolValue(true), customDetails, false},
+		{types.BoolUnknown(), noCustomDetails, true},
+	}
+
+	for _, tc := range testCases {
+		diags := validateDatabaseDetailsAttributes(DatabaseResourceModel{
+			AdoptSample:       tc.adoptSample,
+			BigQueryDetails:   types.ObjectNull(bigQueryDetailsObjectType.AttrTypes),
+			ClickHouseDetails: types.ObjectNull(clickHouseDetailsObjectType.AttrTypes),
+			CustomDetails:     tc.customDetails,
+		})
+		if diags.HasError() == tc.valid {
+			t.Errorf("unexpected validation result for adopt_sample %s and custom details %s: %v", tc.adoptSample, tc.customDetails, diags)
+		}
+	}
+}
+
+func TestAdoptSampleDatabaseOnlySetsName(t *testing.T) {
+	ctx := context.Background()
+
+	var receivedBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodGet && r.URL.Path == "/database" {
+			w.Write([]byte(`{"data":[{"id":2,"name":"Postgres","engine":"postgres","details":{}},{"id":1,"name":"Sample Database","engine":"h2","is_sample":true,"details":{}}],"total":2}`))
+			return
+		}
+
+		if r.Method != http.MethodPut || r.URL.Path != "/database/1" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = json.Unmarshal(bodyBytes, &receivedBody)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		w.Write([]byte(`{"id":1,"name":"🧪 Examples","engine":"h2","is_sample":true,"details":{"db":"zip:/app/metabase.jar!/sample-database.db"}}`))
+	}))
+	defer server.Close()
+
+	client, err := metabase.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &DatabaseResource{MetabaseBaseResource{name: "database", client: client}}
+	data := DatabaseResourceModel{
+		Name:              types.StringValue("🧪 Examples"),
+		AdoptSample:       types.BoolValue(true),
+		BigQueryDetails:   types.ObjectNull(bigQueryDetailsObjectType.AttrTypes),
+		ClickHouseDetails: types.ObjectNull(clickHouseDetailsObjectType.AttrTypes),
+		CustomDetails:     types.ObjectNull(customDetailsObjectType.AttrTypes),
+	}
+
+	diags := r.adoptSampleDatabase(ctx, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expectedBody := map[string]interface{}{"name": "🧪 Examples"}
+	if !reflect.DeepEqual(receivedBody, expectedBody) {
+		t.Errorf("expected body %v, got %v", expectedBody, receivedBody)
+	}
+	if data.Id.ValueInt64() != 1 {
+		t.Errorf("expected the sample database to be adopted, got ID %d", data.Id.ValueInt64())
+	}
+	if !data.CustomDetails.IsNull() {
+		t.Errorf("expected the details of the sample database not to be exposed, got %s", data.CustomDetails.String())
+	}
+}
+
+func TestMakeSettingsJsonFromDatabaseIgnoresServerSettings(t *testing.T) {
+	definition := `{"database-enable-actions": true}`
+	data := DatabaseResourceModel{
+		SettingsJson: types.StringValue(definition),
+	}
+
+	settings := metabase.DatabaseSettings{
+		"database-enable-actions": true,
+		"persist-models-enabled":  false,
+	}
+	settingsJson, diags := makeSettingsJsonFromDatabase(metabase.Database{Settings: &settings}, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if settingsJson.ValueString() != definition {
+		t.Errorf("Expected settings %s to be unchanged, got %s.", definition, settingsJson.ValueString())
+	}
+
+	settings["database-enable-actions"] = false
+	settingsJson, diags = makeSettingsJsonFromDatabase(metabase.Database{Settings: &settings}, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := `{"database-enable-actions":false}`
+	if settingsJson.ValueString() != expected {
+		t.Errorf("Expected settings %s, got %s.", expected, settingsJson.ValueString())
+	}
+
+	// Settings are not managed when the attribute is not set.
+	settingsJson, diags = makeSettingsJsonFromDatabase(metabase.Database{Settings: &settings}, DatabaseResourceModel{SettingsJson: types.StringNull()})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !settingsJson.IsNull() {
+		t.Errorf("Expected settings to be null, got %s.", settingsJson.String())
+	}
+}
+
+func TestMakeDatabaseSettingsFromModelRequiresObject(t *testing.T) {
+	_, diags := makeDatabaseSettingsFromModel(DatabaseResourceModel{SettingsJson: types.StringValue(`["database-enable-actions"]`)})
+	if !diags.HasError() {
+		t.Errorf("Expected an error when the settings are not a JSON object.")
+	}
+
+	_, diags = makeDatabaseSettingsFromModel(DatabaseResourceModel{SettingsJson: types.StringValue(`null`)})
+	if !diags.HasError() {
+		t.Errorf("Expected an error when the settings are null.")
+	}
+}
+
+func TestWaitForDatabaseInitialSync(t *testing.T) {
+	defer func(interval time.Duration) { initialSyncPollInterval = interval }(initialSyncPollInterval)
+	initialSyncPollInterval = 0
+
+	testCases := []struct {
+		name             string
+		statuses         []string
+		timeout          time.Duration
+		expectedRequests int
+		expectedError    bool
+		expectedWarnings int
+	}{
+		{"complete", []string{`"incomplete"`, `"complete"`}, time.Minute, 2, false, 0},
+		{"aborted", []string{`"incomplete"`, `"aborted"`}, time.Minute, 2, true, 0},
+		{"missing status", []string{``}, time.Minute, 1, false, 1},
+		{"timeout", []string{`"incomplete"`}, 0, 1, true, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				status := tc.statuses[min(requests, len(tc.statuses)-1)]
+				requests++
+
+				syncStatus := ""
+				if status != "" {
+					syncStatus = fmt.Sprintf(`,"initial_sync_status":%s`, status)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(fmt.Sprintf(`{"id":1,"name":"Warehouse","engine":"postgres","details":{}%s}`, syncStatus)))
+			}))
+			defer server.Close()
+
+			client, err := metabase.NewClientWithResponses(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			diags := waitForDatabaseInitialSync(context.Background(), client, 1, tc.timeout)
+
+			if diags.HasError() != tc.expectedError {
+				t.Errorf("unexpected diagnostics: %v", diags)
+			}
+			if diags.WarningsCount() != tc.expectedWarnings {
+				t.Errorf("expected %d warnings, got: %v", tc.expectedWarnings, diags)
+			}
+			if requests != tc.expectedRequests {
+				t.Errorf("expected %d requests, got %d", tc.expectedRequests, requests)
+			}
+		})
+	}
+}
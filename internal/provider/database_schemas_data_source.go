@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DatabaseSchemasDataSource{}
+
+// Creates a new database schemas data source.
+func NewDatabaseSchemasDataSource() datasource.DataSource {
+	return &DatabaseSchemasDataSource{}
+}
+
+// A data source listing the schemas in a database, as synced by Metabase.
+type DatabaseSchemasDataSource struct {
+	// The Metabase API client.
+	client *metabase.ClientWithResponses
+}
+
+// The Terraform model for the schemas in a database.
+type DatabaseSchemasDataSourceModel struct {
+	DatabaseId types.Int64 `tfsdk:"database_id"` // The ID of the database.
+	Schemas    types.List  `tfsdk:"schemas"`     // The names of the schemas in the database.
+}
+
+func (d *DatabaseSchemasDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_schemas"
+}
+
+func (d *DatabaseSchemasDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The list of schemas in a Metabase database, as found during the last sync of the database.
+
+This can be used to check schema names before referencing them, e.g. in the schema filters of a database or in the permissions graph.`,
+
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the database.",
+				Required:            true,
+			},
+			"schemas": schema.ListAttribute{
+				MarkdownDescription: "The names of the schemas in the database, in alphabetical order. Schemas excluded by the database filters are not listed.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DatabaseSchemasDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*metabase.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected client type when configuring Metabase data source.",
+			fmt.Sprintf("Expected *metabase.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Updates the given `DatabaseSchemasDataSourceModel` from the list of schemas returned by the Metabase API.
+func updateModelFromDatabaseSchemas(schemas []string, data *DatabaseSchemasDataSourceModel) diag.Diagnostics {
+	sortedSchemas := make([]string, len(schemas))
+	copy(sortedSchemas, schemas)
+	sort.Strings(sortedSchemas)
+
+	schemasList := make([]attr.Value, 0, len(sortedSchemas))
+	for _, s := range sortedSchemas {
+		schemasList = append(schemasList, types.StringValue(s))
+	}
+
+	schemasValue, diags := types.ListValue(types.StringType, schemasList)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Schemas = schemasValue
+
+	return diags
+}
+
+func (d *DatabaseSchemasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DatabaseSchemasDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listResp, err := d.client.ListDatabaseSchemasWithResponse(ctx, int(data.DatabaseId.ValueInt64()))
+
+	resp.Diagnostics.Append(checkMetabaseResponse(listResp, err, []int{200}, "list database schemas")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromDatabaseSchemas(*listResp.JSON200, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUpdateModelFromDatabaseSchemas(t *testing.T) {
+	var data DatabaseSchemasDataSourceModel
+	diags := updateModelFromDatabaseSchemas([]string{"reporting", "public", "analytics"}, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	expected := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("analytics"),
+		types.StringValue("public"),
+		types.StringValue("reporting"),
+	})
+	if !data.Schemas.Equal(expected) {
+		t.Errorf("Expected sorted schemas %v, got %v.", expected, data.Schemas)
+	}
+
+	diags = updateModelFromDatabaseSchemas([]string{}, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if data.Schemas.IsNull() || len(data.Schemas.Elements()) != 0 {
+		t.Errorf("Expected an empty list of schemas, got %v.", data.Schemas)
+	}
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSourceWithConfigValidators = &FieldDataSource{}
+
+// Creates a new field data source.
+func NewFieldDataSource() datasource.DataSource {
+	return &FieldDataSource{}
+}
+
+// A data source obtaining details about a single field (column) in a table.
+// This is a shortcut to the `fields` attribute of the table data source, when only the ID of a single field is needed,
+// e.g. to define dashboard filters.
+type FieldDataSource struct {
+	// The Metabase API client.
+	client *metabase.ClientWithResponses
+}
+
+// The Terraform model for a field.
+type FieldDataSourceModel struct {
+	Id           types.Int64  `tfsdk:"id"`            // The ID of the field.
+	TableId      types.Int64  `tfsdk:"table_id"`      // The ID of the parent table.
+	DbId         types.Int64  `tfsdk:"db_id"`         // The ID of the database of the parent table, used to search for the table.
+	TableName    types.String `tfsdk:"table_name"`    // The name of the parent table, used to search for the table.
+	TableSchema  types.String `tfsdk:"table_schema"`  // The schema of the parent table, used to search for the table.
+	Name         types.String `tfsdk:"name"`          // The name of the field (column) in the table.
+	DisplayName  types.String `tfsdk:"display_name"`  // The name displayed in the interface for the field.
+	SemanticType types.String `tfsdk:"semantic_type"` // The semantic type of the field.
+	BaseType     types.String `tfsdk:"base_type"`     // The type of the field in the database.
+}
+
+func (d *FieldDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_field"
+}
+
+func (d *FieldDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A Metabase field (column), part of a table.
+
+This data source can be useful to find the Metabase ID of a single field, e.g. to define dashboard filters, without going through the fields of the entire table. The parent table can either be referenced using its ID, or searched using its database, name, and schema.`,
+
+		Attributes: map[string]schema.Attribute{
+			"table_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the parent table. If specified, the `db_id`, `table_name`, and `table_schema` should not be specified.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"db_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the database of the parent table, used to search for the table when its ID is not known.",
+				Optional:            true,
+			},
+			"table_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the parent table, used to search for the table when its ID is not known.",
+				Optional:            true,
+			},
+			"table_schema": schema.StringAttribute{
+				MarkdownDescription: "The database schema of the parent table, used to search for the table when its ID is not known. For BigQuery, this is the dataset name.",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the field (column) in the table.",
+				Required:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the field.",
+				Computed:            true,
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "The name displayed in the interface for the field.",
+				Computed:            true,
+			},
+			"semantic_type": schema.StringAttribute{
+				MarkdownDescription: "The semantic type of the field, e.g. `type/PK`.",
+				Computed:            true,
+			},
+			"base_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the field in the database, as understood by Metabase, e.g. `type/Text`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// The attributes which can be used to search for the parent table when its ID is not specified.
+var fieldTableSearchAttributes = []string{"db_id", "table_name", "table_schema"}
+
+func (d *FieldDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	// The parent table is either referenced using its ID, or searched using the other table attributes.
+	lookupAttributes := []path.Expression{path.MatchRoot("table_id")}
+	validators := []datasource.ConfigValidator{}
+	for _, a := range fieldTableSearchAttributes {
+		lookupAttributes = append(lookupAttributes, path.MatchRoot(a))
+		validators = append(validators, datasourcevalidator.Conflicting(path.MatchRoot("table_id"), path.MatchRoot(a)))
+	}
+
+	return append(validators, datasourcevalidator.AtLeastOneOf(lookupAttributes...))
+}
+
+func (d *FieldDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*metabase.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected client type when configuring Metabase data source.",
+			fmt.Sprintf("Expected *metabase.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Makes the filter used to find the parent table of the field.
+func makeFieldTableFilter(data FieldDataSourceModel) tableFilter {
+	return tableFilter{
+		Id:         data.TableId,
+		DbId:       data.DbId,
+		Name:       data.TableName,
+		EntityType: types.StringNull(),
+		Schema:     data.TableSchema,
+	}
+}
+
+// Updates the given `FieldDataSourceModel` from the `Field` returned by the Metabase API.
+func updateModelFromField(f metabase.Field, data *FieldDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.Int64Value(int64(f.Id))
+	data.TableId = types.Int64Value(int64(f.TableId))
+	data.Name = types.StringValue(f.Name)
+	data.DisplayName = types.StringValue(f.DisplayName)
+	data.SemanticType = stringValueOrNull(f.SemanticType)
+	data.BaseType = stringValueOrNull(f.BaseType)
+
+	return diags
+}
+
+func (d *FieldDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FieldDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	table, diags := findTableInMetabase(ctx, d.client, makeFieldTableFilter(data))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	field, diags := findFieldByName(table.Fields, data.Name.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromField(*field, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMakeFieldTableFilter(t *testing.T) {
+	schema := "public"
+	tables := []metabase.Table{
+		{Id: 1, DbId: 2, Name: "orders", Schema: &schema},
+		{Id: 3, DbId: 2, Name: "orders"},
+		{Id: 4, DbId: 5, Name: "orders", Schema: &schema},
+	}
+
+	testCases := []struct {
+		data       FieldDataSourceModel
+		expectedId int
+	}{
+		{FieldDataSourceModel{TableId: types.Int64Value(3)}, 3},
+		{FieldDataSourceModel{TableId: types.Int64Null(), DbId: types.Int64Value(5), TableName: types.StringValue("orders")}, 4},
+		{FieldDataSourceModel{TableId: types.Int64Null(), DbId: types.Int64Value(2), TableName: types.StringValue("orders"), TableSchema: types.StringValue("")}, 3},
+	}
+
+	for _, tc := range testCases {
+		predicate, diags := makeSearchPredicate(makeFieldTableFilter(tc.data))
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		table, diags := findTable(tables, *predicate)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+		if table.Id != tc.expectedId {
+			t.Errorf("Expected table %d, got %d.", tc.expectedId, table.Id)
+		}
+	}
+
+	_, diags := makeSearchPredicate(makeFieldTableFilter(FieldDataSourceModel{
+		TableId:   types.Int64Value(3),
+		TableName: types.StringValue("orders"),
+	}))
+	if !diags.HasError() {
+		t.Errorf("Expected an error when both the table ID and the table name are set.")
+	}
+}
+
+func TestUpdateModelFromField(t *testing.T) {
+	var field metabase.Field
+	err := json.Unmarshal([]byte(`{
+		"id": 12,
+		"table_id": 3,
+		"name": "user_id",
+		"display_name": "User ID",
+		"semantic_type": "type/FK",
+		"base_type": "type/Integer"
+	}`), &field)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := FieldDataSourceModel{
+		TableId:   types.Int64Null(),
+		TableName: types.StringValue("orders"),
+	}
+	diags := updateModelFromField(field, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if data.Id.ValueInt64() != 12 || data.TableId.ValueInt64() != 3 {
+		t.Errorf("Expected field 12 in table 3, got field %s in table %s.", data.Id.String(), data.TableId.String())
+	}
+	if data.SemanticType.ValueString() != "type/FK" || data.BaseType.ValueString() != "type/Integer" {
+		t.Errorf("Unexpected types for the field: %s, %s.", data.SemanticType.String(), data.BaseType.String())
+	}
+	if data.TableName.ValueString() != "orders" {
+		t.Errorf("Expected the table filter to be kept, got %s.", data.TableName.String())
+	}
+}
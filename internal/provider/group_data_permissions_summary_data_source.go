@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GroupDataPermissionsSummaryDataSource{}
+
+// Creates a new group data permissions summary data source.
+func NewGroupDataPermissionsSummaryDataSource() datasource.DataSource {
+	return &GroupDataPermissionsSummaryDataSource{}
+}
+
+// A data source summarizing the database permissions of a single group.
+// This reads the entire permissions graph but only exposes the edges for the requested group, which is mostly useful
+// for auditing.
+type GroupDataPermissionsSummaryDataSource struct {
+	// The Metabase API client.
+	client *metabase.ClientWithResponses
+}
+
+// The Terraform model for the summary.
+type GroupDataPermissionsSummaryDataSourceModel struct {
+	GroupId     types.Int64 `tfsdk:"group_id"`    // The ID of the permissions group.
+	Revision    types.Int64 `tfsdk:"revision"`    // The revision of the permissions graph from which the summary was built.
+	Permissions types.List  `tfsdk:"permissions"` // The list of permissions (edges) for the group, sorted by database ID.
+}
+
+// The schema for an `AccessPermissions` object, as a read-only data source attribute.
+var accessPermissionDataSourceAttributes = map[string]schema.Attribute{
+	"schemas": schema.StringAttribute{
+		MarkdownDescription: "The permission to access data through the Metabase interface.",
+		Computed:            true,
+	},
+	"granular_schemas": schema.MapAttribute{
+		MarkdownDescription: "The permission for each schema, when permissions differ between schemas.",
+		ElementType:         types.StringType,
+		Computed:            true,
+	},
+}
+
+func (d *GroupDataPermissionsSummaryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_data_permissions_summary"
+}
+
+func (d *GroupDataPermissionsSummaryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A summary of the database permissions of a single permissions group.
+
+This data source reads the permissions graph and only returns the edges for the given group. It does not modify any permission and can be used to audit the access of a group across all databases, e.g. to check that permissions managed by Terraform match expectations.`,
+
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the permissions group.",
+				Required:            true,
+			},
+			"revision": schema.Int64Attribute{
+				MarkdownDescription: "The revision number of the permissions graph from which the summary was built.",
+				Computed:            true,
+			},
+			"permissions": schema.ListNestedAttribute{
+				MarkdownDescription: "The permissions of the group for each database, sorted by database ID. Databases for which the group has no permission are not listed.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the group to which the permission applies.",
+							Computed:            true,
+						},
+						"database": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the database to which the permission applies.",
+							Computed:            true,
+						},
+						"view_data": schema.StringAttribute{
+							MarkdownDescription: "The permission definition for data access.",
+							Computed:            true,
+						},
+						"create_queries": schema.StringAttribute{
+							MarkdownDescription: "The permission definition for creating queries.",
+							Computed:            true,
+						},
+						"download": schema.SingleNestedAttribute{
+							MarkdownDescription: "The permission definition for downloading data.",
+							Computed:            true,
+							Attributes:          accessPermissionDataSourceAttributes,
+						},
+						"data_model": schema.SingleNestedAttribute{
+							MarkdownDescription: "The permission definition for accessing the data model.",
+							Computed:            true,
+							Attributes:          accessPermissionDataSourceAttributes,
+						},
+						"details": schema.StringAttribute{
+							MarkdownDescription: "The permission definition for accessing details.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GroupDataPermissionsSummaryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*metabase.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected client type when configuring Metabase data source.",
+			fmt.Sprintf("Expected *metabase.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Updates the given `GroupDataPermissionsSummaryDataSourceModel` from the `PermissionsGraph` returned by the Metabase
+// API, keeping only the edges for the group in the model.
+func updateModelFromPermissionsGraphForGroup(ctx context.Context, g metabase.PermissionsGraph, data *GroupDataPermissionsSummaryDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Revision = types.Int64Value(int64(g.Revision))
+
+	groupId := strconv.FormatInt(data.GroupId.ValueInt64(), 10)
+	dbPermissionsMap := g.Groups[groupId]
+
+	dbIds := make([]int, 0, len(dbPermissionsMap))
+	for dbId := range dbPermissionsMap {
+		// Ignore the Metabase Analytics and Saved Questions databases, consistently with the permissions graph resource.
+		if dbId == metabase.MetabaseAnalyticsDatabaseId || dbId == metabase.SavedQuestionsDatabaseId {
+			continue
+		}
+
+		dbIdInt, err := strconv.Atoi(dbId)
+		if err != nil {
+			diags.AddError("Could not convert the database ID to an integer.", err.Error())
+			return diags
+		}
+
+		dbIds = append(dbIds, dbIdInt)
+	}
+	sort.Ints(dbIds)
+
+	permissionsList := make([]attr.Value, 0, len(dbIds))
+	for _, dbIdInt := range dbIds {
+		dbId := strconv.Itoa(dbIdInt)
+
+		permissionsObject, objDiags := makePermissionsObjectFromDatabasePermissions(ctx, groupId, dbId, dbPermissionsMap[dbId])
+		diags.Append(objDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		permissionsList = append(permissionsList, *permissionsObject)
+	}
+
+	permissionsValue, listDiags := types.ListValue(databasePermissionsObjectType, permissionsList)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Permissions = permissionsValue
+
+	return diags
+}
+
+func (d *GroupDataPermissionsSummaryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupDataPermissionsSummaryDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResp, err := d.client.GetPermissionsGraphWithResponse(ctx)
+
+	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200}, "get permissions graph")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromPermissionsGraphForGroup(ctx, *getResp.JSON200, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
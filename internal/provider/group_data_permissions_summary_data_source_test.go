@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUpdateModelFromPermissionsGraphForGroup(t *testing.T) {
+	ctx := context.Background()
+	graph := metabase.PermissionsGraph{
+		Revision: 4,
+		Groups: map[string]metabase.PermissionsGraphDatabasePermissionsMap{
+			"1": {
+				"1": {ViewData: metabase.Unrestricted},
+			},
+			"3": {
+				"12":                              {ViewData: metabase.Unrestricted},
+				"2":                               {ViewData: metabase.Unrestricted},
+				metabase.SavedQuestionsDatabaseId: {ViewData: metabase.Unrestricted},
+			},
+		},
+	}
+
+	data := GroupDataPermissionsSummaryDataSourceModel{GroupId: types.Int64Value(3)}
+	diags := updateModelFromPermissionsGraphForGroup(ctx, graph, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if data.Revision.ValueInt64() != 4 {
+		t.Errorf("Expected revision 4, got %s.", data.Revision.String())
+	}
+
+	var permissions []DatabasePermissions
+	diags = data.Permissions.ElementsAs(ctx, &permissions, false)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if len(permissions) != 2 {
+		t.Fatalf("Expected the permissions for 2 databases, got %s.", data.Permissions.String())
+	}
+	if permissions[0].Database.ValueInt64() != 2 || permissions[1].Database.ValueInt64() != 12 {
+		t.Errorf("Expected the permissions to be sorted by database ID, got %s.", data.Permissions.String())
+	}
+	for _, p := range permissions {
+		if p.Group.ValueInt64() != 3 {
+			t.Errorf("Expected only the permissions of group 3, got group %s.", p.Group.String())
+		}
+	}
+}
+
+func TestUpdateModelFromPermissionsGraphForMissingGroup(t *testing.T) {
+	graph := metabase.PermissionsGraph{
+		Revision: 1,
+		Groups: map[string]metabase.PermissionsGraphDatabasePermissionsMap{
+			"1": {
+				"1": {ViewData: metabase.Unrestricted},
+			},
+		},
+	}
+
+	data := GroupDataPermissionsSummaryDataSourceModel{GroupId: types.Int64Value(5)}
+	diags := updateModelFromPermissionsGraphForGroup(context.Background(), graph, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if data.Permissions.IsNull() || len(data.Permissions.Elements()) != 0 {
+		t.Errorf("Expected an empty list of permissions, got %s.", data.Permissions.String())
+	}
+}
@@ -0,0 +1,357 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithImportState = &GroupMembersResource{}
+
+// Creates a new group members resource.
+func NewGroupMembersResource() resource.Resource {
+	return &GroupMembersResource{
+		MetabaseBaseResource{name: "group_members"},
+	}
+}
+
+// A resource handling the entire list of members of a permissions group.
+type GroupMembersResource struct {
+	MetabaseBaseResource
+}
+
+// The Terraform model for the members of a permissions group.
+type GroupMembersResourceModel struct {
+	GroupId types.Int64 `tfsdk:"group_id"` // The ID of the permissions group.
+	UserIds types.Set   `tfsdk:"user_ids"` // The IDs of the users in the group.
+}
+
+func (r *GroupMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The members of a Metabase permissions group.
+
+This resource is authoritative for the group: users which are not listed are removed from the group, and listed users are added to it. This is convenient to sync the membership from an external source of truth. Destroying the resource removes the listed users from the group, but leaves other users untouched.
+
+The membership of the All Users group cannot be modified, as it contains every user by definition.`,
+
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the permissions group.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"user_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the users in the group.",
+				ElementType:         types.Int64Type,
+				Required:            true,
+			},
+		},
+	}
+}
+
+// The changes required to reconcile the members of a group with the expected list of users.
+type groupMembersChanges struct {
+	UsersToAdd          []int // The IDs of the users which should be added to the group.
+	MembershipsToRemove []int // The IDs of the memberships which should be deleted.
+}
+
+// Computes the changes required such that the group only contains the given users.
+// Users are added in increasing ID order, which keeps the calls to the API deterministic.
+func makeGroupMembersChanges(members []metabase.PermissionsGroupMember, userIds []int) (*groupMembersChanges, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	expectedUsers := make(map[int]bool, len(userIds))
+	for _, id := range userIds {
+		expectedUsers[id] = true
+	}
+
+	changes := groupMembersChanges{
+		UsersToAdd:          []int{},
+		MembershipsToRemove: []int{},
+	}
+
+	existingUsers := make(map[int]bool, len(members))
+	for _, m := range members {
+		existingUsers[m.UserId] = true
+
+		if expectedUsers[m.UserId] {
+			continue
+		}
+
+		if m.MembershipId == nil {
+			diags.AddError("Unable to remove user from group.", fmt.Sprintf("The membership ID of user %d was not returned by the Metabase API.", m.UserId))
+			return nil, diags
+		}
+
+		changes.MembershipsToRemove = append(changes.MembershipsToRemove, *m.MembershipId)
+	}
+
+	for id := range expectedUsers {
+		if !existingUsers[id] {
+			changes.UsersToAdd = append(changes.UsersToAdd, id)
+		}
+	}
+	sort.Ints(changes.UsersToAdd)
+	sort.Ints(changes.MembershipsToRemove)
+
+	return &changes, diags
+}
+
+// Returns the list of user IDs in the given Terraform set.
+func makeUserIdsFromSet(ctx context.Context, set types.Set) ([]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var userIds []int64
+	diags.Append(set.ElementsAs(ctx, &userIds, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	ids := make([]int, 0, len(userIds))
+	for _, id := range userIds {
+		ids = append(ids, int(id))
+	}
+
+	return ids, diags
+}
+
+// Updates the given `GroupMembersResourceModel` from the members of the `PermissionsGroup` returned by the Metabase API.
+func updateModelFromGroupMembers(ctx context.Context, pg metabase.PermissionsGroup, data *GroupMembersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.GroupId = types.Int64Value(int64(pg.Id))
+
+	userIds := []int64{}
+	if pg.Members != nil {
+		for _, m := range *pg.Members {
+			userIds = append(userIds, int64(m.UserId))
+		}
+	}
+
+	userIdsValue, setDiags := types.SetValueFrom(ctx, types.Int64Type, userIds)
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	data.UserIds = userIdsValue
+
+	return diags
+}
+
+// Fetches the permissions group, along with its members. `nil` is returned if the group does not exist.
+func (r *GroupMembersResource) getGroup(ctx context.Context, groupId int) (*metabase.PermissionsGroup, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	getResp, err := r.client.GetPermissionsGroupWithResponse(ctx, groupId)
+
+	status, statusDiags := checkMetabaseResponseStatus(getResp, err, []int{200, 204}, "get permissions group")
+	diags.Append(statusDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// The Metabase API can also return "no content" when the group has been deleted.
+	if status == metabaseResponseNotFound || getResp.StatusCode() == 204 {
+		return nil, diags
+	}
+
+	return getResp.JSON200, diags
+}
+
+// Adds and removes users from the group, such that it only contains the given users.
+func (r *GroupMembersResource) reconcileGroupMembers(ctx context.Context, groupId int, userIds []int) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	group, getDiags := r.getGroup(ctx, groupId)
+	diags.Append(getDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if group == nil {
+		diags.AddAttributeError(path.Root("group_id"), "Unable to find the permissions group.", fmt.Sprint(groupId))
+		return diags
+	}
+
+	var members []metabase.PermissionsGroupMember
+	if group.Members != nil {
+		members = *group.Members
+	}
+
+	changes, changesDiags := makeGroupMembersChanges(members, userIds)
+	diags.Append(changesDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, userId := range changes.UsersToAdd {
+		createResp, err := r.client.CreatePermissionsMembershipWithResponse(ctx, metabase.CreatePermissionsMembershipBody{
+			GroupId: groupId,
+			UserId:  userId,
+		})
+
+		diags.Append(checkMetabaseResponse(createResp, err, []int{200}, "create permissions membership")...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	for _, membershipId := range changes.MembershipsToRemove {
+		deleteResp, err := r.client.DeletePermissionsMembershipWithResponse(ctx, membershipId)
+
+		diags.Append(checkMetabaseResponse(deleteResp, err, []int{204}, "delete permissions membership")...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// Reconciles the members of the group with the plan, and reads the resulting list of members.
+func (r *GroupMembersResource) applyPlan(ctx context.Context, data *GroupMembersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	userIds, setDiags := makeUserIdsFromSet(ctx, data.UserIds)
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	groupId := int(data.GroupId.ValueInt64())
+	diags.Append(r.reconcileGroupMembers(ctx, groupId, userIds)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	group, getDiags := r.getGroup(ctx, groupId)
+	diags.Append(getDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if group == nil {
+		diags.AddAttributeError(path.Root("group_id"), "Unable to find the permissions group.", fmt.Sprint(groupId))
+		return diags
+	}
+
+	diags.Append(updateModelFromGroupMembers(ctx, *group, data)...)
+	return diags
+}
+
+func (r *GroupMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *GroupMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyPlan(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *GroupMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, diags := r.getGroup(ctx, int(data.GroupId.ValueInt64()))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if group == nil {
+		removeMissingResourceFromState(ctx, resp, "permissions group", data.GroupId.ValueInt64(), "not found")
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromGroupMembers(ctx, *group, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *GroupMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyPlan(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *GroupMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, diags := r.getGroup(ctx, int(data.GroupId.ValueInt64()))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || group == nil || group.Members == nil {
+		return
+	}
+
+	userIds, diags := makeUserIdsFromSet(ctx, data.UserIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the users listed in the state are removed, such that users added outside of Terraform since the last refresh
+	// are left in the group.
+	managedUsers := make(map[int]bool, len(userIds))
+	for _, id := range userIds {
+		managedUsers[id] = true
+	}
+
+	remainingUsers := make([]int, 0, len(*group.Members))
+	for _, m := range *group.Members {
+		if !managedUsers[m.UserId] {
+			remainingUsers = append(remainingUsers, m.UserId)
+		}
+	}
+
+	resp.Diagnostics.Append(r.reconcileGroupMembers(ctx, group.Id, remainingUsers)...)
+}
+
+func (r *GroupMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupId, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to convert ID to an integer.", req.ID)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupId)...)
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccGroupMembersResource(userIds string) string {
+	return fmt.Sprintf(`
+resource "metabase_permissions_group" "test" {
+  name = "👥 Members"
+}
+
+resource "metabase_group_members" "test" {
+  group_id = metabase_permissions_group.test.id
+  user_ids = %s
+}
+`,
+		userIds,
+	)
+}
+
+func TestAccGroupMembersResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionsGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The admin user created when setting up Metabase always has ID 1.
+				Config: providerConfig + testAccGroupMembersResource("[1]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_group_members.test", "user_ids.#", "1"),
+					resource.TestCheckResourceAttr("metabase_group_members.test", "user_ids.0", "1"),
+				),
+			},
+			{
+				ResourceName:                         "metabase_group_members.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "group_id",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["metabase_permissions_group.test"].Primary.ID, nil
+				},
+			},
+			{
+				Config: providerConfig + testAccGroupMembersResource("[]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("metabase_group_members.test", "user_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestMakeGroupMembersChanges(t *testing.T) {
+	firstMembership, secondMembership := 10, 11
+	members := []metabase.PermissionsGroupMember{
+		{UserId: 1, MembershipId: &firstMembership},
+		{UserId: 2, MembershipId: &secondMembership},
+	}
+
+	changes, diags := makeGroupMembersChanges(members, []int{4, 2, 3})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !reflect.DeepEqual(changes.UsersToAdd, []int{3, 4}) {
+		t.Errorf("Expected users 3 and 4 to be added, got: %v.", changes.UsersToAdd)
+	}
+	if !reflect.DeepEqual(changes.MembershipsToRemove, []int{10}) {
+		t.Errorf("Expected the membership of user 1 to be removed, got: %v.", changes.MembershipsToRemove)
+	}
+
+	changes, diags = makeGroupMembersChanges(members, []int{1, 2})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(changes.UsersToAdd) != 0 || len(changes.MembershipsToRemove) != 0 {
+		t.Errorf("Expected no change when the group already has the expected members, got: %v.", changes)
+	}
+
+	_, diags = makeGroupMembersChanges([]metabase.PermissionsGroupMember{{UserId: 1}}, []int{})
+	if !diags.HasError() {
+		t.Errorf("Expected an error when the membership ID is missing.")
+	}
+}
@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithImportState = &ImpersonationResource{}
+
+// Creates a new impersonation resource.
+func NewImpersonationResource() resource.Resource {
+	return &ImpersonationResource{
+		MetabaseBaseResource{name: "impersonation"},
+	}
+}
+
+// A resource handling a connection impersonation, mapping a group to a database role using a user attribute.
+// This is only available in paid versions of Metabase.
+type ImpersonationResource struct {
+	MetabaseBaseResource
+}
+
+// The Terraform model for a connection impersonation.
+type ImpersonationResourceModel struct {
+	Id         types.Int64  `tfsdk:"id"`          // The ID of the impersonation.
+	GroupId    types.Int64  `tfsdk:"group_id"`    // The ID of the permissions group to which the impersonation applies.
+	DatabaseId types.Int64  `tfsdk:"database_id"` // The ID of the database to which the impersonation applies.
+	Attribute  types.String `tfsdk:"attribute"`   // The user attribute containing the name of the database role.
+}
+
+func (r *ImpersonationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A Metabase connection impersonation, making queries from members of a group run using the database role contained in a user attribute.
+
+Connection impersonation is only available in paid versions of Metabase. It should be used along with the ` + "`impersonated`" + ` ` + "`view_data`" + ` level for the group and database in the ` + "`metabase_permissions_graph`" + ` resource. Setting another level in the permissions graph will cause Metabase to delete the impersonation.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the impersonation.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"group_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the permissions group to which the impersonation applies.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"database_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the database to which the impersonation applies.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"attribute": schema.StringAttribute{
+				MarkdownDescription: "The name of the user attribute containing the database role to use when running queries.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// Updates the given `ImpersonationResourceModel` from the `Impersonation` returned by the Metabase API.
+func updateModelFromImpersonation(i metabase.Impersonation, data *ImpersonationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.Int64Value(int64(i.Id))
+	data.GroupId = types.Int64Value(int64(i.GroupId))
+	data.DatabaseId = types.Int64Value(int64(i.DbId))
+	data.Attribute = types.StringValue(i.Attribute)
+
+	return diags
+}
+
+// Creates or updates the impersonation for the group and database in the model.
+// Metabase does not expose a dedicated endpoint for this, and impersonations are instead sent along with the permissions
+// graph. No group permission is sent in the graph, such that existing permissions are left untouched.
+func (r *ImpersonationResource) upsertImpersonation(ctx context.Context, data ImpersonationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	revision, revisionDiags := getPermissionsGraphRevision(ctx, r.client)
+	diags.Append(revisionDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	impersonations := []metabase.ImpersonationParams{
+		{
+			GroupId:   int(data.GroupId.ValueInt64()),
+			DbId:      int(data.DatabaseId.ValueInt64()),
+			Attribute: data.Attribute.ValueString(),
+		},
+	}
+
+	_, updateDiags := updateGraphWithRevisionRetry(
+		ctx,
+		r.client,
+		revision,
+		func(revision int) (*metabase.ReplacePermissionsGraphResponse, error) {
+			return r.client.ReplacePermissionsGraphWithResponse(ctx, metabase.PermissionsGraph{
+				Revision:       revision,
+				Groups:         map[string]metabase.PermissionsGraphDatabasePermissionsMap{},
+				Impersonations: &impersonations,
+			})
+		},
+		getPermissionsGraphRevision,
+		"replace permissions graph",
+	)
+	diags.Append(updateDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	return diags
+}
+
+// Fetches the impersonation for the group and database in the model, and updates the model from it.
+// If the impersonation cannot be found, `found` is `false` and the model is not updated.
+func (r *ImpersonationResource) readImpersonation(ctx context.Context, data *ImpersonationResourceModel) (found bool, diags diag.Diagnostics) {
+	getResp, err := r.client.GetImpersonationWithResponse(ctx, &metabase.GetImpersonationParams{
+		GroupId: int(data.GroupId.ValueInt64()),
+		DbId:    int(data.DatabaseId.ValueInt64()),
+	})
+
+	diags.Append(checkMetabaseResponse(getResp, err, []int{200, 204, 402, 404}, "get impersonation")...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	if metabase.IsPaidFeatureUnavailable(getResp) {
+		diags.AddError("Connection impersonation is not available in this Metabase instance.", "Connection impersonation is only available in paid versions of Metabase (Pro or Enterprise).")
+		return false, diags
+	}
+
+	// Other 404 responses are reported as unexpected, and Metabase returns an empty response (204) when no impersonation
+	// exists for the group and database.
+	diags.Append(checkMetabaseResponse(getResp, nil, []int{200, 204}, "get impersonation")...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	if getResp.StatusCode() == 204 {
+		return false, diags
+	}
+
+	diags.Append(updateModelFromImpersonation(*getResp.JSON200, data)...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return true, diags
+}
+
+// Returns the diagnostics reported when an impersonation cannot be found right after it has been created or updated,
+// which usually means the feature is not available in the Metabase instance.
+func impersonationUnavailableDiagnostics() diag.Diagnostics {
+	return diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Connection impersonation is not available in this Metabase instance.",
+			"The impersonation could not be found after being saved. Connection impersonation is only available in paid versions of Metabase (Pro or Enterprise), and for databases that support it.",
+		),
+	}
+}
+
+func (r *ImpersonationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ImpersonationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.upsertImpersonation(ctx, *data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.readImpersonation(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.Diagnostics.Append(impersonationUnavailableDiagnostics()...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImpersonationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ImpersonationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.readImpersonation(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		removeMissingResourceFromState(ctx, resp, "impersonation", fmt.Sprintf("%d/%d", data.GroupId.ValueInt64(), data.DatabaseId.ValueInt64()), "not found")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImpersonationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ImpersonationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.upsertImpersonation(ctx, *data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.readImpersonation(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.Diagnostics.Append(impersonationUnavailableDiagnostics()...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImpersonationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ImpersonationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteResp, err := r.client.DeleteImpersonationWithResponse(ctx, int(data.Id.ValueInt64()))
+
+	resp.Diagnostics.Append(checkMetabaseResponse(deleteResp, err, []int{204}, "delete impersonation")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ImpersonationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Metabase only allows retrieving an impersonation from its group and database.
+	groupIdStr, databaseIdStr, ok := strings.Cut(req.ID, ",")
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected import ID format.", fmt.Sprintf("Expected '<group ID>,<database ID>', got: %s.", req.ID))
+		return
+	}
+
+	groupId, err := strconv.ParseInt(groupIdStr, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to convert group ID to an integer.", groupIdStr)
+		return
+	}
+
+	databaseId, err := strconv.ParseInt(databaseIdStr, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to convert database ID to an integer.", databaseIdStr)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database_id"), databaseId)...)
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUpdateModelFromImpersonation(t *testing.T) {
+	var impersonation metabase.Impersonation
+	err := json.Unmarshal([]byte(`{"id": 7, "group_id": 3, "db_id": 2, "attribute": "db_role"}`), &impersonation)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var data ImpersonationResourceModel
+	diags := updateModelFromImpersonation(impersonation, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if data.Id.ValueInt64() != 7 || data.GroupId.ValueInt64() != 3 || data.DatabaseId.ValueInt64() != 2 {
+		t.Errorf("Unexpected IDs for the impersonation: %s, %s, %s.", data.Id, data.GroupId, data.DatabaseId)
+	}
+	if data.Attribute.ValueString() != "db_role" {
+		t.Errorf("Expected attribute db_role, got %s.", data.Attribute)
+	}
+}
+
+func TestImpersonationImportState(t *testing.T) {
+	ctx := context.Background()
+	r := &ImpersonationResource{}
+
+	importState := func(id string) (ImpersonationResourceModel, bool) {
+		resp := resource.ImportStateResponse{State: makeTestResourceState(t, r, nil)}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: id}, &resp)
+		if resp.Diagnostics.HasError() {
+			return ImpersonationResourceModel{}, false
+		}
+
+		var data ImpersonationResourceModel
+		diags := resp.State.Get(ctx, &data)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+		return data, true
+	}
+
+	data, ok := importState("3,2")
+	if !ok {
+		t.Fatalf("Expected the import ID to be parsed.")
+	}
+	if data.GroupId.ValueInt64() != 3 || data.DatabaseId.ValueInt64() != 2 {
+		t.Errorf("Expected group 3 and database 2, got %s and %s.", data.GroupId, data.DatabaseId)
+	}
+
+	for _, id := range []string{"3", "3/2", "a,2", "3,b", ",2"} {
+		if _, ok := importState(id); ok {
+			t.Errorf("Expected an error for import ID %q.", id)
+		}
+	}
+}
+
+func TestReadImpersonation(t *testing.T) {
+	testCases := []struct {
+		status        int
+		body          string
+		expectedFound bool
+		expectedError bool
+	}{
+		{200, `{"id": 7, "group_id": 3, "db_id": 2, "attribute": "db_role"}`, true, false},
+		{204, ``, false, false},
+		{402, `Connection impersonation is a paid feature not currently available to your instance.`, false, true},
+		{404, `API endpoint does not exist.`, false, true},
+		{404, `Not found.`, false, true},
+		{500, `Internal error.`, false, true},
+	}
+
+	for _, tc := range testCases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/ee/advanced-permissions/impersonation" || r.URL.Query().Get("group_id") != "3" || r.URL.Query().Get("db_id") != "2" {
+				t.Errorf("Unexpected request: %s %s.", r.Method, r.URL)
+			}
+
+			if tc.status == 200 {
+				w.Header().Set("Content-Type", "application/json")
+			}
+			w.WriteHeader(tc.status)
+			w.Write([]byte(tc.body))
+		}))
+
+		client, err := metabase.NewClientWithResponses(server.URL)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		r := &ImpersonationResource{MetabaseBaseResource{client: client}}
+		data := ImpersonationResourceModel{
+			GroupId:    types.Int64Value(3),
+			DatabaseId: types.Int64Value(2),
+		}
+		found, diags := r.readImpersonation(context.Background(), &data)
+		server.Close()
+
+		if found != tc.expectedFound {
+			t.Errorf("Expected found to be %t for status %d, got %t.", tc.expectedFound, tc.status, found)
+		}
+		if diags.HasError() != tc.expectedError {
+			t.Errorf("Unexpected diagnostics for status %d: %v.", tc.status, diags)
+		}
+		if found && data.Id.ValueInt64() != 7 {
+			t.Errorf("Expected the model to be updated, got ID %s.", data.Id)
+		}
+	}
+}
+
+func TestReadRemovesMissingImpersonation(t *testing.T) {
+	// Unlike other resources, Metabase returns an empty response rather than a 404 when no impersonation exists.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := metabase.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v.", err)
+	}
+
+	r := &ImpersonationResource{MetabaseBaseResource{client: client}}
+	id := tftypes.NewValue(tftypes.Number, 1)
+	state := makeTestResourceState(t, r, map[string]tftypes.Value{"group_id": id, "database_id": id})
+	resp := resource.ReadResponse{State: state}
+
+	r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v.", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("Expected the impersonation to be removed from the state, got %s.", resp.State.Raw)
+	}
+}
@@ -16,6 +16,12 @@ type MetabaseBaseResource struct {
 
 	// The Metabase API client.
 	client *metabase.ClientWithResponses
+
+	// The base URL of the Metabase site, used to build links to objects.
+	siteUrl string
+
+	// Whether the permissions of the Administrators group are managed by graph resources by default.
+	manageAdminPermissions bool
 }
 
 func (r *MetabaseBaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -27,16 +33,18 @@ func (r *MetabaseBaseResource) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*metabase.ClientWithResponses)
+	providerData, ok := req.ProviderData.(*MetabaseResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected client type when configuring Metabase resource.",
-			fmt.Sprintf("Expected *metabase.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.MetabaseResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.siteUrl = providerData.SiteUrl
+	r.manageAdminPermissions = providerData.ManageAdminPermissions
 }
@@ -0,0 +1,357 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The database setting indicating whether models querying the database are persisted.
+const persistModelsEnabledSetting = "persist-models-enabled"
+
+// The states of a persisted model for which persistence has been disabled.
+var unpersistedModelStates = map[string]bool{
+	"off":       true,
+	"deletable": true,
+}
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithImportState = &ModelPersistenceResource{}
+
+// Creates a new model persistence resource.
+func NewModelPersistenceResource() resource.Resource {
+	return &ModelPersistenceResource{
+		MetabaseBaseResource{name: "model_persistence"},
+	}
+}
+
+// A resource handling the persistence of models to the warehouse of a database.
+type ModelPersistenceResource struct {
+	MetabaseBaseResource
+}
+
+// The Terraform model for the persistence of models in a database.
+type ModelPersistenceResourceModel struct {
+	DatabaseId      types.Int64  `tfsdk:"database_id"`      // The ID of the database for which persistence is enabled.
+	RefreshSchedule types.String `tfsdk:"refresh_schedule"` // The cron expression used to refresh persisted models.
+	ModelIds        types.Set    `tfsdk:"model_ids"`        // The IDs of the models for which persistence is explicitly enabled.
+}
+
+func (r *ModelPersistenceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The persistence of Metabase models to the warehouse of a database.
+
+Creating the resource enables model persistence for the Metabase instance if needed, and then for the database, which persists all the models querying it. Destroying the resource disables persistence for the database and removes the persisted models, but leaves persistence enabled for the instance.
+
+Persistence can be disabled for a single model from the Metabase UI. Models listed in ` + "`model_ids`" + ` are persisted again if this happens, and models removed from the list are no longer persisted.`,
+
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the database for which models are persisted.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"refresh_schedule": schema.StringAttribute{
+				MarkdownDescription: "The schedule used to refresh persisted models, as a Quartz cron expression, e.g. `0 0 0/6 * * ? *`. This schedule is shared by all databases, and should only be set in one resource. The schedule is not read back from Metabase, and it is left unchanged if this is not set.",
+				Optional:            true,
+			},
+			"model_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the models (cards of type `model`) for which persistence is explicitly enabled.",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Returns whether persistence is enabled for the database, using its settings.
+func isDatabasePersistenceEnabled(db metabase.Database) bool {
+	if db.Settings == nil {
+		return false
+	}
+
+	enabled, ok := (*db.Settings)[persistModelsEnabledSetting].(bool)
+	return ok && enabled
+}
+
+// Returns the IDs which are in `expected` but not in `current`, and the ones which are in `current` but not in
+// `expected`. Both lists are sorted, which keeps the calls to the API deterministic.
+func makeModelPersistenceChanges(current []int, expected []int) ([]int, []int) {
+	currentIds := make(map[int]bool, len(current))
+	for _, id := range current {
+		currentIds[id] = true
+	}
+
+	expectedIds := make(map[int]bool, len(expected))
+	for _, id := range expected {
+		expectedIds[id] = true
+	}
+
+	toPersist := []int{}
+	for id := range expectedIds {
+		if !currentIds[id] {
+			toPersist = append(toPersist, id)
+		}
+	}
+
+	toUnpersist := []int{}
+	for id := range currentIds {
+		if !expectedIds[id] {
+			toUnpersist = append(toUnpersist, id)
+		}
+	}
+
+	sort.Ints(toPersist)
+	sort.Ints(toUnpersist)
+
+	return toPersist, toUnpersist
+}
+
+// Returns the list of model IDs in the given Terraform set, which may be null.
+func makeModelIdsFromSet(ctx context.Context, set types.Set) ([]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if set.IsNull() {
+		return []int{}, diags
+	}
+
+	var modelIds []int64
+	diags.Append(set.ElementsAs(ctx, &modelIds, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	ids := make([]int, 0, len(modelIds))
+	for _, id := range modelIds {
+		ids = append(ids, int(id))
+	}
+
+	return ids, diags
+}
+
+// Returns whether the given model is currently persisted. A model that has never been persisted is not found by the
+// Metabase API.
+func (r *ModelPersistenceResource) isModelPersisted(ctx context.Context, modelId int) (bool, diag.Diagnostics) {
+	getResp, err := r.client.GetPersistedInfoWithResponse(ctx, modelId)
+
+	status, diags := checkMetabaseResponseStatus(getResp, err, []int{200}, "get persisted info")
+	if diags.HasError() || status == metabaseResponseNotFound {
+		return false, diags
+	}
+
+	return !unpersistedModelStates[getResp.JSON200.State], diags
+}
+
+// Persists and unpersists models such that persistence is enabled for the planned models. Models listed in the state
+// are checked, as their persistence may have been disabled outside of Terraform.
+func (r *ModelPersistenceResource) updateModels(ctx context.Context, state types.Set, plan types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	stateIds, setDiags := makeModelIdsFromSet(ctx, state)
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	planIds, setDiags := makeModelIdsFromSet(ctx, plan)
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	toPersist, toUnpersist := makeModelPersistenceChanges(stateIds, planIds)
+
+	for _, modelId := range toPersist {
+		persistResp, err := r.client.PersistModelWithResponse(ctx, modelId)
+
+		diags.Append(checkMetabaseResponse(persistResp, err, []int{204}, "persist model")...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	for _, modelId := range toUnpersist {
+		unpersistResp, err := r.client.UnpersistModelWithResponse(ctx, modelId)
+
+		diags.Append(checkMetabaseResponse(unpersistResp, err, []int{204}, "unpersist model")...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// Sets the refresh schedule for persisted models, if it is defined in the model.
+func (r *ModelPersistenceResource) setRefreshSchedule(ctx context.Context, data ModelPersistenceResourceModel) diag.Diagnostics {
+	if data.RefreshSchedule.IsNull() {
+		return diag.Diagnostics{}
+	}
+
+	scheduleResp, err := r.client.SetPersistenceRefreshScheduleWithResponse(ctx, metabase.SetPersistenceRefreshScheduleBody{
+		Cron: data.RefreshSchedule.ValueString(),
+	})
+
+	return checkMetabaseResponse(scheduleResp, err, []int{204}, "set persistence refresh schedule")
+}
+
+func (r *ModelPersistenceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ModelPersistenceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Persistence cannot be enabled for a database unless it is enabled for the instance.
+	enableResp, err := r.client.EnablePersistenceWithResponse(ctx, metabase.EnablePersistenceBody{
+		Enabled: true,
+	})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(enableResp, err, []int{204}, "enable persistence")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setRefreshSchedule(ctx, *data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	persistResp, err := r.client.PersistDatabaseWithResponse(ctx, int(data.DatabaseId.ValueInt64()))
+
+	resp.Diagnostics.Append(checkMetabaseResponse(persistResp, err, []int{204}, "persist database")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Persisting the database usually persists all of its models, but some of them may have been unpersisted before.
+	resp.Diagnostics.Append(r.updateModels(ctx, types.SetNull(types.Int64Type), data.ModelIds)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ModelPersistenceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ModelPersistenceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResp, err := r.client.GetDatabaseWithResponse(ctx, int(data.DatabaseId.ValueInt64()))
+
+	status, diags := checkMetabaseResponseStatus(getResp, err, []int{200}, "get database")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if status == metabaseResponseNotFound {
+		removeMissingResourceFromState(ctx, resp, "database", data.DatabaseId.ValueInt64(), "not found")
+		return
+	}
+
+	if !isDatabasePersistenceEnabled(*getResp.JSON200) {
+		removeMissingResourceFromState(ctx, resp, "model persistence", data.DatabaseId.ValueInt64(), "persistence disabled")
+		return
+	}
+
+	if !data.ModelIds.IsNull() {
+		modelIds, diags := makeModelIdsFromSet(ctx, data.ModelIds)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		persistedIds := make([]int64, 0, len(modelIds))
+		for _, modelId := range modelIds {
+			persisted, diags := r.isModelPersisted(ctx, modelId)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if persisted {
+				persistedIds = append(persistedIds, int64(modelId))
+			}
+		}
+
+		modelIdsValue, diags := types.SetValueFrom(ctx, types.Int64Type, persistedIds)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.ModelIds = modelIdsValue
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ModelPersistenceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ModelPersistenceResourceModel
+	var state *ModelPersistenceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.RefreshSchedule.Equal(state.RefreshSchedule) {
+		resp.Diagnostics.Append(r.setRefreshSchedule(ctx, *data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.updateModels(ctx, state.ModelIds, data.ModelIds)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ModelPersistenceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ModelPersistenceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unpersisting the database also removes all of its persisted models.
+	unpersistResp, err := r.client.UnpersistDatabaseWithResponse(ctx, int(data.DatabaseId.ValueInt64()))
+
+	resp.Diagnostics.Append(checkMetabaseResponse(unpersistResp, err, []int{204}, "unpersist database")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *ModelPersistenceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	databaseId, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to convert database ID to an integer.", fmt.Sprintf("Expected a database ID, got: %s.", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database_id"), databaseId)...)
+}
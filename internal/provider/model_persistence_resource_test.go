@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+)
+
+func TestMakeModelPersistenceChanges(t *testing.T) {
+	toPersist, toUnpersist := makeModelPersistenceChanges([]int{3, 1, 2}, []int{4, 2, 1})
+
+	if !reflect.DeepEqual(toPersist, []int{4}) {
+		t.Errorf("Expected model 4 to be persisted, got %v.", toPersist)
+	}
+	if !reflect.DeepEqual(toUnpersist, []int{3}) {
+		t.Errorf("Expected model 3 to be unpersisted, got %v.", toUnpersist)
+	}
+}
+
+func TestIsDatabasePersistenceEnabled(t *testing.T) {
+	if isDatabasePersistenceEnabled(metabase.Database{}) {
+		t.Errorf("Expected persistence to be disabled when the database has no settings.")
+	}
+
+	settings := metabase.DatabaseSettings{persistModelsEnabledSetting: true}
+	if !isDatabasePersistenceEnabled(metabase.Database{Settings: &settings}) {
+		t.Errorf("Expected persistence to be enabled.")
+	}
+
+	settings[persistModelsEnabledSetting] = false
+	if isDatabasePersistenceEnabled(metabase.Database{Settings: &settings}) {
+		t.Errorf("Expected persistence to be disabled.")
+	}
+}
@@ -6,17 +6,22 @@ import (
 	"strconv"
 
 	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensures provider defined types fully satisfy framework interfaces.
 var _ resource.ResourceWithImportState = &PermissionsGraphResource{}
+var _ resource.ResourceWithValidateConfig = &PermissionsGraphResource{}
+var _ resource.ResourceWithModifyPlan = &PermissionsGraphResource{}
 
 // Creates a new permissions graph resource.
 func NewPermissionsGraphResource() resource.Resource {
@@ -37,6 +42,7 @@ type PermissionsGraphResourceModel struct {
 	AdvancedPermissions types.Bool  `tfsdk:"advanced_permissions"` // Whether advanced permissions should be set. This is only available to paid versions of Metabase.
 	IgnoredGroups       types.Set   `tfsdk:"ignored_groups"`       // The list of groups that should be ignored when updating permissions.
 	Permissions         types.Set   `tfsdk:"permissions"`          // The list of permissions (edges) in the graph.
+	CheckReferences     types.Bool  `tfsdk:"check_references"`     // Whether groups and databases referenced by the permissions should be checked when planning.
 }
 
 // The model for a single edge in the permissions graph.
@@ -65,13 +71,22 @@ var databasePermissionsObjectType = types.ObjectType{
 
 // The model for a single permission setting in an edge of the graph.
 type AccessPermissions struct {
-	Schemas types.String `tfsdk:"schemas"` // Schemas permissions.
+	Schemas         types.String `tfsdk:"schemas"`          // Schemas permissions.
+	GranularSchemas types.Map    `tfsdk:"granular_schemas"` // Permissions for each schema, when they differ between schemas.
 }
 
 // The schema for the `AccessPermissions` model.
 var accessPermissionAttributes = map[string]schema.Attribute{
 	"schemas": schema.StringAttribute{
-		MarkdownDescription: "The permission to access data through the Metabase interface",
+		MarkdownDescription: "The permission to access data through the Metabase interface, e.g. `full`, `limited`, or `none`.",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("granular_schemas")),
+		},
+	},
+	"granular_schemas": schema.MapAttribute{
+		MarkdownDescription: "The permission for each schema, where keys are schema names and values are permissions (e.g. `full`, `limited`, or `none`). This cannot be set along with `schemas`. Per-table permissions are not supported.",
+		ElementType:         types.StringType,
 		Optional:            true,
 	},
 }
@@ -79,7 +94,8 @@ var accessPermissionAttributes = map[string]schema.Attribute{
 // The object type definition for the `AccessPermissions` model.
 var accessPermissionsObjectType = types.ObjectType{
 	AttrTypes: map[string]attr.Type{
-		"schemas": types.StringType,
+		"schemas":          types.StringType,
+		"granular_schemas": types.MapType{ElemType: types.StringType},
 	},
 }
 
@@ -91,7 +107,11 @@ Metabase exposes a single resource to define all permissions related to database
 
 The permissions graph cannot be created or deleted. Trying to create it will result in an error. It should be imported instead. Trying to delete the resource will succeed with no impact on Metabase (it is a no-op).
 
-Permissions for the Administrators group cannot be changed. To avoid issues during the update, all permissions for the Administrators group are ignored by default. This behavior can be changed using the ignored groups attribute.`,
+The virtual Saved Questions database (ID ` + "`-1337`" + `) is ignored when reading the graph, unless permissions for it are explicitly defined, in which case they are managed like any other database.
+
+Permissions for the Administrators group cannot be changed. To avoid issues during the update, all permissions for the Administrators group are ignored by default. This behavior can be changed using the ignored groups attribute.
+
+When ` + "`check_references`" + ` is set and the permissions change, the provider checks that the referenced groups and databases exist in Metabase, and fails the plan otherwise. This only requires listing groups and databases once per plan.`,
 
 		Attributes: map[string]schema.Attribute{
 			"revision": schema.Int64Attribute{
@@ -104,7 +124,11 @@ Permissions for the Administrators group cannot be changed. To avoid issues duri
 			},
 			"ignored_groups": schema.SetAttribute{
 				ElementType:         types.Int64Type,
-				MarkdownDescription: "The list of group IDs that should be ignored when reading and updating permissions. By default, this contains the Administrators group (`[2]`).",
+				MarkdownDescription: "The list of group IDs that should be ignored when reading and updating permissions. By default, this contains the Administrators group (`[2]`), unless `manage_admin_permissions` is set in the provider configuration.",
+				Optional:            true,
+			},
+			"check_references": schema.BoolAttribute{
+				MarkdownDescription: "Whether the groups and databases referenced by the permissions should be checked when planning, such that a missing group or database is reported before applying. This lists groups and databases from the Metabase API when the permissions change. Defaults to `false`.",
 				Optional:            true,
 			},
 			"permissions": schema.SetNestedAttribute{
@@ -121,7 +145,7 @@ Permissions for the Administrators group cannot be changed. To avoid issues duri
 							Required:            true,
 						},
 						"view_data": schema.StringAttribute{
-							MarkdownDescription: "The permission definition for data access.",
+							MarkdownDescription: "The permission definition for data access. In paid versions of Metabase, `impersonated` can be used along with the `metabase_impersonation` resource.",
 							Required:            true,
 						},
 						"create_queries": schema.StringAttribute{
@@ -158,15 +182,31 @@ func makeAccessPermissionsFromDatabaseAccess(ctx context.Context, da *metabase.P
 	}
 
 	var diags diag.Diagnostics
+	access := AccessPermissions{
+		Schemas:         types.StringNull(),
+		GranularSchemas: types.MapNull(types.StringType),
+	}
+
 	schemas, err := da.Schemas.AsPermissionsGraphDatabaseAccessSchemas0()
-	if err != nil {
-		diags.AddError("Unexpected permissions value. This could be caused by using granular permissions (unsupported). Remove granular permissions and try again", err.Error())
-		return nil, diags
+	if err == nil {
+		access.Schemas = stringValueOrNull(&schemas)
+	} else {
+		granularSchemas, granularErr := da.Schemas.AsPermissionsGraphDatabaseAccessSchemas1()
+		if granularErr != nil {
+			diags.AddError("Unexpected permissions value. This could be caused by using per-table permissions (unsupported). Remove per-table permissions and try again", err.Error())
+			return nil, diags
+		}
+
+		granularSchemasValue, mapDiags := types.MapValueFrom(ctx, types.StringType, granularSchemas)
+		diags.Append(mapDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		access.GranularSchemas = granularSchemasValue
 	}
 
-	obj, diags := types.ObjectValueFrom(ctx, accessPermissionsObjectType.AttrTypes, AccessPermissions{
-		Schemas: stringValueOrNull(&schemas),
-	})
+	obj, diags := types.ObjectValueFrom(ctx, accessPermissionsObjectType.AttrTypes, access)
 	if diags.HasError() {
 		return nil, diags
 	}
@@ -224,18 +264,49 @@ func makePermissionsObjectFromDatabasePermissions(ctx context.Context, groupId s
 	return &permissionsObject, diags
 }
 
+// Returns whether the given permissions (from the plan or state) contain an edge for the Saved Questions virtual
+// database.
+func isSavedQuestionsDatabaseManaged(ctx context.Context, permissions types.Set) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if permissions.IsNull() || permissions.IsUnknown() {
+		return false, diags
+	}
+
+	var permissionsList []DatabasePermissions
+	diags.Append(permissions.ElementsAs(ctx, &permissionsList, false)...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	for _, p := range permissionsList {
+		if strconv.FormatInt(p.Database.ValueInt64(), 10) == metabase.SavedQuestionsDatabaseId {
+			return true, diags
+		}
+	}
+
+	return false, diags
+}
+
 // Updates the given `PermissionsGraphResourceModel` from the `PermissionsGraph` returned by the Metabase API.
-func updateModelFromPermissionsGraph(ctx context.Context, g metabase.PermissionsGraph, data *PermissionsGraphResourceModel) diag.Diagnostics {
+// `manageAdminPermissions` is the provider setting determining whether the Administrators group is ignored by default.
+func updateModelFromPermissionsGraph(ctx context.Context, g metabase.PermissionsGraph, manageAdminPermissions bool, data *PermissionsGraphResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	data.Revision = types.Int64Value(int64(g.Revision))
 
-	ignoredGroups, groupsDiags := getIgnoredPermissionsGroups(ctx, data.IgnoredGroups)
+	ignoredGroups, groupsDiags := getIgnoredPermissionsGroups(ctx, data.IgnoredGroups, manageAdminPermissions)
 	diags.Append(groupsDiags...)
 	if diags.HasError() {
 		return diags
 	}
 
+	manageSavedQuestions, savedQuestionsDiags := isSavedQuestionsDatabaseManaged(ctx, data.Permissions)
+	diags.Append(savedQuestionsDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
 	permissionsList := make([]attr.Value, 0, len(data.Permissions.Elements()))
 	for groupId, dbPermissionsMap := range g.Groups {
 		// Permissions for ignored groups are not stored in the state for clarity.
@@ -249,6 +320,12 @@ func updateModelFromPermissionsGraph(ctx context.Context, g metabase.Permissions
 				continue
 			}
 
+			// The Saved Questions virtual database is only stored in the state when it is part of the configuration, to
+			// avoid unexpected diffs.
+			if dbId == metabase.SavedQuestionsDatabaseId && !manageSavedQuestions {
+				continue
+			}
+
 			permissionsObject, objDiags := makePermissionsObjectFromDatabasePermissions(ctx, groupId, dbId, dbPermissions)
 			diags.Append(objDiags...)
 			if diags.HasError() {
@@ -301,6 +378,18 @@ func makeDatasetAccessFromModel(ctx context.Context, apObj types.Object, setIfNu
 				diags.AddError("Unexpected error setting permissions value", err.Error())
 				return nil, diags
 			}
+		} else if !ap.GranularSchemas.IsNull() {
+			granularSchemas := make(metabase.PermissionsGraphDatabaseAccessSchemas1, len(ap.GranularSchemas.Elements()))
+			diags.Append(ap.GranularSchemas.ElementsAs(ctx, &granularSchemas, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			err := schemas.FromPermissionsGraphDatabaseAccessSchemas1(granularSchemas)
+			if err != nil {
+				diags.AddError("Unexpected error setting granular permissions value", err.Error())
+				return nil, diags
+			}
 		}
 	}
 
@@ -313,7 +402,9 @@ func makeDatasetAccessFromModel(ctx context.Context, apObj types.Object, setIfNu
 // Passing the current state allows comparing the plan to an existing set of permissions. This allows explicitly
 // removing permissions by sending "none" values to the Metabase API.
 // The Metabase API automatically removes "none" values and does not return them.
-func makePermissionsGraphFromModel(ctx context.Context, data PermissionsGraphResourceModel, state *PermissionsGraphResourceModel) (*metabase.PermissionsGraph, diag.Diagnostics) {
+// If the set of existing database IDs is passed, permissions from the state for databases which have since been deleted
+// are not sent, as the Metabase API rejects permissions for unknown databases.
+func makePermissionsGraphFromModel(ctx context.Context, data PermissionsGraphResourceModel, state *PermissionsGraphResourceModel, existingDatabases map[string]bool) (*metabase.PermissionsGraph, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	advancedPermissions := data.AdvancedPermissions.ValueBool()
@@ -422,6 +513,15 @@ func makePermissionsGraphFromModel(ctx context.Context, data PermissionsGraphRes
 				continue
 			}
 
+			if existingDatabases != nil && !existingDatabases[databaseId] {
+				// The database has been deleted, along with its permissions.
+				tflog.Info(ctx, "Skipping removal of permissions for a deleted database.", map[string]interface{}{
+					"group":    groupId,
+					"database": databaseId,
+				})
+				continue
+			}
+
 			var schemasNone metabase.PermissionsGraphDatabaseAccess_Schemas
 			err := schemasNone.FromPermissionsGraphDatabaseAccessSchemas0(metabase.PermissionsGraphDatabaseAccessSchemas0None)
 			if err != nil {
@@ -452,6 +552,150 @@ func makePermissionsGraphFromModel(ctx context.Context, data PermissionsGraphRes
 	}, diags
 }
 
+func (r *PermissionsGraphResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PermissionsGraphResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The provider may not be configured yet, in which case the warning is raised regardless of the provider setting.
+	if r.manageAdminPermissions {
+		return
+	}
+
+	resp.Diagnostics.Append(checkIgnoredGroupsContainAdministrators(ctx, data.IgnoredGroups)...)
+}
+
+// Returns the set of IDs of the given databases, as used as keys in the permissions graph.
+// The virtual databases (Saved Questions and Metabase analytics) are not listed by the API and are always included.
+func makeExistingDatabaseIds(databases []metabase.Database) map[string]bool {
+	existingDatabases := make(map[string]bool, len(databases)+2)
+	for _, db := range databases {
+		existingDatabases[fmt.Sprint(db.Id)] = true
+	}
+	existingDatabases[metabase.SavedQuestionsDatabaseId] = true
+	existingDatabases[metabase.MetabaseAnalyticsDatabaseId] = true
+
+	return existingDatabases
+}
+
+// Lists the databases from the Metabase API, returning the set of their IDs.
+func listExistingDatabaseIds(ctx context.Context, client *metabase.ClientWithResponses) (map[string]bool, diag.Diagnostics) {
+	databasesResp, err := client.ListDatabasesWithResponse(ctx, &metabase.ListDatabasesParams{})
+
+	diags := checkMetabaseResponse(databasesResp, err, []int{200}, "list databases")
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return makeExistingDatabaseIds(databasesResp.JSON200.Data), diags
+}
+
+// Returns errors for the groups and databases referenced by the permissions which do not exist. Existing databases are
+// described by a set of IDs, as returned by `makeExistingDatabaseIds`.
+func findMissingPermissionsGraphReferences(permissions []DatabasePermissions, groups []metabase.PermissionsGroup, existingDatabases map[string]bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	existingGroups := make(map[int64]bool, len(groups))
+	for _, g := range groups {
+		existingGroups[int64(g.Id)] = true
+	}
+
+	reportedGroups := make(map[int64]bool)
+	reportedDatabases := make(map[int64]bool)
+	for _, p := range permissions {
+		if !p.Group.IsUnknown() && !p.Group.IsNull() {
+			groupId := p.Group.ValueInt64()
+			if !existingGroups[groupId] && !reportedGroups[groupId] {
+				reportedGroups[groupId] = true
+				diags.AddAttributeError(
+					path.Root("permissions"),
+					"A permissions group referenced by the permissions graph does not exist.",
+					fmt.Sprintf("Group %d could not be found in Metabase.", groupId),
+				)
+			}
+		}
+
+		if !p.Database.IsUnknown() && !p.Database.IsNull() {
+			dbId := p.Database.ValueInt64()
+			if !existingDatabases[fmt.Sprint(dbId)] && !reportedDatabases[dbId] {
+				reportedDatabases[dbId] = true
+				diags.AddAttributeError(
+					path.Root("permissions"),
+					"A database referenced by the permissions graph does not exist.",
+					fmt.Sprintf("Database %d could not be found in Metabase.", dbId),
+				)
+			}
+		}
+	}
+
+	return diags
+}
+
+// Checks that the groups and databases referenced by the permissions exist, by listing groups from the Metabase API and
+// using the given set of existing database IDs.
+func checkPermissionsGraphReferencesExist(ctx context.Context, client *metabase.ClientWithResponses, permissions types.Set, existingDatabases map[string]bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var edges []DatabasePermissions
+	diags.Append(permissions.ElementsAs(ctx, &edges, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	groupsResp, err := client.ListPermissionsGroupsWithResponse(ctx)
+
+	diags.Append(checkMetabaseResponse(groupsResp, err, []int{200}, "list permissions groups")...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(findMissingPermissionsGraphReferences(edges, *groupsResp.JSON200, existingDatabases)...)
+
+	return diags
+}
+
+func (r *PermissionsGraphResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan PermissionsGraphResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// References are only checked when requested, and cannot be checked if the provider has not been configured yet.
+	if !plan.CheckReferences.ValueBool() || r.client == nil || plan.Permissions.IsNull() || plan.Permissions.IsUnknown() {
+		return
+	}
+
+	// References are only checked when the permissions change, to avoid unnecessary calls to the API.
+	if !req.State.Raw.IsNull() {
+		var state PermissionsGraphResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if state.Permissions.Equal(plan.Permissions) {
+			return
+		}
+	}
+
+	existingDatabases, diags := listExistingDatabaseIds(ctx, r.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkPermissionsGraphReferencesExist(ctx, r.client, plan.Permissions, existingDatabases)...)
+}
+
 func (r *PermissionsGraphResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	resp.Diagnostics.AddError("Creating the permissions graph is not allowed, import it instead.", "")
 }
@@ -471,7 +715,7 @@ func (r *PermissionsGraphResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	resp.Diagnostics.Append(updateModelFromPermissionsGraph(ctx, *getResp.JSON200, data)...)
+	resp.Diagnostics.Append(updateModelFromPermissionsGraph(ctx, *getResp.JSON200, r.manageAdminPermissions, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -492,20 +736,35 @@ func (r *PermissionsGraphResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	body, diags := makePermissionsGraphFromModel(ctx, *data, state)
+	existingDatabases, diags := listExistingDatabaseIds(ctx, r.client)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updateResp, err := r.client.ReplacePermissionsGraphWithResponse(ctx, *body)
+	body, diags := makePermissionsGraphFromModel(ctx, *data, state, existingDatabases)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	resp.Diagnostics.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update permissions graph")...)
+	updateResp, diags := updateGraphWithRevisionRetry(
+		ctx,
+		r.client,
+		body.Revision,
+		func(revision int) (*metabase.ReplacePermissionsGraphResponse, error) {
+			body.Revision = revision
+			return r.client.ReplacePermissionsGraphWithResponse(ctx, *body)
+		},
+		getPermissionsGraphRevision,
+		"update permissions graph",
+	)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(updateModelFromPermissionsGraph(ctx, *updateResp.JSON200, data)...)
+	resp.Diagnostics.Append(updateModelFromPermissionsGraph(ctx, *updateResp.JSON200, r.manageAdminPermissions, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -517,12 +776,17 @@ func (r *PermissionsGraphResource) Delete(ctx context.Context, req resource.Dele
 	resp.Diagnostics.AddWarning("Delete operation is not supported for the Metabase permissions graph.", "")
 }
 
-func (r *PermissionsGraphResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	revision, err := strconv.Atoi(req.ID)
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to convert revision to an integer.", req.ID)
-		return
+// Fetches the current revision of the permissions graph.
+func getPermissionsGraphRevision(ctx context.Context, client metabase.ClientWithResponsesInterface) (int, diag.Diagnostics) {
+	getResp, err := client.GetPermissionsGraphWithResponse(ctx)
+	diags := checkMetabaseResponse(getResp, err, []int{200}, "get permissions graph")
+	if diags.HasError() {
+		return 0, diags
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("revision"), revision)...)
+	return getResp.JSON200.Revision, diags
+}
+
+func (r *PermissionsGraphResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateGraphRevision(ctx, r.client, req, resp, getPermissionsGraphRevision)
 }
@@ -1,10 +1,14 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
 	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -53,6 +57,232 @@ func TestAccPermissionsGraphResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("metabase_permissions_graph.graph", "revision"),
 				),
 			},
+			{
+				ResourceName:  "metabase_permissions_graph.graph",
+				ImportState:   true,
+				ImportStateId: "latest",
+			},
+		},
+	})
+}
+
+// Converts the access permissions to the Metabase API format and back (through JSON), checking the sent body and that
+// the resulting object is unchanged.
+func testAccessPermissionsRoundTrip(t *testing.T, access AccessPermissions, expectedJson string) {
+	ctx := context.Background()
+
+	obj, diags := types.ObjectValueFrom(ctx, accessPermissionsObjectType.AttrTypes, access)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	da, diags := makeDatasetAccessFromModel(ctx, obj, false)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	body, err := json.Marshal(da)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != expectedJson {
+		t.Fatalf("expected body %s, got %s", expectedJson, string(body))
+	}
+
+	var received metabase.PermissionsGraphDatabaseAccess
+	err = json.Unmarshal(body, &received)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, diags := makeAccessPermissionsFromDatabaseAccess(ctx, &received)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !result.Equal(obj) {
+		t.Fatalf("expected %s, got %s", obj.String(), result.String())
+	}
+}
+
+func TestAccessPermissionsLimitedRoundTrip(t *testing.T) {
+	testAccessPermissionsRoundTrip(t, AccessPermissions{
+		Schemas:         types.StringValue("limited"),
+		GranularSchemas: types.MapNull(types.StringType),
+	}, `{"schemas":"limited"}`)
+}
+
+func TestAccessPermissionsGranularSchemasRoundTrip(t *testing.T) {
+	testAccessPermissionsRoundTrip(t, AccessPermissions{
+		Schemas: types.StringNull(),
+		GranularSchemas: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"PUBLIC":  types.StringValue("full"),
+			"private": types.StringValue("limited"),
+		}),
+	}, `{"schemas":{"PUBLIC":"full","private":"limited"}}`)
+}
+
+func TestAccessPermissionsPerTableUnsupported(t *testing.T) {
+	var received metabase.PermissionsGraphDatabaseAccess
+	err := json.Unmarshal([]byte(`{"schemas":{"PUBLIC":{"1":"full"}}}`), &received)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, diags := makeAccessPermissionsFromDatabaseAccess(context.Background(), &received)
+	if !diags.HasError() {
+		t.Fatal("expected an error for per-table permissions")
+	}
+}
+
+func makeTestSavedQuestionsGraph() metabase.PermissionsGraph {
+	return metabase.PermissionsGraph{
+		Revision: 1,
+		Groups: map[string]metabase.PermissionsGraphDatabasePermissionsMap{
+			"1": {
+				"1": {
+					ViewData: metabase.Unrestricted,
+				},
+				metabase.SavedQuestionsDatabaseId: {
+					ViewData: metabase.Unrestricted,
+				},
+			},
 		},
+	}
+}
+
+func TestSavedQuestionsDatabaseIgnoredByDefault(t *testing.T) {
+	ctx := context.Background()
+	data := PermissionsGraphResourceModel{
+		IgnoredGroups: types.SetNull(types.Int64Type),
+		Permissions:   types.SetNull(databasePermissionsObjectType),
+	}
+
+	diags := updateModelFromPermissionsGraph(ctx, makeTestSavedQuestionsGraph(), false, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	var permissions []DatabasePermissions
+	diags = data.Permissions.ElementsAs(ctx, &permissions, false)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(permissions) != 1 || permissions[0].Database.ValueInt64() != 1 {
+		t.Fatalf("expected only the permissions for database 1, got %s", data.Permissions.String())
+	}
+}
+
+func TestSavedQuestionsDatabaseManagedWhenConfigured(t *testing.T) {
+	ctx := context.Background()
+	configured, diags := types.ObjectValueFrom(ctx, databasePermissionsObjectType.AttrTypes, DatabasePermissions{
+		Group:         types.Int64Value(1),
+		Database:      types.Int64Value(-1337),
+		ViewData:      types.StringValue("unrestricted"),
+		CreateQueries: types.StringValue("no"),
+		Download:      types.ObjectNull(accessPermissionsObjectType.AttrTypes),
+		DataModel:     types.ObjectNull(accessPermissionsObjectType.AttrTypes),
+		Details:       types.StringNull(),
 	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	data := PermissionsGraphResourceModel{
+		IgnoredGroups: types.SetNull(types.Int64Type),
+		Permissions:   types.SetValueMust(databasePermissionsObjectType, []attr.Value{configured}),
+	}
+
+	diags = updateModelFromPermissionsGraph(ctx, makeTestSavedQuestionsGraph(), false, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(data.Permissions.Elements()) != 2 {
+		t.Fatalf("expected permissions for both databases, got %s", data.Permissions.String())
+	}
+}
+
+func TestFindMissingPermissionsGraphReferences(t *testing.T) {
+	groups := []metabase.PermissionsGroup{{Id: 1, Name: "All Users"}, {Id: 3, Name: "Analysts"}}
+	databases := makeExistingDatabaseIds([]metabase.Database{{Id: 1, Name: "Sample Database"}})
+
+	makeEdge := func(group int64, database int64) DatabasePermissions {
+		return DatabasePermissions{Group: types.Int64Value(group), Database: types.Int64Value(database)}
+	}
+
+	diags := findMissingPermissionsGraphReferences([]DatabasePermissions{
+		makeEdge(1, 1),
+		makeEdge(3, -1337),
+		{Group: types.Int64Unknown(), Database: types.Int64Value(1)},
+	}, groups, databases)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	diags = findMissingPermissionsGraphReferences([]DatabasePermissions{
+		makeEdge(4, 1),
+		makeEdge(4, 2),
+	}, groups, databases)
+	if diags.ErrorsCount() != 2 {
+		t.Fatalf("expected one error for the group and one for the database, got %v", diags)
+	}
+	if diags[0].Detail() != "Group 4 could not be found in Metabase." {
+		t.Errorf("expected the error to name the missing group, got %s", diags[0].Detail())
+	}
+	if diags[1].Detail() != "Database 2 could not be found in Metabase." {
+		t.Errorf("expected the error to name the missing database, got %s", diags[1].Detail())
+	}
+}
+
+func TestRemovedPermissionsSkipDeletedDatabases(t *testing.T) {
+	ctx := context.Background()
+	makeEdge := func(database int64) attr.Value {
+		edge, diags := types.ObjectValueFrom(ctx, databasePermissionsObjectType.AttrTypes, DatabasePermissions{
+			Group:         types.Int64Value(3),
+			Database:      types.Int64Value(database),
+			ViewData:      types.StringValue("unrestricted"),
+			CreateQueries: types.StringValue("query-builder"),
+			Download:      types.ObjectNull(accessPermissionsObjectType.AttrTypes),
+			DataModel:     types.ObjectNull(accessPermissionsObjectType.AttrTypes),
+			Details:       types.StringNull(),
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		return edge
+	}
+
+	plan := PermissionsGraphResourceModel{
+		Revision:            types.Int64Value(1),
+		AdvancedPermissions: types.BoolValue(false),
+		Permissions:         types.SetValueMust(databasePermissionsObjectType, []attr.Value{makeEdge(1)}),
+	}
+	// Permissions for databases 2 and 3 have been removed from the plan, but database 3 has been deleted in Metabase.
+	state := PermissionsGraphResourceModel{
+		Revision:            types.Int64Value(1),
+		AdvancedPermissions: types.BoolValue(false),
+		Permissions:         types.SetValueMust(databasePermissionsObjectType, []attr.Value{makeEdge(1), makeEdge(2), makeEdge(3)}),
+	}
+	existingDatabases := makeExistingDatabaseIds([]metabase.Database{{Id: 1}, {Id: 2}})
+
+	graph, diags := makePermissionsGraphFromModel(ctx, plan, &state, existingDatabases)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	permissions := graph.Groups["3"]
+	if len(permissions) != 2 {
+		t.Fatalf("expected permissions for databases 1 and 2 only, got %v", permissions)
+	}
+	if permissions["1"].ViewData != metabase.Unrestricted {
+		t.Errorf("expected the planned permissions for database 1, got %v", permissions["1"])
+	}
+	if *permissions["2"].CreateQueries != metabase.PermissionsGraphDatabasePermissionsCreateQueriesNo {
+		t.Errorf("expected the permissions for database 2 to be removed, got %v", permissions["2"])
+	}
+	if _, ok := permissions["3"]; ok {
+		t.Errorf("expected no permissions to be sent for the deleted database 3")
+	}
 }
@@ -2,9 +2,12 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/flovouin/terraform-provider-metabase/metabase"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -29,8 +32,10 @@ type PermissionsGroupResource struct {
 
 // The Terraform model for a permissions group.
 type PermissionsGroupResourceModel struct {
-	Id   types.Int64  `tfsdk:"id"`   // The ID of the permissions group.
-	Name types.String `tfsdk:"name"` // A user-displayable name for the group.
+	Id            types.Int64  `tfsdk:"id"`             // The ID of the permissions group.
+	Name          types.String `tfsdk:"name"`           // A user-displayable name for the group.
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"` // Whether an existing group with the same name should be adopted rather than failing.
+	MemberCount   types.Int64  `tfsdk:"member_count"`   // The number of users in the group.
 }
 
 func (r *PermissionsGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -47,6 +52,14 @@ func (r *PermissionsGroupResource) Schema(ctx context.Context, req resource.Sche
 				MarkdownDescription: "A user-displayable name for the group.",
 				Required:            true,
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "If a group with the same name already exists when creating the resource, it will be adopted (i.e. imported) rather than failing. Defaults to `false`.",
+				Optional:            true,
+			},
+			"member_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of users in the group. This can be used to detect empty groups, or groups with an unexpectedly large number of members.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -58,9 +71,43 @@ func updateModelFromPermissionsGroup(pg metabase.PermissionsGroup, data *Permiss
 	data.Id = types.Int64Value(int64(pg.Id))
 	data.Name = types.StringValue(pg.Name)
 
+	// The member count is only returned when listing groups, while single groups are returned with their members.
+	data.MemberCount = types.Int64Null()
+	if pg.MemberCount != nil {
+		data.MemberCount = types.Int64Value(int64(*pg.MemberCount))
+	} else if pg.Members != nil {
+		data.MemberCount = types.Int64Value(int64(len(*pg.Members)))
+	}
+
 	return diags
 }
 
+// Returns whether the response to a permissions group creation indicates that a group with the same name already exists.
+func isDuplicatePermissionsGroupResponse(r *metabase.CreatePermissionsGroupResponse) bool {
+	return r.StatusCode() == 400 && strings.Contains(strings.ToLower(string(r.Body)), "already exists")
+}
+
+// Finds the permissions group with the given name in Metabase.
+func findPermissionsGroupByName(ctx context.Context, client metabase.ClientWithResponsesInterface, name string) (*metabase.PermissionsGroup, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	listResp, err := client.ListPermissionsGroupsWithResponse(ctx)
+
+	diags.Append(checkMetabaseResponse(listResp, err, []int{200}, "list permissions groups")...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, pg := range *listResp.JSON200 {
+		if pg.Name == name {
+			return &pg, diags
+		}
+	}
+
+	diags.AddError("Unable to find an existing permissions group with the given name.", name)
+	return nil, diags
+}
+
 func (r *PermissionsGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *PermissionsGroupResourceModel
 
@@ -73,6 +120,31 @@ func (r *PermissionsGroupResource) Create(ctx context.Context, req resource.Crea
 		Name: data.Name.ValueString(),
 	})
 
+	if err == nil && isDuplicatePermissionsGroupResponse(createResp) {
+		if !data.AdoptExisting.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"A permissions group with the same name already exists.",
+				fmt.Sprintf("The %s group should be imported, or adopt_existing can be set to adopt it when creating the resource.", data.Name.ValueString()),
+			)
+			return
+		}
+
+		existingGroup, diags := findPermissionsGroupByName(ctx, r.client, data.Name.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(updateModelFromPermissionsGroup(*existingGroup, data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
 	resp.Diagnostics.Append(checkMetabaseResponse(createResp, err, []int{200}, "create permissions group")...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -96,14 +168,15 @@ func (r *PermissionsGroupResource) Read(ctx context.Context, req resource.ReadRe
 
 	getResp, err := r.client.GetPermissionsGroupWithResponse(ctx, int(data.Id.ValueInt64()))
 
-	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200, 204, 404}, "get permissions group")...)
+	status, diags := checkMetabaseResponseStatus(getResp, err, []int{200, 204}, "get permissions group")
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// The Metabase API can also return "no content" when the group has been deleted.
-	if getResp.StatusCode() == 404 || getResp.StatusCode() == 204 {
-		resp.State.RemoveResource(ctx)
+	if status == metabaseResponseNotFound || getResp.StatusCode() == 204 {
+		removeMissingResourceFromState(ctx, resp, "permissions group", data.Id.ValueInt64(), "not found")
 		return
 	}
 
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/flovouin/terraform-provider-metabase/metabase"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -101,3 +102,62 @@ func TestAccPermissionsGroupResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPermissionsGroupResourceAdoptExisting(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionsGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					_, err := testAccMetabaseClient.CreatePermissionsGroupWithResponse(context.Background(), metabase.CreatePermissionsGroupBody{
+						Name: "🤝 Existing",
+					})
+					if err != nil {
+						t.Fatalf("Failed to create existing permissions group: %s", err)
+					}
+				},
+				Config: providerConfig + `
+resource "metabase_permissions_group" "test" {
+  name           = "🤝 Existing"
+  adopt_existing = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPermissionsGroupExists("metabase_permissions_group.test"),
+					resource.TestCheckResourceAttrSet("metabase_permissions_group.test", "id"),
+					resource.TestCheckResourceAttr("metabase_permissions_group.test", "name", "🤝 Existing"),
+				),
+			},
+		},
+	})
+}
+
+func TestUpdateModelFromPermissionsGroupMemberCount(t *testing.T) {
+	count := 3
+	var data PermissionsGroupResourceModel
+	diags := updateModelFromPermissionsGroup(metabase.PermissionsGroup{Id: 1, Name: "Group", MemberCount: &count}, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if data.MemberCount.ValueInt64() != 3 {
+		t.Errorf("Expected member count from the listing response, got: %s.", data.MemberCount)
+	}
+
+	members := []metabase.PermissionsGroupMember{{UserId: 1}, {UserId: 2}}
+	diags = updateModelFromPermissionsGroup(metabase.PermissionsGroup{Id: 1, Name: "Group", Members: &members}, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if data.MemberCount.ValueInt64() != 2 {
+		t.Errorf("Expected member count from the list of members, got: %s.", data.MemberCount)
+	}
+
+	diags = updateModelFromPermissionsGroup(metabase.PermissionsGroup{Id: 1, Name: "Group"}, &data)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !data.MemberCount.IsNull() {
+		t.Errorf("Expected null member count when membership is not returned, got: %s.", data.MemberCount)
+	}
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PermissionsGroupsDataSource{}
+
+// Creates a new permissions groups data source.
+func NewPermissionsGroupsDataSource() datasource.DataSource {
+	return &PermissionsGroupsDataSource{}
+}
+
+// A data source listing all the permissions groups in Metabase.
+// This is mostly useful to assign permissions to every group, including the ones not managed by Terraform.
+type PermissionsGroupsDataSource struct {
+	// The Metabase API client.
+	client *metabase.ClientWithResponses
+}
+
+// The Terraform model for the list of permissions groups.
+type PermissionsGroupsDataSourceModel struct {
+	Groups types.List `tfsdk:"groups"` // The list of permissions groups, sorted by ID.
+}
+
+// The object type for a single permissions group in the list.
+var permissionsGroupsItemObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":           types.Int64Type,
+		"name":         types.StringType,
+		"member_count": types.Int64Type,
+	},
+}
+
+func (d *PermissionsGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions_groups"
+}
+
+func (d *PermissionsGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The list of all Metabase permissions groups.
+
+This data source can be used to assign permissions to every group in bulk, e.g. to grant baseline access to collections to all groups except ` + "`Administrators`" + `. Groups that are not managed by Terraform are also listed.`,
+
+		Attributes: map[string]schema.Attribute{
+			"groups": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of permissions groups, sorted by ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the permissions group.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The user-displayable name of the group.",
+							Computed:            true,
+						},
+						"member_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of users in the group.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PermissionsGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*metabase.ClientWithResponses)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected client type when configuring Metabase data source.",
+			fmt.Sprintf("Expected *metabase.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Updates the given `PermissionsGroupsDataSourceModel` from the list of groups returned by the Metabase API.
+func updateModelFromPermissionsGroups(groups []metabase.PermissionsGroup, data *PermissionsGroupsDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sortedGroups := make([]metabase.PermissionsGroup, len(groups))
+	copy(sortedGroups, groups)
+	sort.Slice(sortedGroups, func(i, j int) bool {
+		return sortedGroups[i].Id < sortedGroups[j].Id
+	})
+
+	groupsList := make([]attr.Value, 0, len(sortedGroups))
+	for _, g := range sortedGroups {
+		groupObject, objectDiags := types.ObjectValue(permissionsGroupsItemObjectType.AttrTypes, map[string]attr.Value{
+			"id":           types.Int64Value(int64(g.Id)),
+			"name":         types.StringValue(g.Name),
+			"member_count": int64ValueOrNull(g.MemberCount),
+		})
+		diags.Append(objectDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		groupsList = append(groupsList, groupObject)
+	}
+
+	groupsValue, listDiags := types.ListValue(permissionsGroupsItemObjectType, groupsList)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Groups = groupsValue
+
+	return diags
+}
+
+func (d *PermissionsGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PermissionsGroupsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listResp, err := d.client.ListPermissionsGroupsWithResponse(ctx)
+
+	resp.Diagnostics.Append(checkMetabaseResponse(listResp, err, []int{200}, "list permissions groups")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromPermissionsGroups(*listResp.JSON200, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUpdateModelFromPermissionsGroups(t *testing.T) {
+	var groups []metabase.PermissionsGroup
+	err := json.Unmarshal([]byte(`[
+		{"id": 3, "name": "Analysts", "member_count": 4},
+		{"id": 1, "name": "All Users", "member_count": 12},
+		{"id": 2, "name": "Administrators"}
+	]`), &groups)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var data PermissionsGroupsDataSourceModel
+	diags := updateModelFromPermissionsGroups(groups, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	elements := data.Groups.Elements()
+	if len(elements) != 3 {
+		t.Fatalf("Expected 3 groups, got %d.", len(elements))
+	}
+
+	expectedNames := []string{`"All Users"`, `"Administrators"`, `"Analysts"`}
+	expectedCounts := []string{"12", "<null>", "4"}
+	for i, e := range elements {
+		attributes := e.(types.Object).Attributes()
+		if name := attributes["name"].String(); name != expectedNames[i] {
+			t.Errorf("Expected name %s for group %d, got %s.", expectedNames[i], i, name)
+		}
+		if count := attributes["member_count"].String(); count != expectedCounts[i] {
+			t.Errorf("Expected member count %s for group %d, got %s.", expectedCounts[i], i, count)
+		}
+	}
+}
@@ -2,12 +2,15 @@ package provider
 
 import (
 	"context"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/flovouin/terraform-provider-metabase/metabase"
 )
@@ -24,10 +27,27 @@ type MetabaseProvider struct {
 
 // The Terraform model for the provider.
 type MetabaseProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"` // The URL to the Metabase API.
-	Username types.String `tfsdk:"username"` // The user name (or email address) to use to authenticate.
-	Password types.String `tfsdk:"password"` // The password to use to authenticate.
-	ApiKey   types.String `tfsdk:"api_key"`  // The API key to use to authenticate. This can be used instead of a user name and password.
+	Endpoint               types.String `tfsdk:"endpoint"`                 // The URL to the Metabase API.
+	Username               types.String `tfsdk:"username"`                 // The user name (or email address) to use to authenticate.
+	Password               types.String `tfsdk:"password"`                 // The password to use to authenticate.
+	ApiKey                 types.String `tfsdk:"api_key"`                  // The API key to use to authenticate. This can be used instead of a user name and password.
+	SessionToken           types.String `tfsdk:"session_token"`            // An existing session ID to use to authenticate, without creating a new session.
+	ExtraHeaders           types.Map    `tfsdk:"extra_headers"`            // Additional HTTP headers sent with every request, e.g. for an authentication proxy.
+	SessionHeaderName      types.String `tfsdk:"session_header_name"`      // The header in which the session ID is sent, when authenticating with a user name and password.
+	ApiKeyHeaderName       types.String `tfsdk:"api_key_header_name"`      // The header in which the API key is sent.
+	ManageAdminPermissions types.Bool   `tfsdk:"manage_admin_permissions"` // Whether graph resources manage the permissions of the Administrators group by default.
+}
+
+// The data passed by the provider to resources when they are configured.
+type MetabaseResourceData struct {
+	Client                 *metabase.ClientWithResponses // The authenticated Metabase API client.
+	SiteUrl                string                        // The base URL of the Metabase site, used to build links to objects.
+	ManageAdminPermissions bool                          // Whether graph resources manage the permissions of the Administrators group by default.
+}
+
+// Returns the base URL of the Metabase site from the URL to the Metabase API, by removing the `/api` suffix.
+func makeSiteUrlFromEndpoint(endpoint string) string {
+	return strings.TrimSuffix(strings.TrimSuffix(strings.TrimSuffix(endpoint, "/"), "/api"), "/")
 }
 
 func (p *MetabaseProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -39,7 +59,9 @@ func (p *MetabaseProvider) Schema(ctx context.Context, req provider.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `The Metabase provider allows managing both metadata (collections, permissions groups) and actual visualizations (cards/questions and dashboards).
 
-While most Terraform resources fully define the Metabase objects using attributes, the most complex ones (cards and dashboards) must be defined using JSON (and possibly templates).`,
+While most Terraform resources fully define the Metabase objects using attributes, the most complex ones (cards and dashboards) must be defined using JSON (and possibly templates).
+
+Objects deleted outside of Terraform are handled consistently by all resources: when reading a resource whose object no longer exists in Metabase (or has been archived, for cards and dashboards), the resource is removed from the state and a warning is logged. Terraform then plans its re-creation, and importing a non-existing object fails. Graph resources (permissions and collection graphs) always exist and are never removed from the state.`,
 
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
@@ -60,6 +82,29 @@ While most Terraform resources fully define the Metabase objects using attribute
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"session_token": schema.StringAttribute{
+				MarkdownDescription: "An existing Metabase session ID to use to authenticate, e.g. when the session is managed outside of Terraform. No new session is created. This can be used instead of a user name and password or an API key.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"extra_headers": schema.MapAttribute{
+				MarkdownDescription: "Additional HTTP headers sent with every request to Metabase, e.g. `X-Forwarded-Access-Token` to traverse an authentication proxy. This is distinct from the Metabase authentication.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"session_header_name": schema.StringAttribute{
+				MarkdownDescription: "The HTTP header in which the session ID is sent when authenticating with a user name and password or a session token, e.g. if a gateway in front of Metabase rewrites headers. Defaults to `X-Metabase-Session`.",
+				Optional:            true,
+			},
+			"api_key_header_name": schema.StringAttribute{
+				MarkdownDescription: "The HTTP header in which the API key is sent, e.g. if a gateway in front of Metabase rewrites headers. Defaults to `X-Api-Key`.",
+				Optional:            true,
+			},
+			"manage_admin_permissions": schema.BoolAttribute{
+				MarkdownDescription: "Whether the `metabase_permissions_graph` and `metabase_collection_graph` resources should manage the permissions of the Administrators group when their `ignored_groups` attribute is not set. By default, the Administrators group is ignored, as Metabase does not allow changing its permissions. Defaults to `false`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -73,64 +118,124 @@ func (p *MetabaseProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	var clientOptions []metabase.ClientOption
+	if !data.ExtraHeaders.IsNull() {
+		var extraHeaders map[string]string
+		resp.Diagnostics.Append(data.ExtraHeaders.ElementsAs(ctx, &extraHeaders, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Header values may contain credentials and are never logged.
+		headerNames := make([]string, 0, len(extraHeaders))
+		for name := range extraHeaders {
+			headerNames = append(headerNames, name)
+		}
+		sort.Strings(headerNames)
+		tflog.Debug(ctx, "Adding extra headers to Metabase requests.", map[string]interface{}{
+			"header_names": headerNames,
+		})
+
+		clientOptions = append(clientOptions, metabase.WithHeaders(extraHeaders))
+	}
+
 	var err error
 	var authenticatedClient *metabase.ClientWithResponses
 
-	if !data.Username.IsNull() && !data.Password.IsNull() {
-		if !data.ApiKey.IsNull() {
-			resp.Diagnostics.AddError("Only one of username / password or API key can be provided.", "")
-			return
+	authenticationMethods := 0
+	for _, configured := range []bool{
+		!data.Username.IsNull() || !data.Password.IsNull(),
+		!data.ApiKey.IsNull(),
+		!data.SessionToken.IsNull(),
+	} {
+		if configured {
+			authenticationMethods++
 		}
+	}
+	if authenticationMethods > 1 {
+		resp.Diagnostics.AddError("Only one of username / password, API key, or session token can be provided.", "")
+		return
+	}
 
+	if !data.Username.IsNull() && !data.Password.IsNull() {
 		authenticatedClient, err = metabase.MakeAuthenticatedClientWithUsernameAndPassword(
 			ctx,
 			data.Endpoint.ValueString(),
 			data.Username.ValueString(),
 			data.Password.ValueString(),
+			data.SessionHeaderName.ValueString(),
+			clientOptions...,
 		)
 		if err != nil {
 			resp.Diagnostics.AddError("Failed to create the Metabase client from username and password.", err.Error())
 			return
 		}
 	} else if !data.ApiKey.IsNull() {
-		if !data.Username.IsNull() || !data.Password.IsNull() {
-			resp.Diagnostics.AddError("Only one of username / password or API key can be provided.", "")
-			return
-		}
-
 		authenticatedClient, err = metabase.MakeAuthenticatedClientWithApiKey(
 			ctx,
 			data.Endpoint.ValueString(),
 			data.ApiKey.ValueString(),
+			data.ApiKeyHeaderName.ValueString(),
+			clientOptions...,
 		)
 		if err != nil {
 			resp.Diagnostics.AddError("Failed to create the Metabase client from the API key.", err.Error())
 			return
 		}
+	} else if !data.SessionToken.IsNull() {
+		authenticatedClient, err = metabase.MakeAuthenticatedClientWithSessionToken(
+			ctx,
+			data.Endpoint.ValueString(),
+			data.SessionToken.ValueString(),
+			data.SessionHeaderName.ValueString(),
+			clientOptions...,
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to create the Metabase client from the session token.", err.Error())
+			return
+		}
 	} else {
-		resp.Diagnostics.AddError("Either username / password or API key must be provided.", "")
+		resp.Diagnostics.AddError("Either username / password, API key, or session token must be provided.", "")
 		return
 	}
 
 	resp.DataSourceData = authenticatedClient
-	resp.ResourceData = authenticatedClient
+	resp.ResourceData = &MetabaseResourceData{
+		Client:                 authenticatedClient,
+		SiteUrl:                makeSiteUrlFromEndpoint(data.Endpoint.ValueString()),
+		ManageAdminPermissions: data.ManageAdminPermissions.ValueBool(),
+	}
 }
 
 func (p *MetabaseProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewCardResource,
+		NewCardVerificationResource,
 		NewCollectionGraphResource,
 		NewCollectionResource,
 		NewDashboardResource,
 		NewDatabaseResource,
+		NewGroupMembersResource,
+		NewImpersonationResource,
+		NewModelPersistenceResource,
 		NewPermissionsGraphResource,
 		NewPermissionsGroupResource,
+		NewSandboxResource,
 		NewTableResource,
 	}
 }
 
 func (p *MetabaseProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewCardRelatedDataSource,
+		NewCollectionItemsDataSource,
+		NewCollectionTreeDataSource,
+		NewDashboardExportDataSource,
+		NewDatabaseDataSource,
+		NewDatabaseSchemasDataSource,
+		NewFieldDataSource,
+		NewGroupDataPermissionsSummaryDataSource,
+		NewPermissionsGroupsDataSource,
 		NewTableDataSource,
 	}
 }
@@ -41,4 +41,5 @@ var testAccMetabaseClient, _ = metabase.MakeAuthenticatedClientWithUsernameAndPa
 	os.Getenv("METABASE_URL"),
 	os.Getenv("METABASE_USERNAME"),
 	os.Getenv("METABASE_PASSWORD"),
+	"",
 )
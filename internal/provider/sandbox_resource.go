@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensures provider defined types fully satisfy framework interfaces.
+var _ resource.ResourceWithImportState = &SandboxResource{}
+
+// Creates a new sandbox resource.
+func NewSandboxResource() resource.Resource {
+	return &SandboxResource{
+		MetabaseBaseResource{name: "sandbox"},
+	}
+}
+
+// A resource handling a sandbox (or group table access policy), restricting the rows of a table a group can access.
+// This is only available in paid versions of Metabase.
+type SandboxResource struct {
+	MetabaseBaseResource
+}
+
+// The Terraform model for a sandbox.
+type SandboxResourceModel struct {
+	Id                  types.Int64 `tfsdk:"id"`                   // The ID of the sandbox.
+	GroupId             types.Int64 `tfsdk:"group_id"`             // The ID of the permissions group to which the sandbox applies.
+	TableId             types.Int64 `tfsdk:"table_id"`             // The ID of the sandboxed table.
+	CardId              types.Int64 `tfsdk:"card_id"`              // The ID of the card used to filter the table.
+	AttributeRemappings types.Map   `tfsdk:"attribute_remappings"` // A map between user attributes and JSON-encoded targets.
+}
+
+func (r *SandboxResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A Metabase sandbox (or group table access policy), restricting the rows of a table that members of a group can see.
+
+Sandboxes are only available in paid versions of Metabase. The table should also be sandboxed for the group in the permissions graph.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the sandbox.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"group_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the permissions group to which the sandbox applies.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"table_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the sandboxed table.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"card_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the card (question) used to filter the table. If not set, the table is filtered using the attribute remappings only.",
+				Optional:            true,
+			},
+			"attribute_remappings": schema.MapAttribute{
+				MarkdownDescription: "A map where keys are user attributes and values are the JSON-encoded targets they filter on, e.g. `jsonencode([\"dimension\", [\"field\", 1, null]])`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// Updates the given `SandboxResourceModel` from the `Sandbox` returned by the Metabase API.
+func updateModelFromSandbox(ctx context.Context, s metabase.Sandbox, data *SandboxResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.Int64Value(int64(s.Id))
+	data.GroupId = types.Int64Value(int64(s.GroupId))
+	data.TableId = types.Int64Value(int64(s.TableId))
+	data.CardId = int64ValueOrNull(s.CardId)
+
+	if s.AttributeRemappings == nil || len(*s.AttributeRemappings) == 0 {
+		// An empty map is kept as is to avoid a diff with the plan.
+		if data.AttributeRemappings.IsNull() || len(data.AttributeRemappings.Elements()) > 0 {
+			data.AttributeRemappings = types.MapNull(types.StringType)
+		}
+		return diags
+	}
+
+	existingRemappings := make(map[string]string, len(data.AttributeRemappings.Elements()))
+	if !data.AttributeRemappings.IsNull() && !data.AttributeRemappings.IsUnknown() {
+		diags.Append(data.AttributeRemappings.ElementsAs(ctx, &existingRemappings, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	remappings := make(map[string]attr.Value, len(*s.AttributeRemappings))
+	for attribute, target := range *s.AttributeRemappings {
+		// The existing JSON string is kept if it is equivalent to the target returned by Metabase, such that formatting
+		// differences do not cause a diff.
+		if existingTargetJson, ok := existingRemappings[attribute]; ok {
+			var existingTarget interface{}
+			err := json.Unmarshal([]byte(existingTargetJson), &existingTarget)
+			if err == nil && reflect.DeepEqual(existingTarget, target) {
+				remappings[attribute] = types.StringValue(existingTargetJson)
+				continue
+			}
+		}
+
+		targetJson, err := json.Marshal(target)
+		if err != nil {
+			diags.AddError("Error serializing attribute remapping target.", err.Error())
+			return diags
+		}
+
+		remappings[attribute] = types.StringValue(string(targetJson))
+	}
+
+	remappingsValue, mapDiags := types.MapValue(types.StringType, remappings)
+	diags.Append(mapDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.AttributeRemappings = remappingsValue
+
+	return diags
+}
+
+// Makes the attribute remappings that can be sent to the Metabase API from the Terraform model.
+func makeAttributeRemappingsFromModel(ctx context.Context, data SandboxResourceModel) (*metabase.SandboxAttributeRemappings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	remappings := make(metabase.SandboxAttributeRemappings)
+	if data.AttributeRemappings.IsNull() {
+		return &remappings, diags
+	}
+
+	var remappingsJson map[string]string
+	diags.Append(data.AttributeRemappings.ElementsAs(ctx, &remappingsJson, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for attribute, targetJson := range remappingsJson {
+		var target interface{}
+		err := json.Unmarshal([]byte(targetJson), &target)
+		if err != nil {
+			diags.AddError("Unable to deserialize attribute remapping target.", err.Error())
+			return nil, diags
+		}
+
+		remappings[attribute] = target
+	}
+
+	return &remappings, diags
+}
+
+func (r *SandboxResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SandboxResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remappings, diags := makeAttributeRemappingsFromModel(ctx, *data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, err := r.client.CreateSandboxWithResponse(ctx, metabase.CreateSandboxBody{
+		GroupId:             int(data.GroupId.ValueInt64()),
+		TableId:             int(data.TableId.ValueInt64()),
+		CardId:              valueInt64OrNull(data.CardId),
+		AttributeRemappings: remappings,
+	})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(createResp, err, []int{200, 402, 404}, "create sandbox")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if metabase.IsPaidFeatureUnavailable(createResp) {
+		resp.Diagnostics.AddError("Sandboxes are not available in this Metabase instance.", "Sandboxes are only available in paid versions of Metabase (Pro or Enterprise).")
+		return
+	}
+
+	// Other 404 responses, e.g. when the group or table does not exist, are reported as unexpected.
+	resp.Diagnostics.Append(checkMetabaseResponse(createResp, nil, []int{200}, "create sandbox")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromSandbox(ctx, *createResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SandboxResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SandboxResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	getResp, err := r.client.GetSandboxWithResponse(ctx, int(data.Id.ValueInt64()))
+
+	status, diags := checkMetabaseResponseStatus(getResp, err, []int{200}, "get sandbox")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if status == metabaseResponseNotFound {
+		removeMissingResourceFromState(ctx, resp, "sandbox", data.Id.ValueInt64(), "not found")
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromSandbox(ctx, *getResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SandboxResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SandboxResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remappings, diags := makeAttributeRemappingsFromModel(ctx, *data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateResp, err := r.client.UpdateSandboxWithResponse(ctx, int(data.Id.ValueInt64()), metabase.UpdateSandboxBody{
+		CardId:              valueInt64OrNull(data.CardId),
+		AttributeRemappings: remappings,
+	})
+
+	resp.Diagnostics.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update sandbox")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(updateModelFromSandbox(ctx, *updateResp.JSON200, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SandboxResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SandboxResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteResp, err := r.client.DeleteSandboxWithResponse(ctx, int(data.Id.ValueInt64()))
+
+	resp.Diagnostics.Append(checkMetabaseResponse(deleteResp, err, []int{204}, "delete sandbox")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SandboxResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStatePassthroughIntegerId(ctx, req, resp)
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUpdateModelFromSandboxKeepsEquivalentRemappings(t *testing.T) {
+	ctx := context.Background()
+
+	var sandbox metabase.Sandbox
+	err := json.Unmarshal([]byte(`{
+		"id": 4,
+		"group_id": 3,
+		"table_id": 2,
+		"card_id": null,
+		"attribute_remappings": {
+			"user_id": ["dimension", ["field", 12, null]],
+			"region": ["variable", ["template-tag", "region"]]
+		}
+	}`), &sandbox)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	existingUserId := `[ "dimension", [ "field", 12, null ] ]`
+	data := SandboxResourceModel{
+		AttributeRemappings: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"user_id": types.StringValue(existingUserId),
+			"region":  types.StringValue(`["variable", ["template-tag", "country"]]`),
+		}),
+	}
+
+	diags := updateModelFromSandbox(ctx, sandbox, &data)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if data.Id.ValueInt64() != 4 || data.GroupId.ValueInt64() != 3 || data.TableId.ValueInt64() != 2 || !data.CardId.IsNull() {
+		t.Errorf("Unexpected attributes for the sandbox: %s, %s, %s, %s.", data.Id, data.GroupId, data.TableId, data.CardId)
+	}
+
+	remappings := data.AttributeRemappings.Elements()
+	if remappings["user_id"].(types.String).ValueString() != existingUserId {
+		t.Errorf("Expected the equivalent remapping to be kept as is, got %s.", remappings["user_id"])
+	}
+	if remappings["region"].(types.String).ValueString() != `["variable",["template-tag","region"]]` {
+		t.Errorf("Expected the changed remapping to be updated, got %s.", remappings["region"])
+	}
+}
+
+func TestUpdateModelFromSandboxWithoutRemappings(t *testing.T) {
+	ctx := context.Background()
+
+	for _, remappingsJson := range []string{`null`, `{}`} {
+		var sandbox metabase.Sandbox
+		err := json.Unmarshal([]byte(`{"id": 4, "group_id": 3, "table_id": 2, "attribute_remappings": `+remappingsJson+`}`), &sandbox)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data := SandboxResourceModel{AttributeRemappings: types.MapNull(types.StringType)}
+		diags := updateModelFromSandbox(ctx, sandbox, &data)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+		if !data.AttributeRemappings.IsNull() {
+			t.Errorf("Expected null remappings for %s, got %s.", remappingsJson, data.AttributeRemappings)
+		}
+
+		// An empty map in the configuration is kept to avoid a diff with the plan.
+		data = SandboxResourceModel{AttributeRemappings: types.MapValueMust(types.StringType, map[string]attr.Value{})}
+		diags = updateModelFromSandbox(ctx, sandbox, &data)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+		if data.AttributeRemappings.IsNull() || len(data.AttributeRemappings.Elements()) != 0 {
+			t.Errorf("Expected empty remappings for %s, got %s.", remappingsJson, data.AttributeRemappings)
+		}
+	}
+}
+
+func TestMakeAttributeRemappingsFromModel(t *testing.T) {
+	ctx := context.Background()
+
+	remappings, diags := makeAttributeRemappingsFromModel(ctx, SandboxResourceModel{
+		AttributeRemappings: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"user_id": types.StringValue(`["dimension", ["field", 12, null]]`),
+		}),
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if len(*remappings) != 1 {
+		t.Errorf("Expected one remapping, got %v.", *remappings)
+	}
+
+	_, diags = makeAttributeRemappingsFromModel(ctx, SandboxResourceModel{
+		AttributeRemappings: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"user_id": types.StringValue(`["dimension", `),
+		}),
+	})
+	if !diags.HasError() {
+		t.Errorf("Expected an error for an invalid JSON target.")
+	}
+}
+
+func TestCreateSandboxWhenUnavailable(t *testing.T) {
+	testCases := []struct {
+		status   int
+		body     string
+		expected string
+	}{
+		{402, `Sandboxes are a paid feature not currently available to your instance.`, "Sandboxes are not available in this Metabase instance."},
+		{404, `API endpoint does not exist.`, "Sandboxes are not available in this Metabase instance."},
+		{404, `Not found.`, "Unexpected response while calling the Metabase API for operation 'create sandbox'."},
+	}
+
+	for _, tc := range testCases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tc.status)
+			w.Write([]byte(tc.body))
+		}))
+
+		client, err := metabase.NewClientWithResponses(server.URL)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		ctx := context.Background()
+		r := &SandboxResource{MetabaseBaseResource{client: client}}
+		state := makeTestResourceState(t, r, map[string]tftypes.Value{
+			"group_id": tftypes.NewValue(tftypes.Number, 3),
+			"table_id": tftypes.NewValue(tftypes.Number, 2),
+		})
+
+		resp := resource.CreateResponse{State: tfsdk.State{Schema: state.Schema}}
+		r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}}, &resp)
+		server.Close()
+
+		if resp.Diagnostics.ErrorsCount() != 1 {
+			t.Fatalf("Expected one error for status %d, got: %v.", tc.status, resp.Diagnostics)
+		}
+		if resp.Diagnostics[0].Summary() != tc.expected && resp.Diagnostics[0].Detail() != tc.expected {
+			t.Errorf("Expected error %q for status %d, got: %v.", tc.expected, tc.status, resp.Diagnostics)
+		}
+	}
+}
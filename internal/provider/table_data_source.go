@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -12,7 +13,7 @@ import (
 )
 
 // Ensures provider defined types fully satisfy framework interfaces.
-var _ datasource.DataSource = &TableDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &TableDataSource{}
 
 // Creates a new table data source.
 func NewTableDataSource() datasource.DataSource {
@@ -30,14 +31,16 @@ type TableDataSource struct {
 
 // The Terraform model for a table.
 type TableDataSourceModel struct {
-	Id          types.Int64  `tfsdk:"id"`           // The ID of the table.
-	DbId        types.Int64  `tfsdk:"db_id"`        // The ID of the parent database.
-	Name        types.String `tfsdk:"name"`         // The name of the table.
-	EntityType  types.String `tfsdk:"entity_type"`  // The type of table.
-	Schema      types.String `tfsdk:"schema"`       // The database schema in which the table is located. For BigQuery, this is the dataset name.
-	DisplayName types.String `tfsdk:"display_name"` // The name displayed in the interface for the table.
-	Description types.String `tfsdk:"description"`  // A description for the table.
-	Fields      types.Map    `tfsdk:"fields"`       // A map where keys are field (column) names and values are the corresponding Metabase integer IDs.
+	Id           types.Int64  `tfsdk:"id"`            // The ID of the table.
+	DbId         types.Int64  `tfsdk:"db_id"`         // The ID of the parent database.
+	Name         types.String `tfsdk:"name"`          // The name of the table.
+	EntityType   types.String `tfsdk:"entity_type"`   // The type of table.
+	Schema       types.String `tfsdk:"schema"`        // The database schema in which the table is located. For BigQuery, this is the dataset name.
+	DisplayName  types.String `tfsdk:"display_name"`  // The name displayed in the interface for the table.
+	Description  types.String `tfsdk:"description"`   // A description for the table.
+	Fields       types.Map    `tfsdk:"fields"`        // A map where keys are field (column) names and values are the corresponding Metabase integer IDs.
+	FieldDetails types.Map    `tfsdk:"field_details"` // A map where keys are field (column) names and values are details about the field, including types.
+	ForeignKeys  types.List   `tfsdk:"foreign_keys"`  // The foreign keys in other tables referencing fields of this table.
 }
 
 func (d *TableDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -86,10 +89,66 @@ Metabase also assigns an ID to each field (column) in the table. Those are also
 				ElementType:         types.Int64Type,
 				Computed:            true,
 			},
+			"field_details": schema.MapNestedAttribute{
+				MarkdownDescription: "A map where keys are field (column) names and values are details about each field. This is useful to build queries requiring the type of fields.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The Metabase ID of the field.",
+							Computed:            true,
+						},
+						"base_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the field in the database, as understood by Metabase, e.g. `type/Text`.",
+							Computed:            true,
+						},
+						"effective_type": schema.StringAttribute{
+							MarkdownDescription: "The type used by Metabase for the field, which can differ from the base type if a coercion strategy is set.",
+							Computed:            true,
+						},
+						"semantic_type": schema.StringAttribute{
+							MarkdownDescription: "The semantic type of the field, e.g. `type/PK`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"foreign_keys": schema.ListNestedAttribute{
+				MarkdownDescription: "The foreign keys in other tables referencing fields of this table, sorted by origin table and field.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{
+							MarkdownDescription: "The name of the referenced field, in this table.",
+							Computed:            true,
+						},
+						"origin_table_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the table containing the foreign key.",
+							Computed:            true,
+						},
+						"origin_field": schema.StringAttribute{
+							MarkdownDescription: "The name of the foreign key field, in the origin table.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func (d *TableDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	// The table is either looked up using its ID, or searched using the other attributes.
+	validators := []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(makeTableLookupAttributes()...),
+	}
+	for _, conflict := range makeTableConflictingAttributes() {
+		validators = append(validators, datasourcevalidator.Conflicting(conflict...))
+	}
+
+	return validators
+}
+
 func (d *TableDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -128,6 +187,13 @@ func updateModelFromTableMetadata(t metabase.TableMetadata, data *TableDataSourc
 	}
 	data.Fields = *fieldsValue
 
+	fieldDetailsValue, fieldDetailsDiags := makeTableFieldDetailsValue(t)
+	diags.Append(fieldDetailsDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	data.FieldDetails = *fieldDetailsValue
+
 	return diags
 }
 
@@ -156,5 +222,12 @@ func (d *TableDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	foreignKeys, diags := getTableForeignKeysValue(ctx, d.client, data.Id.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ForeignKeys = *foreignKeys
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -2,22 +2,36 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
 
 	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	fieldDimensionTypeExternal = "external" // Displays the values of another field, e.g. the name of a foreign key target.
+	fieldDimensionTypeInternal = "internal" // Displays custom values configured in Metabase.
 )
 
 // Ensures provider defined types fully satisfy framework interfaces.
 var _ resource.ResourceWithImportState = &TableResource{}
+var _ resource.ResourceWithConfigValidators = &TableResource{}
 
 // Creates a new table resource.
 func NewTableResource() resource.Resource {
@@ -40,8 +54,45 @@ type TableResourceModel struct {
 	Schema           types.String `tfsdk:"schema"`             // The database schema in which the table is located. For BigQuery, this is the dataset name.
 	DisplayName      types.String `tfsdk:"display_name"`       // The name displayed in the interface for the table.
 	Description      types.String `tfsdk:"description"`        // A description for the table.
+	Caveats          types.String `tfsdk:"caveats"`            // Things to be aware of about the table.
+	PointsOfInterest types.String `tfsdk:"points_of_interest"` // What is useful or interesting about the table.
 	Fields           types.Map    `tfsdk:"fields"`             // A map where keys are field (column) names and values are the corresponding Metabase integer IDs.
 	ForcedFieldTypes types.Map    `tfsdk:"forced_field_types"` // A map where keys are field (column) names and values are Metabase semantic types. Not all fields have to be specified.
+	FieldFormatting  types.Map    `tfsdk:"field_formatting"`   // A map where keys are field (column) names and values are formatting settings, as JSON strings. Not all fields have to be specified.
+	FieldDimensions  types.Map    `tfsdk:"field_dimensions"`   // A map where keys are field (column) names and values define how the field values are remapped.
+	FieldValueLabels types.Map    `tfsdk:"field_value_labels"` // A map where keys are field (column) names and values map raw values to their displayed labels.
+	FieldDocs        types.Map    `tfsdk:"field_docs"`         // A map where keys are field (column) names and values are the caveats and points of interest of the field.
+	ForeignKeys      types.List   `tfsdk:"foreign_keys"`       // The foreign keys in other tables referencing fields of this table.
+}
+
+// The Terraform model for the dimension of a field, defining how its values are remapped when displayed.
+type FieldDimensionModel struct {
+	Type                 types.String `tfsdk:"type"`                    // The type of remapping, either `external` or `internal`.
+	Name                 types.String `tfsdk:"name"`                    // The name displayed for the remapped field.
+	HumanReadableFieldId types.Int64  `tfsdk:"human_readable_field_id"` // For `external` dimensions, the ID of the field displayed instead.
+}
+
+// The Terraform model for the documentation of a field, displayed in the data reference.
+type FieldDocsModel struct {
+	Caveats          types.String `tfsdk:"caveats"`            // Things to be aware of about the field.
+	PointsOfInterest types.String `tfsdk:"points_of_interest"` // What is useful or interesting about the field.
+}
+
+// The object type for the documentation of a field.
+var fieldDocsObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"caveats":            types.StringType,
+		"points_of_interest": types.StringType,
+	},
+}
+
+// The object type for the dimension of a field.
+var fieldDimensionObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type":                    types.StringType,
+		"name":                    types.StringType,
+		"human_readable_field_id": types.Int64Type,
+	},
 }
 
 func (r *TableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -54,9 +105,9 @@ Instead of being created, the table will be looked up based on its id or a combi
 
 Like its data source counterpart, this resource exposes the ID of the fields (columns) in the table.
 
-The display name and the description of the table can be set. If not specified, the remote values are available instead.
+The display name, the description, the caveats, and the points of interest of the table can be set. If not specified, the remote values are available instead.
 
-Finally, this resource may define the semantic type for all or a subset of the fields (columns) using the forced_field_types attribute. Only the fields in the map will be updated, all other fields are left as is.`,
+Finally, this resource may define the semantic type for all or a subset of the fields (columns) using the forced_field_types attribute. Only the fields in the map will be updated, all other fields are left as is. The display formatting of fields (e.g. number formatting, currency, or date styles) can be set in the same way using the field_formatting attribute. Similarly, the field_dimensions attribute remaps the values of fields when they are displayed, e.g. to show the name of a customer instead of its ID. Custom labels for the raw values of fields (e.g. 0 -> Inactive) can be set using the field_value_labels attribute.`,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
@@ -116,6 +167,18 @@ Finally, this resource may define the semantic type for all or a subset of the f
 				Computed:            true,
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
+			"caveats": schema.StringAttribute{
+				MarkdownDescription: "Things to be aware of about the table, displayed in the data reference.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"points_of_interest": schema.StringAttribute{
+				MarkdownDescription: "What is useful or interesting about the table, displayed in the data reference.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
 			"fields": schema.MapAttribute{
 				MarkdownDescription: "A map where keys are field (column) names and values are their Metabase ID.",
 				ElementType:         types.Int64Type,
@@ -127,10 +190,92 @@ Finally, this resource may define the semantic type for all or a subset of the f
 				ElementType:         types.StringType,
 				Optional:            true,
 			},
+			"field_formatting": schema.MapAttribute{
+				MarkdownDescription: "A map where keys are field (column) names and values are formatting settings as JSON strings, e.g. `number_style`, `currency`, or `date_style`. The settings are merged into the existing settings of the field, and only the listed fields and settings are managed.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"field_dimensions": schema.MapNestedAttribute{
+				MarkdownDescription: "A map where keys are field (column) names and values define how the values of the field are remapped when displayed. Only the listed fields are managed, and the remapping of a field is removed when it is removed from the map.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of remapping. `external` displays the values of another field (usually referenced by a foreign key), while `internal` uses custom values configured in Metabase.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(fieldDimensionTypeExternal, fieldDimensionTypeInternal),
+							},
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name displayed for the remapped field.",
+							Required:            true,
+						},
+						"human_readable_field_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the field whose values are displayed instead. Required for `external` dimensions.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"field_value_labels": schema.MapAttribute{
+				MarkdownDescription: "A map where keys are field (column) names and values map raw values of the field to the labels displayed in Metabase, e.g. `{ \"0\" = \"Inactive\" }`. For the labels to be displayed, the field should also have an `internal` dimension in `field_dimensions`. Only the listed fields and raw values are managed, and labels removed from the map are left as is.",
+				ElementType:         types.MapType{ElemType: types.StringType},
+				Optional:            true,
+			},
+			"field_docs": schema.MapNestedAttribute{
+				MarkdownDescription: "A map where keys are field (column) names and values are the caveats and points of interest of the field, displayed in the data reference. Only the listed fields and attributes are managed, and values removed from the map are left as is.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"caveats": schema.StringAttribute{
+							MarkdownDescription: "Things to be aware of about the field.",
+							Optional:            true,
+						},
+						"points_of_interest": schema.StringAttribute{
+							MarkdownDescription: "What is useful or interesting about the field.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"foreign_keys": schema.ListNestedAttribute{
+				MarkdownDescription: "The foreign keys in other tables referencing fields of this table, sorted by origin table and field. This is read-only metadata describing the relationships between tables.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{
+							MarkdownDescription: "The name of the referenced field, in this table.",
+							Computed:            true,
+						},
+						"origin_table_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the table containing the foreign key.",
+							Computed:            true,
+						},
+						"origin_field": schema.StringAttribute{
+							MarkdownDescription: "The name of the foreign key field, in the origin table.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func (r *TableResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	// The table is either looked up using its ID, or searched using the other attributes.
+	validators := []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(makeTableLookupAttributes()...),
+	}
+	for _, conflict := range makeTableConflictingAttributes() {
+		validators = append(validators, resourcevalidator.Conflicting(conflict...))
+	}
+
+	return validators
+}
+
 // Updates the given `TableResourceModel` from the `Table` returned by the Metabase API.
 func updateModelFromTable(t metabase.TableMetadata, data *TableResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -142,6 +287,8 @@ func updateModelFromTable(t metabase.TableMetadata, data *TableResourceModel) di
 	data.Schema = stringValueOrNull(t.Schema)
 	data.DisplayName = types.StringValue(t.DisplayName)
 	data.Description = stringValueOrNull(t.Description)
+	data.Caveats = stringValueOrNull(t.Caveats)
+	data.PointsOfInterest = stringValueOrNull(t.PointsOfInterest)
 
 	fieldsValue, fieldsDiags := makeTableFieldsValue(t)
 	diags.Append(fieldsDiags...)
@@ -154,16 +301,9 @@ func updateModelFromTable(t metabase.TableMetadata, data *TableResourceModel) di
 		// Only the semantic types for the fields referenced in the model before populating it are set.
 		forcedFieldTypes := make(map[string]attr.Value, len(data.ForcedFieldTypes.Elements()))
 		for fieldName := range data.ForcedFieldTypes.Elements() {
-			var field *metabase.Field
-			for _, f := range t.Fields {
-				if f.Name == fieldName {
-					field = &f
-					break
-				}
-			}
-
-			if field == nil {
-				diags.AddError("Unable to find field in table definition.", fmt.Sprintf("Field name: %s", fieldName))
+			field, fieldDiags := findFieldByName(t.Fields, fieldName)
+			diags.Append(fieldDiags...)
+			if diags.HasError() {
 				return diags
 			}
 
@@ -178,9 +318,177 @@ func updateModelFromTable(t metabase.TableMetadata, data *TableResourceModel) di
 		data.ForcedFieldTypes = forcedFieldTypesValue
 	}
 
+	if !data.FieldFormatting.IsNull() {
+		// Similarly to semantic types, only the formatting of the fields referenced in the model is set.
+		fieldFormatting := make(map[string]attr.Value, len(data.FieldFormatting.Elements()))
+		for fieldName, formattingValue := range data.FieldFormatting.Elements() {
+			field, fieldDiags := findFieldByName(t.Fields, fieldName)
+			diags.Append(fieldDiags...)
+			if diags.HasError() {
+				return diags
+			}
+
+			formatting, formattingDiags := makeFieldFormattingFromSettings(formattingValue.(types.String), field.Settings)
+			diags.Append(formattingDiags...)
+			if diags.HasError() {
+				return diags
+			}
+
+			fieldFormatting[fieldName] = formatting
+		}
+
+		fieldFormattingValue, fieldFormattingDiags := types.MapValue(types.StringType, fieldFormatting)
+		diags.Append(fieldFormattingDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		data.FieldFormatting = fieldFormattingValue
+	}
+
+	if !data.FieldDimensions.IsNull() {
+		// Only the dimensions of the fields referenced in the model are set.
+		fieldDimensions := make(map[string]attr.Value, len(data.FieldDimensions.Elements()))
+		for fieldName := range data.FieldDimensions.Elements() {
+			field, fieldDiags := findFieldByName(t.Fields, fieldName)
+			diags.Append(fieldDiags...)
+			if diags.HasError() {
+				return diags
+			}
+
+			dimension, dimensionDiags := makeFieldDimensionValue(*field)
+			diags.Append(dimensionDiags...)
+			if diags.HasError() {
+				return diags
+			}
+
+			fieldDimensions[fieldName] = dimension
+		}
+
+		fieldDimensionsValue, fieldDimensionsDiags := types.MapValue(fieldDimensionObjectType, fieldDimensions)
+		diags.Append(fieldDimensionsDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		data.FieldDimensions = fieldDimensionsValue
+	}
+
+	if !data.FieldDocs.IsNull() {
+		// Only the documentation of the fields referenced in the model is set.
+		fieldDocs := make(map[string]attr.Value, len(data.FieldDocs.Elements()))
+		for fieldName, docsValue := range data.FieldDocs.Elements() {
+			field, fieldDiags := findFieldByName(t.Fields, fieldName)
+			diags.Append(fieldDiags...)
+			if diags.HasError() {
+				return diags
+			}
+
+			docs, docsDiags := makeFieldDocsValue(docsValue.(types.Object), *field)
+			diags.Append(docsDiags...)
+			if diags.HasError() {
+				return diags
+			}
+
+			fieldDocs[fieldName] = docs
+		}
+
+		fieldDocsValue, fieldDocsDiags := types.MapValue(fieldDocsObjectType, fieldDocs)
+		diags.Append(fieldDocsDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		data.FieldDocs = fieldDocsValue
+	}
+
 	return diags
 }
 
+// Finds the field with the given name in the list of fields of a table.
+func findFieldByName(fields []metabase.Field, name string) (*metabase.Field, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for i := range fields {
+		if fields[i].Name == name {
+			return &fields[i], diags
+		}
+	}
+
+	diags.AddError("Unable to find field in table definition.", fmt.Sprintf("Field name: %s", name))
+	return nil, diags
+}
+
+// Makes the Terraform object describing the documentation of a field. Only the attributes set in the `existing` object
+// are populated, such that documentation which is not managed by Terraform does not cause a diff.
+func makeFieldDocsValue(existing types.Object, f metabase.Field) (types.Object, diag.Diagnostics) {
+	existingAttributes := existing.Attributes()
+
+	caveats := types.StringNull()
+	if value, ok := existingAttributes["caveats"]; ok && !value.IsNull() {
+		caveats = stringValueOrNull(f.Caveats)
+	}
+
+	pointsOfInterest := types.StringNull()
+	if value, ok := existingAttributes["points_of_interest"]; ok && !value.IsNull() {
+		pointsOfInterest = stringValueOrNull(f.PointsOfInterest)
+	}
+
+	return types.ObjectValue(fieldDocsObjectType.AttrTypes, map[string]attr.Value{
+		"caveats":            caveats,
+		"points_of_interest": pointsOfInterest,
+	})
+}
+
+// Makes the Terraform object describing the dimension of a field. A null object is returned if the field has no
+// dimension.
+func makeFieldDimensionValue(f metabase.Field) (types.Object, diag.Diagnostics) {
+	if f.Dimensions == nil || len(*f.Dimensions) == 0 {
+		return types.ObjectNull(fieldDimensionObjectType.AttrTypes), nil
+	}
+
+	// Metabase supports a single dimension per field.
+	d := (*f.Dimensions)[0]
+	return types.ObjectValue(fieldDimensionObjectType.AttrTypes, map[string]attr.Value{
+		"type":                    types.StringValue(d.Type),
+		"name":                    types.StringValue(d.Name),
+		"human_readable_field_id": int64ValueOrNull(d.HumanReadableFieldId),
+	})
+}
+
+// Makes the formatting JSON for a field from its settings returned by the Metabase API.
+// Only the settings present in the `existing` JSON are kept. If they are equal to the existing ones, the existing value
+// is returned as is to avoid diffs caused by JSON formatting.
+func makeFieldFormattingFromSettings(existing types.String, settings *map[string]interface{}) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var existingFormatting map[string]interface{}
+	err := json.Unmarshal([]byte(existing.ValueString()), &existingFormatting)
+	if err != nil {
+		diags.AddError("Unable to parse field formatting JSON.", err.Error())
+		return types.StringNull(), diags
+	}
+
+	formatting := make(map[string]interface{}, len(existingFormatting))
+	if settings != nil {
+		for key := range existingFormatting {
+			value, ok := (*settings)[key]
+			if ok {
+				formatting[key] = value
+			}
+		}
+	}
+
+	if reflect.DeepEqual(formatting, existingFormatting) {
+		return existing, diags
+	}
+
+	formattingJson, err := json.Marshal(formatting)
+	if err != nil {
+		diags.AddError("Unable to serialize field formatting JSON.", err.Error())
+		return types.StringNull(), diags
+	}
+
+	return types.StringValue(string(formattingJson)), diags
+}
+
 func (r *TableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Although this gets information from the plan, it will be updated with the response from the Metabase API when the
 	// table if found. This will describe the current state of the table, from which an update can be made if needed.
@@ -211,7 +519,13 @@ func (r *TableResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Keeping a copy of attributes that might have been specified by the user.
 	displayName := plan.DisplayName
 	description := plan.Description
+	caveats := plan.Caveats
+	pointsOfInterest := plan.PointsOfInterest
 	forcedFieldTypes := plan.ForcedFieldTypes
+	fieldFormatting := plan.FieldFormatting
+	fieldDimensions := plan.FieldDimensions
+	fieldValueLabels := plan.FieldValueLabels
+	fieldDocs := plan.FieldDocs
 
 	resp.Diagnostics.Append(updateModelFromTable(*table, state)...)
 	if resp.Diagnostics.HasError() {
@@ -231,8 +545,24 @@ func (r *TableResource) Create(ctx context.Context, req resource.CreateRequest,
 	if !description.IsUnknown() {
 		plan.Description = description
 	}
+	if !caveats.IsUnknown() {
+		plan.Caveats = caveats
+	}
+	if !pointsOfInterest.IsUnknown() {
+		plan.PointsOfInterest = pointsOfInterest
+	}
 	// This is not a computed field, no need to check for an unknown value.
 	plan.ForcedFieldTypes = forcedFieldTypes
+	plan.FieldFormatting = fieldFormatting
+	plan.FieldDimensions = fieldDimensions
+	plan.FieldValueLabels = fieldValueLabels
+	plan.FieldDocs = fieldDocs
+
+	// The labels cannot be read from the table metadata, and are fetched for each field.
+	resp.Diagnostics.Append(readFieldValueLabels(ctx, r.client, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Now that the table has been "imported" into `state` and the `plan` contains the expected values, a regular update
 	// can be performed.
@@ -241,6 +571,13 @@ func (r *TableResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	foreignKeys, diags := getTableForeignKeysValue(ctx, r.client, plan.Id.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ForeignKeys = *foreignKeys
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -257,13 +594,14 @@ func (r *TableResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		IncludeHiddenFields: &includeHiddenFields,
 	})
 
-	resp.Diagnostics.Append(checkMetabaseResponse(getResp, err, []int{200, 404}, "get table metadata")...)
+	status, diags := checkMetabaseResponseStatus(getResp, err, []int{200}, "get table metadata")
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if getResp.StatusCode() == 404 {
-		resp.State.RemoveResource(ctx)
+	if status == metabaseResponseNotFound {
+		removeMissingResourceFromState(ctx, resp, "table", data.Id.ValueInt64(), "not found")
 		return
 	}
 
@@ -272,6 +610,18 @@ func (r *TableResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	resp.Diagnostics.Append(readFieldValueLabels(ctx, r.client, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	foreignKeys, diags := getTableForeignKeysValue(ctx, r.client, data.Id.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ForeignKeys = *foreignKeys
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -311,15 +661,341 @@ func (r *TableResource) updateForcedFieldTypes(ctx context.Context, plan TableRe
 	return diags
 }
 
+// Merges the formatting settings into the existing settings of the fields in the table.
+// The field is fetched first, such that its other attributes are sent back unchanged.
+func (r *TableResource) updateFieldFormatting(ctx context.Context, plan TableResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var fieldFormatting map[string]string
+	diags.Append(plan.FieldFormatting.ElementsAs(ctx, &fieldFormatting, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var fields map[string]int64
+	diags.Append(plan.Fields.ElementsAs(ctx, &fields, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for fieldName, formattingJson := range fieldFormatting {
+		fieldId, ok := fields[fieldName]
+		if !ok {
+			diags.AddError("Unable to find the ID of the field to update.", fmt.Sprintf("Field name: %s", fieldName))
+			return diags
+		}
+
+		var formatting map[string]interface{}
+		err := json.Unmarshal([]byte(formattingJson), &formatting)
+		if err != nil {
+			diags.AddError("Unable to parse field formatting JSON.", fmt.Sprintf("Field name: %s, error: %s", fieldName, err.Error()))
+			return diags
+		}
+
+		getResp, err := r.client.GetFieldWithResponse(ctx, int(fieldId))
+
+		diags.Append(checkMetabaseResponse(getResp, err, []int{200}, "get field")...)
+		if diags.HasError() {
+			return diags
+		}
+
+		settings := make(map[string]interface{}, len(formatting))
+		if getResp.JSON200.Settings != nil {
+			for key, value := range *getResp.JSON200.Settings {
+				settings[key] = value
+			}
+		}
+		for key, value := range formatting {
+			settings[key] = value
+		}
+
+		updateResp, err := r.client.UpdateFieldWithResponse(ctx, int(fieldId), metabase.UpdateFieldBody{
+			SemanticType: getResp.JSON200.SemanticType,
+			Description:  getResp.JSON200.Description,
+			Settings:     &settings,
+		})
+
+		diags.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update field")...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// Lists the fields for which the dimension should be set or removed, such that the dimensions in the `state` match the
+// ones in the `plan`. Fields which are no longer listed in the plan have their dimension removed.
+func makeFieldDimensionChanges(ctx context.Context, state types.Map, plan types.Map) (map[string]FieldDimensionModel, []string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	dimensionsToSet := make(map[string]FieldDimensionModel)
+	fieldsToRemove := []string{}
+
+	planElements := plan.Elements()
+	for fieldName, planValue := range planElements {
+		stateValue, ok := state.Elements()[fieldName]
+		if ok && stateValue.Equal(planValue) {
+			continue
+		}
+
+		if planValue.IsNull() {
+			fieldsToRemove = append(fieldsToRemove, fieldName)
+			continue
+		}
+
+		var dimension FieldDimensionModel
+		diags.Append(planValue.(types.Object).As(ctx, &dimension, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+
+		dimensionsToSet[fieldName] = dimension
+	}
+
+	for fieldName, stateValue := range state.Elements() {
+		if _, ok := planElements[fieldName]; !ok && !stateValue.IsNull() {
+			fieldsToRemove = append(fieldsToRemove, fieldName)
+		}
+	}
+	sort.Strings(fieldsToRemove)
+
+	return dimensionsToSet, fieldsToRemove, diags
+}
+
+// Sets or removes the dimensions of fields in the table, such that they match the plan.
+func (r *TableResource) updateFieldDimensions(ctx context.Context, state TableResourceModel, plan TableResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	dimensionsToSet, fieldsToRemove, changesDiags := makeFieldDimensionChanges(ctx, state.FieldDimensions, plan.FieldDimensions)
+	diags.Append(changesDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var fields map[string]int64
+	diags.Append(plan.Fields.ElementsAs(ctx, &fields, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for fieldName, dimension := range dimensionsToSet {
+		fieldId, ok := fields[fieldName]
+		if !ok {
+			diags.AddError("Unable to find the ID of the field to update.", fmt.Sprintf("Field name: %s", fieldName))
+			return diags
+		}
+
+		createResp, err := r.client.CreateFieldDimensionWithResponse(ctx, int(fieldId), metabase.CreateFieldDimensionBody{
+			Type:                 dimension.Type.ValueString(),
+			Name:                 dimension.Name.ValueString(),
+			HumanReadableFieldId: valueInt64OrNull(dimension.HumanReadableFieldId),
+		})
+
+		diags.Append(checkMetabaseResponse(createResp, err, []int{200}, "create field dimension")...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	for _, fieldName := range fieldsToRemove {
+		fieldId, ok := fields[fieldName]
+		if !ok {
+			// The field no longer exists, hence there is no dimension to remove.
+			continue
+		}
+
+		deleteResp, err := r.client.DeleteFieldDimensionWithResponse(ctx, int(fieldId))
+
+		diags.Append(checkMetabaseResponse(deleteResp, err, []int{204}, "delete field dimension")...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// Fetches the values of a field from the Metabase API.
+func getFieldValues(ctx context.Context, client metabase.ClientWithResponsesInterface, fieldId int) ([][]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	getResp, err := client.GetFieldValuesWithResponse(ctx, fieldId)
+
+	diags.Append(checkMetabaseResponse(getResp, err, []int{200}, "get field values")...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return getResp.JSON200.Values, diags
+}
+
+// Replaces the labels in the model by the ones returned by the Metabase API. Similarly to other field settings, only
+// the fields and raw values referenced in the model are read.
+func readFieldValueLabels(ctx context.Context, client metabase.ClientWithResponsesInterface, data *TableResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.FieldValueLabels.IsNull() || data.FieldValueLabels.IsUnknown() {
+		return diags
+	}
+
+	var fields map[string]int64
+	diags.Append(data.Fields.ElementsAs(ctx, &fields, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var fieldValueLabels map[string]map[string]string
+	diags.Append(data.FieldValueLabels.ElementsAs(ctx, &fieldValueLabels, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for fieldName, labels := range fieldValueLabels {
+		fieldId, ok := fields[fieldName]
+		if !ok {
+			diags.AddError("Unable to find field in table definition.", fmt.Sprintf("Field name: %s", fieldName))
+			return diags
+		}
+
+		values, valuesDiags := getFieldValues(ctx, client, int(fieldId))
+		diags.Append(valuesDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		keys := make([]string, 0, len(labels))
+		for k := range labels {
+			keys = append(keys, k)
+		}
+
+		fieldValueLabels[fieldName] = makeFieldValueLabels(values, keys)
+	}
+
+	fieldValueLabelsValue, mapDiags := types.MapValueFrom(ctx, types.MapType{ElemType: types.StringType}, fieldValueLabels)
+	diags.Append(mapDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	data.FieldValueLabels = fieldValueLabelsValue
+
+	return diags
+}
+
+// Sets the labels of the raw values of fields, for the fields which labels differ between the `state` and the `plan`.
+func (r *TableResource) updateFieldValueLabels(ctx context.Context, state TableResourceModel, plan TableResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var fields map[string]int64
+	diags.Append(plan.Fields.ElementsAs(ctx, &fields, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	stateElements := state.FieldValueLabels.Elements()
+	for fieldName, labelsValue := range plan.FieldValueLabels.Elements() {
+		stateLabels, ok := stateElements[fieldName]
+		if ok && stateLabels.Equal(labelsValue) {
+			continue
+		}
+
+		fieldId, ok := fields[fieldName]
+		if !ok {
+			diags.AddError("Unable to find the ID of the field to update.", fmt.Sprintf("Field name: %s", fieldName))
+			return diags
+		}
+
+		var labels map[string]string
+		diags.Append(labelsValue.(types.Map).ElementsAs(ctx, &labels, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		existingValues, valuesDiags := getFieldValues(ctx, r.client, int(fieldId))
+		diags.Append(valuesDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		updateResp, err := r.client.UpdateFieldValuesWithResponse(ctx, int(fieldId), metabase.UpdateFieldValuesBody{
+			Values: makeFieldValuesWithLabels(existingValues, labels),
+		})
+
+		diags.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update field values")...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// Sets the caveats and points of interest of the fields listed in the plan, when they differ from the state.
+// The field is fetched first, such that its semantic type and description are sent back unchanged. Attributes which are
+// not set in the plan are not sent, and are left as is.
+func (r *TableResource) updateFieldDocs(ctx context.Context, state TableResourceModel, plan TableResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var fields map[string]int64
+	diags.Append(plan.Fields.ElementsAs(ctx, &fields, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	stateElements := state.FieldDocs.Elements()
+	for fieldName, docsValue := range plan.FieldDocs.Elements() {
+		if stateValue, ok := stateElements[fieldName]; ok && stateValue.Equal(docsValue) {
+			continue
+		}
+
+		var docs FieldDocsModel
+		diags.Append(docsValue.(types.Object).As(ctx, &docs, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return diags
+		}
+
+		fieldId, ok := fields[fieldName]
+		if !ok {
+			diags.AddError("Unable to find the ID of the field to update.", fmt.Sprintf("Field name: %s", fieldName))
+			return diags
+		}
+
+		getResp, err := r.client.GetFieldWithResponse(ctx, int(fieldId))
+
+		diags.Append(checkMetabaseResponse(getResp, err, []int{200}, "get field")...)
+		if diags.HasError() {
+			return diags
+		}
+
+		updateResp, err := r.client.UpdateFieldWithResponse(ctx, int(fieldId), metabase.UpdateFieldBody{
+			SemanticType:     getResp.JSON200.SemanticType,
+			Description:      getResp.JSON200.Description,
+			Caveats:          valueStringOrNull(docs.Caveats),
+			PointsOfInterest: valueStringOrNull(docs.PointsOfInterest),
+		})
+
+		diags.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update field")...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
 // Compares the given `state` and `plan`, and update the table and its fields where necessary.
 func (r *TableResource) updateTableIfNeeded(ctx context.Context, state TableResourceModel, plan *TableResourceModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if !state.DisplayName.Equal(plan.DisplayName) ||
-		!state.Description.Equal(plan.Description) {
+		!state.Description.Equal(plan.Description) ||
+		!state.Caveats.Equal(plan.Caveats) ||
+		!state.PointsOfInterest.Equal(plan.PointsOfInterest) {
 		updateResp, err := r.client.UpdateTableWithResponse(ctx, int(plan.Id.ValueInt64()), metabase.UpdateTableBody{
-			DisplayName: valueStringOrNull(plan.DisplayName),
-			Description: valueStringOrNull(plan.Description),
+			DisplayName:      valueStringOrNull(plan.DisplayName),
+			Description:      valueStringOrNull(plan.Description),
+			Caveats:          valueStringOrNull(plan.Caveats),
+			PointsOfInterest: valueStringOrNull(plan.PointsOfInterest),
 		})
 
 		diags.Append(checkMetabaseResponse(updateResp, err, []int{200}, "update table")...)
@@ -335,6 +1011,35 @@ func (r *TableResource) updateTableIfNeeded(ctx context.Context, state TableReso
 		}
 	}
 
+	// This is performed after updating semantic types, such that the new ones are sent back to Metabase.
+	if !state.FieldFormatting.Equal(plan.FieldFormatting) {
+		diags.Append(r.updateFieldFormatting(ctx, *plan)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if !state.FieldDimensions.Equal(plan.FieldDimensions) {
+		diags.Append(r.updateFieldDimensions(ctx, state, *plan)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if !state.FieldValueLabels.Equal(plan.FieldValueLabels) {
+		diags.Append(r.updateFieldValueLabels(ctx, state, *plan)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if !state.FieldDocs.Equal(plan.FieldDocs) {
+		diags.Append(r.updateFieldDocs(ctx, state, *plan)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	// Contrary to other resources, the response of the API to the update operation is not used to populate the Terraform
 	// model because it does not contain the list of fields. The "table metadata" has to be fetched again.
 	includeHiddenFields := true
@@ -352,6 +1057,11 @@ func (r *TableResource) updateTableIfNeeded(ctx context.Context, state TableReso
 		return diags
 	}
 
+	diags.Append(readFieldValueLabels(ctx, r.client, plan)...)
+	if diags.HasError() {
+		return diags
+	}
+
 	return diags
 }
 
@@ -373,6 +1083,13 @@ func (r *TableResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	foreignKeys, diags := getTableForeignKeysValue(ctx, r.client, plan.Id.ValueInt64())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ForeignKeys = *foreignKeys
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -1,9 +1,22 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"testing"
+	"time"
 
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -67,3 +80,424 @@ func TestAccTableResource(t *testing.T) {
 		},
 	})
 }
+
+// Makes a table resource configuration with the given attributes set, and all others null.
+func makeTestTableConfig(t *testing.T, r *TableResource, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attributes[name] = v
+		} else {
+			attributes[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, attributes),
+	}
+}
+
+func TestTableConfigValidators(t *testing.T) {
+	r := &TableResource{}
+
+	testCases := []struct {
+		values    map[string]tftypes.Value
+		expectErr bool
+	}{
+		{map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.Number, 1)}, false},
+		{map[string]tftypes.Value{"db_id": tftypes.NewValue(tftypes.Number, 1), "name": tftypes.NewValue(tftypes.String, "orders")}, false},
+		{map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.Number, 1), "name": tftypes.NewValue(tftypes.String, "orders")}, true},
+		{map[string]tftypes.Value{}, true},
+	}
+
+	for i, tc := range testCases {
+		config := makeTestTableConfig(t, r, tc.values)
+
+		var diags diag.Diagnostics
+		for _, v := range r.ConfigValidators(context.Background()) {
+			resp := fwresource.ValidateConfigResponse{}
+			v.ValidateResource(context.Background(), fwresource.ValidateConfigRequest{Config: config}, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+
+		if diags.HasError() != tc.expectErr {
+			t.Errorf("Test case %d: expected error to be %t, got: %v", i, tc.expectErr, diags)
+		}
+	}
+}
+
+func TestMakeFieldFormattingFromSettings(t *testing.T) {
+	settings := map[string]interface{}{
+		"number_style": "currency",
+		"currency":     "EUR",
+		"decimals":     float64(2),
+	}
+
+	// Only the managed settings are compared, and the JSON is left as is when they match.
+	existing := types.StringValue(`{ "currency": "EUR", "number_style": "currency" }`)
+	formatting, diags := makeFieldFormattingFromSettings(existing, &settings)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !formatting.Equal(existing) {
+		t.Errorf("Expected formatting to be unchanged, got %s.", formatting.ValueString())
+	}
+
+	// Drift is reported for the managed settings only.
+	existing = types.StringValue(`{"currency":"USD","date_style":"YYYY/MM/DD"}`)
+	formatting, diags = makeFieldFormattingFromSettings(existing, &settings)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if formatting.ValueString() != `{"currency":"EUR"}` {
+		t.Errorf("Expected formatting to reflect the remote settings, got %s.", formatting.ValueString())
+	}
+}
+
+func TestFindFieldByName(t *testing.T) {
+	fields := []metabase.Field{
+		{Id: 1, Name: "id"},
+		{Id: 2, Name: "user_id"},
+	}
+
+	field, diags := findFieldByName(fields, "user_id")
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if field.Id != 2 {
+		t.Errorf("Expected field 2, got %d.", field.Id)
+	}
+
+	_, diags = findFieldByName(fields, "missing")
+	if !diags.HasError() {
+		t.Errorf("Expected an error for a missing field.")
+	}
+}
+
+func TestFindTableInMetabaseRetriesMissingMetadata(t *testing.T) {
+	defer func(delay time.Duration) { tableMetadataRetryDelay = delay }(tableMetadataRetryDelay)
+	tableMetadataRetryDelay = 0
+
+	metadataCalls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/table":
+			w.Write([]byte(`[{"id":3,"db_id":1,"name":"ORDERS","display_name":"Orders","entity_type":"entity/TransactionTable"}]`))
+		case "/table/3/query_metadata":
+			metadataCalls++
+			if metadataCalls == 1 {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(`{"id":3,"db_id":1,"name":"ORDERS","display_name":"Orders","entity_type":"entity/TransactionTable","fields":[]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := metabase.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	table, diags := findTableInMetabase(context.Background(), client, tableFilter{
+		Id:   types.Int64Null(),
+		DbId: types.Int64Value(1),
+		Name: types.StringValue("ORDERS"),
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if table.Id != 3 {
+		t.Errorf("Expected table 3, got %d.", table.Id)
+	}
+	if metadataCalls != 2 {
+		t.Errorf("Expected the metadata to be fetched twice, got %d calls.", metadataCalls)
+	}
+}
+
+func TestUpdateTableCaveatsAndPointsOfInterest(t *testing.T) {
+	table := `{"id":3,"db_id":1,"name":"ORDERS","display_name":"Orders","entity_type":"entity/TransactionTable","schema":"PUBLIC","description":null,"caveats":"Refunds are not included.","points_of_interest":null`
+
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/table/3":
+			err := json.NewDecoder(r.Body).Decode(&body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Write([]byte(table + "}"))
+		case r.Method == http.MethodGet && r.URL.Path == "/table/3/query_metadata":
+			w.Write([]byte(table + `,"fields":[]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := metabase.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := TableResource{MetabaseBaseResource{client: client}}
+	state := TableResourceModel{
+		Id:               types.Int64Value(3),
+		DisplayName:      types.StringValue("Orders"),
+		Description:      types.StringNull(),
+		Caveats:          types.StringNull(),
+		PointsOfInterest: types.StringNull(),
+		ForcedFieldTypes: types.MapNull(types.StringType),
+		FieldFormatting:  types.MapNull(types.StringType),
+		FieldDimensions:  types.MapNull(fieldDimensionObjectType),
+		FieldValueLabels: types.MapNull(types.MapType{ElemType: types.StringType}),
+		FieldDocs:        types.MapNull(fieldDocsObjectType),
+	}
+	plan := state
+	plan.Caveats = types.StringValue("Refunds are not included.")
+
+	diags := r.updateTableIfNeeded(context.Background(), state, &plan)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if body == nil || body["caveats"] != "Refunds are not included." {
+		t.Errorf("Expected the caveats to be sent to Metabase, got %v.", body)
+	}
+	if _, ok := body["points_of_interest"]; ok {
+		t.Errorf("Expected unset points of interest not to be sent, got %v.", body)
+	}
+	if plan.Caveats.ValueString() != "Refunds are not included." || !plan.PointsOfInterest.IsNull() {
+		t.Errorf("Expected the model to be updated from the table metadata, got %v and %v.", plan.Caveats, plan.PointsOfInterest)
+	}
+}
+
+func TestMakeTableForeignKeysValue(t *testing.T) {
+	var fks []metabase.TableForeignKey
+	err := json.Unmarshal([]byte(`[
+		{"relationship": "Mt1", "origin_id": 12, "origin": {"id": 12, "name": "user_id", "table_id": 7}, "destination_id": 3, "destination": {"id": 3, "name": "id", "table_id": 2}},
+		{"relationship": "Mt1", "origin_id": 9, "origin": {"id": 9, "name": "author_id", "table_id": 7}, "destination_id": 3, "destination": {"id": 3, "name": "id", "table_id": 2}},
+		{"relationship": "Mt1", "origin_id": 4, "origin": {"id": 4, "name": "owner_id", "table_id": 5}, "destination_id": 3, "destination": {"id": 3, "name": "id", "table_id": 2}}
+	]`), &fks)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	foreignKeys, diags := makeTableForeignKeysValue(fks)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []string{"5.owner_id", "7.author_id", "7.user_id"}
+	elements := foreignKeys.Elements()
+	if len(elements) != len(expected) {
+		t.Fatalf("Expected %d foreign keys, got %d.", len(expected), len(elements))
+	}
+
+	for i, e := range elements {
+		attributes := e.(types.Object).Attributes()
+		origin := fmt.Sprintf("%d.%s", attributes["origin_table_id"].(types.Int64).ValueInt64(), attributes["origin_field"].(types.String).ValueString())
+		if origin != expected[i] {
+			t.Errorf("Expected foreign key %s at position %d, got %s.", expected[i], i, origin)
+		}
+		if attributes["field"].(types.String).ValueString() != "id" {
+			t.Errorf("Expected referenced field id, got %s.", attributes["field"])
+		}
+	}
+}
+
+func TestMakeFieldDimensionValue(t *testing.T) {
+	var field metabase.Field
+	err := json.Unmarshal([]byte(`{"id": 12, "name": "customer_id", "dimensions": [{"id": 1, "type": "external", "name": "Customer", "human_readable_field_id": 42}]}`), &field)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	dimension, diags := makeFieldDimensionValue(field)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	attributes := dimension.Attributes()
+	if attributes["type"].(types.String).ValueString() != "external" {
+		t.Errorf("Expected external dimension, got %s.", attributes["type"])
+	}
+	if attributes["human_readable_field_id"].(types.Int64).ValueInt64() != 42 {
+		t.Errorf("Expected human readable field 42, got %s.", attributes["human_readable_field_id"])
+	}
+
+	field.Dimensions = &[]metabase.FieldDimension{}
+	dimension, diags = makeFieldDimensionValue(field)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !dimension.IsNull() {
+		t.Errorf("Expected a null dimension for a field without remapping, got %s.", dimension)
+	}
+}
+
+func TestMakeFieldDimensionChanges(t *testing.T) {
+	makeDimension := func(name string) attr.Value {
+		return types.ObjectValueMust(fieldDimensionObjectType.AttrTypes, map[string]attr.Value{
+			"type":                    types.StringValue("external"),
+			"name":                    types.StringValue(name),
+			"human_readable_field_id": types.Int64Value(42),
+		})
+	}
+
+	state := types.MapValueMust(fieldDimensionObjectType, map[string]attr.Value{
+		"unchanged": makeDimension("Unchanged"),
+		"updated":   makeDimension("Old"),
+		"removed":   makeDimension("Removed"),
+		"nulled":    makeDimension("Nulled"),
+	})
+	plan := types.MapValueMust(fieldDimensionObjectType, map[string]attr.Value{
+		"unchanged": makeDimension("Unchanged"),
+		"updated":   makeDimension("New"),
+		"nulled":    types.ObjectNull(fieldDimensionObjectType.AttrTypes),
+		"added":     makeDimension("Added"),
+	})
+
+	dimensionsToSet, fieldsToRemove, diags := makeFieldDimensionChanges(context.Background(), state, plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(dimensionsToSet) != 2 || dimensionsToSet["updated"].Name.ValueString() != "New" || dimensionsToSet["added"].Name.ValueString() != "Added" {
+		t.Errorf("Expected the updated and added dimensions to be set, got %v.", dimensionsToSet)
+	}
+	if len(fieldsToRemove) != 2 || fieldsToRemove[0] != "nulled" || fieldsToRemove[1] != "removed" {
+		t.Errorf("Expected the nulled and removed dimensions to be deleted, got %v.", fieldsToRemove)
+	}
+}
+
+func TestMakeFieldValueLabels(t *testing.T) {
+	values := [][]interface{}{{float64(0), "Inactive"}, {float64(1)}, {float64(2), "Banned"}, {"pending", "Pending"}}
+
+	labels := makeFieldValueLabels(values, []string{"0", "1", "pending", "3"})
+
+	expected := map[string]string{"0": "Inactive", "pending": "Pending"}
+	if !reflect.DeepEqual(labels, expected) {
+		t.Errorf("Expected labels %v, got %v.", expected, labels)
+	}
+}
+
+func TestMakeFieldValuesWithLabels(t *testing.T) {
+	existingValues := [][]interface{}{{float64(0)}, {float64(1), "Enabled"}, {float64(2)}}
+
+	values := makeFieldValuesWithLabels(existingValues, map[string]string{"0": "Inactive", "3": "Deleted"})
+
+	expected := [][]interface{}{{float64(0), "Inactive"}, {float64(1), "Enabled"}, {float64(2), "2"}, {float64(3), "Deleted"}}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("Expected values %v, got %v.", expected, values)
+	}
+}
+
+func TestMakeFieldDocsValue(t *testing.T) {
+	caveats := "Includes test accounts."
+	pointsOfInterest := "Used for billing."
+	field := metabase.Field{Id: 12, Name: "account_id", Caveats: &caveats, PointsOfInterest: &pointsOfInterest}
+
+	existing := types.ObjectValueMust(fieldDocsObjectType.AttrTypes, map[string]attr.Value{
+		"caveats":            types.StringValue("Outdated caveats."),
+		"points_of_interest": types.StringNull(),
+	})
+
+	docs, diags := makeFieldDocsValue(existing, field)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	attributes := docs.Attributes()
+	if !attributes["caveats"].Equal(types.StringValue(caveats)) {
+		t.Errorf("Expected the caveats to be read from the field, got %s.", attributes["caveats"])
+	}
+	if !attributes["points_of_interest"].IsNull() {
+		t.Errorf("Expected the unmanaged points of interest to be null, got %s.", attributes["points_of_interest"])
+	}
+}
+
+func TestUpdateFieldDocsOnlyUpdatesChangedFields(t *testing.T) {
+	updatedFields := map[string]map[string]interface{}{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"id":12,"name":"account_id","table_id":3,"base_type":"type/Integer","semantic_type":"type/FK","description":"The account."}`))
+		case http.MethodPut:
+			var body map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			updatedFields[r.URL.Path] = body
+			w.Write([]byte(`{"id":12,"name":"account_id","table_id":3,"base_type":"type/Integer"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := metabase.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	makeDocs := func(caveats string) attr.Value {
+		return types.ObjectValueMust(fieldDocsObjectType.AttrTypes, map[string]attr.Value{
+			"caveats":            types.StringValue(caveats),
+			"points_of_interest": types.StringNull(),
+		})
+	}
+	fields := types.MapValueMust(types.Int64Type, map[string]attr.Value{
+		"account_id": types.Int64Value(12),
+		"name":       types.Int64Value(13),
+	})
+
+	state := TableResourceModel{
+		Fields: fields,
+		FieldDocs: types.MapValueMust(fieldDocsObjectType, map[string]attr.Value{
+			"name": makeDocs("Not unique."),
+		}),
+	}
+	plan := TableResourceModel{
+		Fields: fields,
+		FieldDocs: types.MapValueMust(fieldDocsObjectType, map[string]attr.Value{
+			"account_id": makeDocs("Includes test accounts."),
+			"name":       makeDocs("Not unique."),
+		}),
+	}
+
+	r := TableResource{MetabaseBaseResource{client: client}}
+	diags := r.updateFieldDocs(context.Background(), state, plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(updatedFields) != 1 {
+		t.Fatalf("Expected only the changed field to be updated, got %v.", updatedFields)
+	}
+
+	body := updatedFields["/field/12"]
+	if body["caveats"] != "Includes test accounts." || body["description"] != "The account." || body["semantic_type"] != "type/FK" {
+		t.Errorf("Expected the caveats to be set and other attributes to be preserved, got %v.", body)
+	}
+	if _, ok := body["points_of_interest"]; ok {
+		t.Errorf("Expected unset points of interest not to be sent, got %v.", body)
+	}
+}
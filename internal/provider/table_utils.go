@@ -2,14 +2,26 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
 
 	"github.com/flovouin/terraform-provider-metabase/metabase"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// The maximum number of attempts when looking up a table whose metadata cannot be found after listing tables.
+const maxTableMetadataAttempts = 3
+
+// The delay between two attempts at looking up a table. This is a variable such that tests do not have to wait.
+var tableMetadataRetryDelay = 2 * time.Second
+
 // A predicate whether a table returned by the Metabase API matches some criteria.
 type tablePredicate func(metabase.Table) bool
 
@@ -37,6 +49,30 @@ type tableFilter struct {
 	Schema     types.String // The database schema in which the table is located. For BigQuery, this is the dataset name.
 }
 
+// The attributes which can be used to search for a table when its ID is not specified.
+var tableSearchAttributes = []string{"db_id", "name", "entity_type", "schema"}
+
+// Makes the pairs of attributes which cannot be set together when looking up a table, i.e. the ID with each of the
+// search attributes.
+func makeTableConflictingAttributes() [][]path.Expression {
+	conflicts := make([][]path.Expression, 0, len(tableSearchAttributes))
+	for _, a := range tableSearchAttributes {
+		conflicts = append(conflicts, []path.Expression{path.MatchRoot("id"), path.MatchRoot(a)})
+	}
+
+	return conflicts
+}
+
+// Makes the list of attributes of which at least one should be set to look up a table.
+func makeTableLookupAttributes() []path.Expression {
+	attributes := []path.Expression{path.MatchRoot("id")}
+	for _, a := range tableSearchAttributes {
+		attributes = append(attributes, path.MatchRoot(a))
+	}
+
+	return attributes
+}
+
 // Makes a `tablePredicate` that will match tables based on the given Terraform values.
 // A predicate can either be an exact match based on the ID of the table, or a search based on one or several table
 // attributes.
@@ -108,50 +144,259 @@ func findTableInMetabase(ctx context.Context, client *metabase.ClientWithRespons
 		return nil, diags
 	}
 
-	// Finding the table from the list of all tables in Metabase.
-	// The API is not paginated and returns all results in a single response.
-	// Also, it does not support query parameters to limit results to what we're searching for.
-	listResp, err := client.ListTablesWithResponse(ctx)
+	for attempt := 1; ; attempt++ {
+		// Finding the table from the list of all tables in Metabase.
+		// The API is not paginated and returns all results in a single response.
+		// Also, it does not support query parameters to limit results to what we're searching for.
+		listResp, err := client.ListTablesWithResponse(ctx)
+
+		diags.Append(checkMetabaseResponse(listResp, err, []int{200}, "list tables")...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		table, findDiags := findTable(*listResp.JSON200, *predicate)
+		diags.Append(findDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		// Querying the found table specifically. The tables returned in the list do not contain information about fields.
+		includeHiddenFields := true
+		metadataResp, err := client.GetTableMetadataWithResponse(ctx, table.Id, &metabase.GetTableMetadataParams{
+			IncludeHiddenFields: &includeHiddenFields,
+		})
+
+		// While a database is being synced, a table can be listed before its metadata is available.
+		// In this case, the whole lookup is retried after a short delay.
+		if err == nil && metadataResp.StatusCode() == 404 && attempt < maxTableMetadataAttempts {
+			tflog.Warn(ctx, "Table metadata not found right after listing tables, retrying.", map[string]interface{}{
+				"table_id": table.Id,
+				"attempt":  attempt,
+			})
+
+			select {
+			case <-ctx.Done():
+				diags.AddError("Interrupted while waiting for the table metadata to be available.", ctx.Err().Error())
+				return nil, diags
+			case <-time.After(tableMetadataRetryDelay):
+			}
+
+			continue
+		}
+
+		diags.Append(checkMetabaseResponse(metadataResp, err, []int{200}, "get table metadata")...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return metadataResp.JSON200, diags
+	}
+}
+
+// Makes a Terraform map value where keys are field names and values are the corresponding IDs.
+func makeTableFieldsValue(t metabase.TableMetadata) (*basetypes.MapValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	fields := make(map[string]attr.Value, len(t.Fields))
+	for _, f := range t.Fields {
+		fields[f.Name] = types.Int64Value(int64(f.Id))
+	}
 
-	diags.Append(checkMetabaseResponse(listResp, err, []int{200}, "list tables")...)
+	fieldsValue, fieldsDiags := types.MapValue(types.Int64Type, fields)
+	diags.Append(fieldsDiags...)
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	table, diags := findTable(*listResp.JSON200, *predicate)
-	diags.Append(diags...)
+	return &fieldsValue, diags
+}
+
+// The object type for the details of a single field, as exposed by the table data source.
+var fieldDetailsObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":             types.Int64Type,
+		"base_type":      types.StringType,
+		"effective_type": types.StringType,
+		"semantic_type":  types.StringType,
+	},
+}
+
+// Makes a Terraform map value where keys are field names and values are objects describing each field.
+func makeTableFieldDetailsValue(t metabase.TableMetadata) (*basetypes.MapValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	fields := make(map[string]attr.Value, len(t.Fields))
+	for _, f := range t.Fields {
+		fieldValue, objectDiags := types.ObjectValue(fieldDetailsObjectType.AttrTypes, map[string]attr.Value{
+			"id":             types.Int64Value(int64(f.Id)),
+			"base_type":      stringValueOrNull(f.BaseType),
+			"effective_type": stringValueOrNull(f.EffectiveType),
+			"semantic_type":  stringValueOrNull(f.SemanticType),
+		})
+		diags.Append(objectDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		fields[f.Name] = fieldValue
+	}
+
+	fieldsValue, fieldsDiags := types.MapValue(fieldDetailsObjectType, fields)
+	diags.Append(fieldsDiags...)
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	// Querying the found table specifically. The tables returned in the list do not contain information about fields.
-	includeHiddenFields := true
-	metadataResp, err := client.GetTableMetadataWithResponse(ctx, table.Id, &metabase.GetTableMetadataParams{
-		IncludeHiddenFields: &includeHiddenFields,
+	return &fieldsValue, diags
+}
+
+// The object type describing a foreign key referencing a field of a table.
+var tableForeignKeyObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"field":           types.StringType,
+		"origin_table_id": types.Int64Type,
+		"origin_field":    types.StringType,
+	},
+}
+
+// Makes a Terraform list value describing the foreign keys referencing fields of a table.
+// Foreign keys are sorted by origin table and field, such that the list does not change with the order of the response.
+func makeTableForeignKeysValue(fks []metabase.TableForeignKey) (*basetypes.ListValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sortedFks := make([]metabase.TableForeignKey, len(fks))
+	copy(sortedFks, fks)
+	sort.Slice(sortedFks, func(i, j int) bool {
+		if sortedFks[i].Origin.TableId != sortedFks[j].Origin.TableId {
+			return sortedFks[i].Origin.TableId < sortedFks[j].Origin.TableId
+		}
+		return sortedFks[i].Origin.Name < sortedFks[j].Origin.Name
 	})
 
-	diags.Append(checkMetabaseResponse(metadataResp, err, []int{200}, "get table metadata")...)
+	foreignKeys := make([]attr.Value, 0, len(sortedFks))
+	for _, fk := range sortedFks {
+		fkValue, objectDiags := types.ObjectValue(tableForeignKeyObjectType.AttrTypes, map[string]attr.Value{
+			"field":           types.StringValue(fk.Destination.Name),
+			"origin_table_id": types.Int64Value(int64(fk.Origin.TableId)),
+			"origin_field":    types.StringValue(fk.Origin.Name),
+		})
+		diags.Append(objectDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		foreignKeys = append(foreignKeys, fkValue)
+	}
+
+	foreignKeysValue, listDiags := types.ListValue(tableForeignKeyObjectType, foreignKeys)
+	diags.Append(listDiags...)
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return metadataResp.JSON200, diags
+	return &foreignKeysValue, diags
 }
 
-// Makes a Terraform map value where keys are field names and values are the corresponding IDs.
-func makeTableFieldsValue(t metabase.TableMetadata) (*basetypes.MapValue, diag.Diagnostics) {
+// Fetches the foreign keys referencing fields of the given table, and returns them as a Terraform list value.
+func getTableForeignKeysValue(ctx context.Context, client metabase.ClientWithResponsesInterface, tableId int64) (*basetypes.ListValue, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	fields := make(map[string]attr.Value, len(t.Fields))
-	for _, f := range t.Fields {
-		fields[f.Name] = types.Int64Value(int64(f.Id))
-	}
+	fksResp, err := client.GetTableForeignKeysWithResponse(ctx, int(tableId))
 
-	fieldsValue, fieldsDiags := types.MapValue(types.Int64Type, fields)
-	diags.Append(fieldsDiags...)
+	diags.Append(checkMetabaseResponse(fksResp, err, []int{200}, "get table foreign keys")...)
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	return &fieldsValue, diags
+	return makeTableForeignKeysValue(*fksResp.JSON200)
+}
+
+// Returns the string identifying a raw field value in Terraform, e.g. `1` or `active`.
+func makeFieldValueKey(v interface{}) string {
+	switch typedValue := v.(type) {
+	case string:
+		return typedValue
+	case float64:
+		return strconv.FormatFloat(typedValue, 'f', -1, 64)
+	case nil:
+		return "null"
+	}
+
+	return fmt.Sprint(v)
+}
+
+// Makes the raw value sent to Metabase for a key which is not part of the existing values of a field.
+func makeFieldValueFromKey(key string) interface{} {
+	number, err := strconv.ParseFloat(key, 64)
+	if err == nil {
+		return number
+	}
+
+	return key
+}
+
+// Extracts the labels of the given raw values (identified by their key) from the values returned by the Metabase API.
+// Raw values without a label are omitted.
+func makeFieldValueLabels(values [][]interface{}, keys []string) map[string]string {
+	labels := make(map[string]string, len(keys))
+
+	expectedKeys := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		expectedKeys[k] = true
+	}
+
+	for _, v := range values {
+		if len(v) < 2 {
+			continue
+		}
+
+		key := makeFieldValueKey(v[0])
+		label, ok := v[1].(string)
+		if expectedKeys[key] && ok {
+			labels[key] = label
+		}
+	}
+
+	return labels
+}
+
+// Makes the complete list of values sent to Metabase, where the given labels are set on top of the existing values.
+// As Metabase replaces all the values of the field, existing labels are kept, and raw values without a label are
+// labelled with their key. Raw values which do not exist yet are appended to the list.
+func makeFieldValuesWithLabels(existingValues [][]interface{}, labels map[string]string) [][]interface{} {
+	values := make([][]interface{}, 0, len(existingValues)+len(labels))
+	labelledKeys := make(map[string]bool, len(labels))
+
+	for _, v := range existingValues {
+		if len(v) == 0 {
+			continue
+		}
+
+		key := makeFieldValueKey(v[0])
+		label, ok := labels[key]
+		if ok {
+			labelledKeys[key] = true
+		} else if len(v) >= 2 && v[1] != nil {
+			label = fmt.Sprint(v[1])
+		} else {
+			label = key
+		}
+
+		values = append(values, []interface{}{v[0], label})
+	}
+
+	missingKeys := make([]string, 0, len(labels))
+	for key := range labels {
+		if !labelledKeys[key] {
+			missingKeys = append(missingKeys, key)
+		}
+	}
+	sort.Strings(missingKeys)
+
+	for _, key := range missingKeys {
+		values = append(values, []interface{}{makeFieldValueFromKey(key), labels[key]})
+	}
+
+	return values
 }
@@ -2,14 +2,19 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"strconv"
+	"strings"
+	"unicode"
 
 	"github.com/flovouin/terraform-provider-metabase/metabase"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Converts a possibly `nil` string to a Terraform `String` type.
@@ -30,6 +35,15 @@ func int64ValueOrNull(v *int) types.Int64 {
 	return types.Int64Value(int64(*v))
 }
 
+// Converts a possibly `nil` boolean to a Terraform `Bool` type.
+func boolValueOrNull(v *bool) types.Bool {
+	if v == nil {
+		return types.BoolNull()
+	}
+
+	return types.BoolValue(*v)
+}
+
 // Returns the value of a Terraform `String` type, or `nil` if it is null.
 func valueStringOrNull(v types.String) *string {
 	if v.IsNull() {
@@ -60,6 +74,29 @@ func valueInt64OrNull(v types.Int64) *int {
 	return &r
 }
 
+// Returns the value of a Terraform `Bool` type, or `nil` if it is null.
+func valueBoolOrNull(v types.Bool) *bool {
+	if v.IsNull() {
+		return nil
+	}
+
+	r := v.ValueBool()
+	return &r
+}
+
+// Marshals an opaque JSON value returned by the Metabase API, such that it can be stored in the state in place of the
+// existing value. Keys are always sorted, which keeps the output stable.
+// When the value replaces an existing one (e.g. when the object has been modified outside of Terraform), it is indented
+// such that the difference with the configuration is line-oriented and readable in the plan. Otherwise (e.g. when
+// importing), it is kept compact, which matches the output of `jsonencode` and avoids formatting-only diffs.
+func marshalOpaqueJson(v interface{}, replacesExisting bool) ([]byte, error) {
+	if replacesExisting {
+		return json.MarshalIndent(v, "", "  ")
+	}
+
+	return json.Marshal(v)
+}
+
 // Ensures that a Metabase response is not an error and has the expected status code. Otherwise, returns a diagnostic
 // error.
 func checkMetabaseResponse(r metabase.MetabaseResponse, err error, statusCodes []int, operation string) diag.Diagnostics {
@@ -95,6 +132,185 @@ func checkMetabaseResponse(r metabase.MetabaseResponse, err error, statusCodes [
 	}
 }
 
+// The outcome of a call to the Metabase API, for operations where the requested object may not exist.
+type metabaseResponseStatus int
+
+const (
+	metabaseResponseOk       metabaseResponseStatus = iota // The response has one of the expected status codes.
+	metabaseResponseNotFound                               // The object does not exist in Metabase (404).
+	metabaseResponseError                                  // The call failed, and the diagnostics describe the error.
+)
+
+// Checks a Metabase response similarly to `checkMetabaseResponse`, but classifies it such that callers can handle a
+// missing object (e.g. by removing it from the state) without inspecting the status code themselves. A 404 response
+// is never reported as an error, and should not be listed in `statusCodes`.
+func checkMetabaseResponseStatus(r metabase.MetabaseResponse, err error, statusCodes []int, operation string) (metabaseResponseStatus, diag.Diagnostics) {
+	if err == nil && r.StatusCode() == 404 {
+		return metabaseResponseNotFound, diag.Diagnostics{}
+	}
+
+	diags := checkMetabaseResponse(r, err, statusCodes, operation)
+	if diags.HasError() {
+		return metabaseResponseError, diags
+	}
+
+	return metabaseResponseOk, diags
+}
+
+// Removes a resource from the state when the corresponding object no longer exists in Metabase, logging the reason.
+// All resources follow the same policy when reading: an object deleted outside of Terraform (usually a 404 response, or
+// an archived object for resources where archiving is the same as deleting) is not an error. Removing it from the state
+// lets Terraform plan its re-creation, and makes the import of a non-existing object fail with a consistent message.
+// Graph resources are never removed, as the graphs of a Metabase instance always exist.
+func removeMissingResourceFromState(ctx context.Context, resp *resource.ReadResponse, objectType string, id interface{}, reason string) {
+	tflog.Warn(ctx, "Removing resource from state as the object no longer exists in Metabase.", map[string]interface{}{
+		"object_type": objectType,
+		"id":          id,
+		"reason":      reason,
+	})
+
+	resp.State.RemoveResource(ctx)
+}
+
+// The major version of Metabase which introduced granular caching, configured using the cache config API rather than
+// the `cache_ttl` attributes of questions and dashboards.
+const granularCachingMajorVersion = 50
+
+// Parses the major version from a Metabase version tag, e.g. `50` for both `v0.50.3` and `v1.50.3`.
+// `false` is returned if the tag cannot be parsed, e.g. for development builds.
+func parseMetabaseMajorVersion(tag string) (int, bool) {
+	parts := strings.Split(strings.TrimPrefix(tag, "v"), ".")
+	if len(parts) < 2 {
+		return 0, false
+	}
+
+	major, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, false
+	}
+
+	return major, true
+}
+
+// Returns whether the Metabase instance uses granular caching, in which case `cache_ttl` attributes are ignored.
+// `false` is returned if the version of the instance cannot be determined.
+func usesGranularCaching(ctx context.Context, client metabase.ClientWithResponsesInterface) (bool, diag.Diagnostics) {
+	propertiesResp, err := client.GetSessionPropertiesWithResponse(ctx)
+
+	diags := checkMetabaseResponse(propertiesResp, err, []int{200}, "get session properties")
+	if diags.HasError() {
+		return false, diags
+	}
+
+	if propertiesResp.JSON200.Version == nil || propertiesResp.JSON200.Version.Tag == nil {
+		return false, diags
+	}
+
+	major, ok := parseMetabaseMajorVersion(*propertiesResp.JSON200.Version.Tag)
+	return ok && major >= granularCachingMajorVersion, diags
+}
+
+// The maximum number of attempts when updating a graph while Metabase keeps reporting revision conflicts.
+const maxGraphUpdateAttempts = 3
+
+// Returns whether the response indicates that the revision sent along with a graph update is outdated. This happens
+// when the graph has been modified concurrently, in which case the latest revision should be fetched before retrying.
+func isRevisionConflict(r metabase.MetabaseResponse) bool {
+	return r.StatusCode() == 409
+}
+
+// A function fetching the current revision of a graph from the Metabase API.
+type graphRevisionGetter func(ctx context.Context, client metabase.ClientWithResponsesInterface) (int, diag.Diagnostics)
+
+// Updates a graph by calling `update` with the given revision. If Metabase reports a revision conflict, the latest
+// revision is fetched using `getRevision` and the update is retried, up to `maxGraphUpdateAttempts` times.
+// A warning is returned when the update only succeeded after a conflict, as concurrent changes may have been overwritten.
+// This is used for both the permissions and the collection graphs, which follow the same revision mechanism.
+func updateGraphWithRevisionRetry[R metabase.MetabaseResponse](
+	ctx context.Context,
+	client metabase.ClientWithResponsesInterface,
+	revision int,
+	update func(revision int) (R, error),
+	getRevision graphRevisionGetter,
+	operation string,
+) (R, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for attempt := 1; ; attempt++ {
+		updateResp, err := update(revision)
+
+		diags.Append(checkMetabaseResponse(updateResp, err, []int{200, 409}, operation)...)
+		if diags.HasError() {
+			return updateResp, diags
+		}
+
+		if !isRevisionConflict(updateResp) {
+			if attempt > 1 {
+				diags.AddWarning(
+					fmt.Sprintf("Revision conflict while calling the Metabase API for operation '%s'.", operation),
+					fmt.Sprintf("The graph was modified concurrently, and the update only succeeded after %d attempts using revision %d. Permissions managed by this resource may have overwritten the concurrent changes. Consider serializing applies that modify the graph, e.g. when several pipelines manage the same Metabase instance.", attempt, revision),
+				)
+			}
+
+			return updateResp, diags
+		}
+
+		if attempt >= maxGraphUpdateAttempts {
+			diags.AddError(
+				fmt.Sprintf("Revision conflict while calling the Metabase API for operation '%s'.", operation),
+				fmt.Sprintf("The graph was modified concurrently and the update failed after %d attempts. Consider serializing applies that modify the graph. Body: %s", attempt, updateResp.BodyString()),
+			)
+			return updateResp, diags
+		}
+
+		tflog.Warn(ctx, "Revision conflict when updating graph, retrying with the latest revision.", map[string]interface{}{
+			"operation": operation,
+			"revision":  revision,
+			"attempt":   attempt,
+		})
+
+		var revisionDiags diag.Diagnostics
+		revision, revisionDiags = getRevision(ctx, client)
+		diags.Append(revisionDiags...)
+		if diags.HasError() {
+			return updateResp, diags
+		}
+	}
+}
+
+// Makes the slug for an object from its name, as used in Metabase URLs. Metabase only relies on the ID in the URL, such
+// that the slug is purely informative.
+func makeSlugFromName(name string) string {
+	var slug strings.Builder
+	pendingSeparator := false
+
+	for _, r := range strings.ToLower(name) {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			pendingSeparator = slug.Len() > 0
+			continue
+		}
+
+		if pendingSeparator {
+			slug.WriteRune('-')
+			pendingSeparator = false
+		}
+
+		slug.WriteRune(r)
+	}
+
+	return slug.String()
+}
+
+// Makes the URL to an object in the Metabase UI, e.g. `https://metabase.example.com/dashboard/1-my-dashboard`.
+func makeObjectUrl(siteUrl string, objectType string, id string, name string) types.String {
+	slug := makeSlugFromName(name)
+	if len(slug) == 0 {
+		return types.StringValue(fmt.Sprintf("%s/%s/%s", siteUrl, objectType, id))
+	}
+
+	return types.StringValue(fmt.Sprintf("%s/%s/%s-%s", siteUrl, objectType, id, url.PathEscape(slug)))
+}
+
 // Performs the import operation for a resource identified using its `id` integer attribute.
 func importStatePassthroughIntegerId(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	id, err := strconv.ParseInt(req.ID, 10, 64)
@@ -106,13 +322,74 @@ func importStatePassthroughIntegerId(ctx context.Context, req resource.ImportSta
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
+// The import ID that can be passed when importing a graph, to use the current revision rather than a specific one.
+const latestGraphRevisionImportId = "latest"
+
+// Performs the import operation for a graph resource identified by its revision. If the import ID is empty or
+// `latest`, the current revision is fetched using `getRevision`. In any case, the entire graph will be read after the
+// import.
+func importStateGraphRevision(ctx context.Context, client metabase.ClientWithResponsesInterface, req resource.ImportStateRequest, resp *resource.ImportStateResponse, getRevision graphRevisionGetter) {
+	if req.ID == "" || req.ID == latestGraphRevisionImportId {
+		revision, diags := getRevision(ctx, client)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("revision"), revision)...)
+		return
+	}
+
+	revision, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to convert revision to an integer.", fmt.Sprintf("Expected a revision number or '%s', got: %s.", latestGraphRevisionImportId, req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("revision"), revision)...)
+}
+
+// Returns a warning if the given set of ignored groups is explicitly set but does not contain the Administrators group.
+// Permissions of the Administrators group cannot be changed, and Metabase rejects updates that try to do so.
+func checkIgnoredGroupsContainAdministrators(ctx context.Context, list types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if list.IsNull() || list.IsUnknown() {
+		return diags
+	}
+
+	for _, g := range list.Elements() {
+		groupId, ok := g.(types.Int64)
+		// The Administrators group may be referenced through a value which is not known yet.
+		if !ok || groupId.IsUnknown() {
+			return diags
+		}
+
+		if groupId.ValueInt64() == metabase.AdministratorsPermissionsGroupId {
+			return diags
+		}
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("ignored_groups"),
+		"The Administrators group is not part of the ignored groups.",
+		fmt.Sprintf("The permissions of the Administrators group (ID %d) cannot be changed, and Metabase will reject updates trying to do so. It should usually be included in ignored_groups.", metabase.AdministratorsPermissionsGroupId),
+	)
+
+	return diags
+}
+
 // Returns a map where keys are the IDs of the permissions groups that should be ignored when synchronizing the
 // permissions graph. If the set of ignored groups in the Terraform resource is null, it will default to the
 // administrators group only (the group is automatically granted access to all collections and datasets, and this cannot
-// be changed).
-func getIgnoredPermissionsGroups(ctx context.Context, list types.Set) (map[string]bool, diag.Diagnostics) {
+// be changed), unless `manageAdminPermissions` is set at the provider level, in which case no group is ignored.
+func getIgnoredPermissionsGroups(ctx context.Context, list types.Set, manageAdminPermissions bool) (map[string]bool, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
+	if list.IsNull() && manageAdminPermissions {
+		return map[string]bool{}, diags
+	}
+
 	if list.IsNull() {
 		return map[string]bool{
 			fmt.Sprint(metabase.AdministratorsPermissionsGroupId): true,
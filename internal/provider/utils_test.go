@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/flovouin/terraform-provider-metabase/metabase"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func makeTestGraphUpdateResponse(statusCode int) *metabase.ReplaceCollectionPermissionsGraphResponse {
+	resp := &metabase.ReplaceCollectionPermissionsGraphResponse{
+		HTTPResponse: &http.Response{StatusCode: statusCode},
+	}
+	if statusCode == 200 {
+		resp.JSON200 = &metabase.CollectionPermissionsGraph{}
+	}
+	return resp
+}
+
+func TestUpdateGraphWithRevisionRetry(t *testing.T) {
+	var sentRevisions []int
+	statusCodes := []int{409, 200}
+
+	_, diags := updateGraphWithRevisionRetry(
+		context.Background(),
+		nil,
+		1,
+		func(revision int) (*metabase.ReplaceCollectionPermissionsGraphResponse, error) {
+			sentRevisions = append(sentRevisions, revision)
+			return makeTestGraphUpdateResponse(statusCodes[len(sentRevisions)-1]), nil
+		},
+		func(context.Context, metabase.ClientWithResponsesInterface) (int, diag.Diagnostics) {
+			return 2, nil
+		},
+		"update graph",
+	)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(sentRevisions) != 2 || sentRevisions[0] != 1 || sentRevisions[1] != 2 {
+		t.Errorf("expected revisions [1 2] to be sent, got %v", sentRevisions)
+	}
+	if diags.WarningsCount() != 1 {
+		t.Errorf("expected a warning about the revision conflict, got %d", diags.WarningsCount())
+	}
+}
+
+func TestUpdateGraphWithoutRevisionConflictDoesNotWarn(t *testing.T) {
+	_, diags := updateGraphWithRevisionRetry(
+		context.Background(),
+		nil,
+		1,
+		func(revision int) (*metabase.ReplaceCollectionPermissionsGraphResponse, error) {
+			return makeTestGraphUpdateResponse(200), nil
+		},
+		func(context.Context, metabase.ClientWithResponsesInterface) (int, diag.Diagnostics) {
+			return 2, nil
+		},
+		"update graph",
+	)
+
+	if len(diags) != 0 {
+		t.Errorf("expected no diagnostic, got %v", diags)
+	}
+}
+
+func TestUpdateGraphWithRevisionRetryGivesUp(t *testing.T) {
+	attempts := 0
+
+	_, diags := updateGraphWithRevisionRetry(
+		context.Background(),
+		nil,
+		1,
+		func(revision int) (*metabase.ReplaceCollectionPermissionsGraphResponse, error) {
+			attempts++
+			return makeTestGraphUpdateResponse(409), nil
+		},
+		func(context.Context, metabase.ClientWithResponsesInterface) (int, diag.Diagnostics) {
+			return 2, nil
+		},
+		"update graph",
+	)
+
+	if !diags.HasError() {
+		t.Fatal("expected an error after too many conflicts")
+	}
+	if attempts != maxGraphUpdateAttempts {
+		t.Errorf("expected %d attempts, got %d", maxGraphUpdateAttempts, attempts)
+	}
+}
+
+func TestCheckIgnoredGroupsContainAdministrators(t *testing.T) {
+	ctx := context.Background()
+
+	withAdministrators, _ := types.SetValueFrom(ctx, types.Int64Type, []int64{2, 5})
+	if diags := checkIgnoredGroupsContainAdministrators(ctx, withAdministrators); diags.WarningsCount() != 0 {
+		t.Errorf("Expected no warning when the Administrators group is ignored, got: %v", diags)
+	}
+
+	withoutAdministrators, _ := types.SetValueFrom(ctx, types.Int64Type, []int64{5})
+	if diags := checkIgnoredGroupsContainAdministrators(ctx, withoutAdministrators); diags.WarningsCount() != 1 {
+		t.Errorf("Expected a warning when the Administrators group is not ignored, got: %v", diags)
+	}
+
+	if diags := checkIgnoredGroupsContainAdministrators(ctx, types.SetNull(types.Int64Type)); diags.WarningsCount() != 0 {
+		t.Errorf("Expected no warning when using the default ignored groups, got: %v", diags)
+	}
+}
+
+func TestGetIgnoredPermissionsGroupsWithManagedAdminPermissions(t *testing.T) {
+	ctx := context.Background()
+
+	ignoredGroups, diags := getIgnoredPermissionsGroups(ctx, types.SetNull(types.Int64Type), false)
+	if diags.HasError() || len(ignoredGroups) != 1 || !ignoredGroups["2"] {
+		t.Errorf("Expected the Administrators group to be ignored by default, got: %v", ignoredGroups)
+	}
+
+	ignoredGroups, diags = getIgnoredPermissionsGroups(ctx, types.SetNull(types.Int64Type), true)
+	if diags.HasError() || len(ignoredGroups) != 0 {
+		t.Errorf("Expected no ignored group when admin permissions are managed, got: %v", ignoredGroups)
+	}
+
+	// An explicit list always takes precedence over the provider setting.
+	explicitGroups, _ := types.SetValueFrom(ctx, types.Int64Type, []int64{2, 5})
+	ignoredGroups, diags = getIgnoredPermissionsGroups(ctx, explicitGroups, true)
+	if diags.HasError() || len(ignoredGroups) != 2 {
+		t.Errorf("Expected the explicit ignored groups, got: %v", ignoredGroups)
+	}
+}
+
+func TestMakeObjectUrl(t *testing.T) {
+	siteUrl := makeSiteUrlFromEndpoint("https://metabase.example.com/api/")
+	if siteUrl != "https://metabase.example.com" {
+		t.Fatalf("unexpected site URL: %s", siteUrl)
+	}
+
+	url := makeObjectUrl(siteUrl, "dashboard", "12", "📈 Sales & Revenue (2024)")
+	if url.ValueString() != "https://metabase.example.com/dashboard/12-sales-revenue-2024" {
+		t.Errorf("unexpected URL: %s", url.ValueString())
+	}
+
+	url = makeObjectUrl(siteUrl, "collection", "root", "")
+	if url.ValueString() != "https://metabase.example.com/collection/root" {
+		t.Errorf("unexpected URL: %s", url.ValueString())
+	}
+}
+
+func TestParseMetabaseMajorVersion(t *testing.T) {
+	for tag, expected := range map[string]int{"v0.50.3": 50, "v1.49.12": 49, "v0.51.0-RC1": 51} {
+		major, ok := parseMetabaseMajorVersion(tag)
+		if !ok || major != expected {
+			t.Errorf("Expected major version %d for tag %s, got: %d", expected, tag, major)
+		}
+	}
+
+	if _, ok := parseMetabaseMajorVersion("vUNKNOWN"); ok {
+		t.Errorf("Expected unknown version tag not to be parsed")
+	}
+}
+
+func TestUsesGranularCaching(t *testing.T) {
+	for tag, expected := range map[string]bool{"v0.50.3": true, "v1.49.12": false, "vUNKNOWN": false} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"version":{"tag":"` + tag + `"},"site-name":"Metabase"}`))
+		}))
+		defer server.Close()
+
+		client, err := metabase.NewClientWithResponses(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		granularCaching, diags := usesGranularCaching(context.Background(), client)
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if granularCaching != expected {
+			t.Errorf("Expected granular caching to be %t for tag %s, got: %t", expected, tag, granularCaching)
+		}
+	}
+}
+
+// Removes insignificant whitespace from a JSON string, such that it can be compared regardless of its indentation.
+func compactJson(t *testing.T, value string) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	err := json.Compact(&buf, []byte(value))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return buf.String()
+}
+
+func TestMarshalOpaqueJson(t *testing.T) {
+	value := map[string]interface{}{"name": "Card", "display": "table"}
+
+	compact, err := marshalOpaqueJson(value, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(compact) != `{"display":"table","name":"Card"}` {
+		t.Errorf("Expected compact JSON with sorted keys, got: %s", compact)
+	}
+
+	indented, err := marshalOpaqueJson(value, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(indented) != "{\n  \"display\": \"table\",\n  \"name\": \"Card\"\n}" {
+		t.Errorf("Expected indented JSON with sorted keys, got: %s", indented)
+	}
+}
+
+func TestCheckMetabaseResponseStatus(t *testing.T) {
+	testCases := []struct {
+		statusCode     int
+		expectedStatus metabaseResponseStatus
+	}{
+		{200, metabaseResponseOk},
+		{404, metabaseResponseNotFound},
+		{500, metabaseResponseError},
+	}
+
+	for _, tc := range testCases {
+		status, diags := checkMetabaseResponseStatus(makeTestGraphUpdateResponse(tc.statusCode), nil, []int{200}, "test")
+		if status != tc.expectedStatus {
+			t.Errorf("Expected status %d for status code %d, got %d.", tc.expectedStatus, tc.statusCode, status)
+		}
+		if diags.HasError() != (tc.expectedStatus == metabaseResponseError) {
+			t.Errorf("Unexpected diagnostics for status code %d: %v.", tc.statusCode, diags)
+		}
+	}
+
+	var resp *metabase.ReplaceCollectionPermissionsGraphResponse
+	status, diags := checkMetabaseResponseStatus(resp, errors.New("connection refused"), []int{200}, "test")
+	if status != metabaseResponseError || !diags.HasError() {
+		t.Errorf("Expected an error when the request fails, got status %d.", status)
+	}
+}
+
+// Makes the state of a resource with the given attributes set, and all others null.
+func makeTestResourceState(t *testing.T, r fwresource.Resource, values map[string]tftypes.Value) tfsdk.State {
+	t.Helper()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attributes[name] = v
+		} else {
+			attributes[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+
+	return tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, attributes),
+	}
+}
+
+func TestReadRemovesResourceOnNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`"Not found."`))
+	}))
+	defer server.Close()
+
+	client, err := metabase.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v.", err)
+	}
+
+	base := MetabaseBaseResource{client: client}
+	id := tftypes.NewValue(tftypes.Number, 1)
+
+	testCases := []struct {
+		name     string
+		resource fwresource.Resource
+		values   map[string]tftypes.Value
+	}{
+		{"card", &CardResource{base}, map[string]tftypes.Value{"id": id}},
+		{"card verification", &CardVerificationResource{base}, map[string]tftypes.Value{"card_id": id}},
+		{"collection", &CollectionResource{base}, map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, "1")}},
+		{"dashboard", &DashboardResource{base}, map[string]tftypes.Value{"id": id}},
+		{"database", &DatabaseResource{base}, map[string]tftypes.Value{"id": id}},
+		{"group members", &GroupMembersResource{base}, map[string]tftypes.Value{"group_id": id}},
+		{"model persistence", &ModelPersistenceResource{base}, map[string]tftypes.Value{"database_id": id}},
+		{"permissions group", &PermissionsGroupResource{base}, map[string]tftypes.Value{"id": id}},
+		{"sandbox", &SandboxResource{base}, map[string]tftypes.Value{"id": id}},
+		{"table", &TableResource{base}, map[string]tftypes.Value{"id": id}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := makeTestResourceState(t, tc.resource, tc.values)
+			resp := fwresource.ReadResponse{State: state}
+
+			tc.resource.Read(context.Background(), fwresource.ReadRequest{State: state}, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected error: %v.", resp.Diagnostics)
+			}
+			if !resp.State.Raw.IsNull() {
+				t.Errorf("Expected the resource to be removed from the state, got %s.", resp.State.Raw)
+			}
+		})
+	}
+}
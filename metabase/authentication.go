@@ -3,14 +3,43 @@ package metabase
 import (
 	"context"
 	"errors"
+	"net/http"
 
 	"github.com/deepmap/oapi-codegen/pkg/securityprovider"
 )
 
+// The default header in which the session ID is passed to authenticate calls.
+const DefaultSessionHeaderName = "X-Metabase-Session"
+
+// The default header in which the API key is passed to authenticate calls.
+const DefaultApiKeyHeaderName = "X-Api-Key"
+
+// Returns the given header name, or the default one if it is empty.
+func headerNameOrDefault(headerName string, defaultHeaderName string) string {
+	if len(headerName) == 0 {
+		return defaultHeaderName
+	}
+
+	return headerName
+}
+
+// Returns a client option adding the given headers to every request. This can be used to traverse an authentication
+// proxy in front of Metabase, and is distinct from the Metabase authentication itself.
+func WithHeaders(headers map[string]string) ClientOption {
+	return WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+
+		return nil
+	})
+}
+
 // Authenticates to the Metabase API using the given username and password, and returns an API client configured with
-// the session obtained during authentication.
-func MakeAuthenticatedClientWithUsernameAndPassword(ctx context.Context, endpoint string, username string, password string) (*ClientWithResponses, error) {
-	client, err := NewClientWithResponses(endpoint)
+// the session obtained during authentication. The session is passed in the `sessionHeaderName` header, or in the
+// default one if it is empty. Additional options are passed to all the clients created by the function.
+func MakeAuthenticatedClientWithUsernameAndPassword(ctx context.Context, endpoint string, username string, password string, sessionHeaderName string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClientWithResponses(endpoint, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -26,13 +55,20 @@ func MakeAuthenticatedClientWithUsernameAndPassword(ctx context.Context, endpoin
 		return nil, errors.New("received unexpected response from the Metabase session API")
 	}
 
+	return MakeAuthenticatedClientWithSessionToken(ctx, endpoint, sessionResp.JSON200.Id, sessionHeaderName, opts...)
+}
+
+// Returns an API client configured with an existing session, e.g. one created outside of this program. The session ID
+// is passed in the `sessionHeaderName` header, or in the default one if it is empty. Additional options are passed to
+// the created client.
+func MakeAuthenticatedClientWithSessionToken(ctx context.Context, endpoint string, sessionToken string, sessionHeaderName string, opts ...ClientOption) (*ClientWithResponses, error) {
 	// Authenticated calls are made by passing the session ID in a Metabase-specific header.
-	apiKeyProvider, err := securityprovider.NewSecurityProviderApiKey("header", "X-Metabase-Session", sessionResp.JSON200.Id)
+	apiKeyProvider, err := securityprovider.NewSecurityProviderApiKey("header", headerNameOrDefault(sessionHeaderName, DefaultSessionHeaderName), sessionToken)
 	if err != nil {
 		return nil, err
 	}
 
-	authenticatedClient, err := NewClientWithResponses(endpoint, WithRequestEditorFn(apiKeyProvider.Intercept))
+	authenticatedClient, err := NewClientWithResponses(endpoint, append([]ClientOption{WithRequestEditorFn(apiKeyProvider.Intercept)}, opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -40,14 +76,15 @@ func MakeAuthenticatedClientWithUsernameAndPassword(ctx context.Context, endpoin
 	return authenticatedClient, nil
 }
 
-// Returns an API client configured with the given API key.
-func MakeAuthenticatedClientWithApiKey(ctx context.Context, endpoint string, apiKey string) (*ClientWithResponses, error) {
-	apiKeyProvider, err := securityprovider.NewSecurityProviderApiKey("header", "X-Api-Key", apiKey)
+// Returns an API client configured with the given API key, passed in the `apiKeyHeaderName` header or in the default one
+// if it is empty. Additional options are passed to the created client.
+func MakeAuthenticatedClientWithApiKey(ctx context.Context, endpoint string, apiKey string, apiKeyHeaderName string, opts ...ClientOption) (*ClientWithResponses, error) {
+	apiKeyProvider, err := securityprovider.NewSecurityProviderApiKey("header", headerNameOrDefault(apiKeyHeaderName, DefaultApiKeyHeaderName), apiKey)
 	if err != nil {
 		return nil, err
 	}
 
-	authenticatedClient, err := NewClientWithResponses(endpoint, WithRequestEditorFn(apiKeyProvider.Intercept))
+	authenticatedClient, err := NewClientWithResponses(endpoint, append([]ClientOption{WithRequestEditorFn(apiKeyProvider.Intercept)}, opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,177 @@
+package metabase
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMakeAuthenticatedClientWithApiKeyAndHeaders(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		w.WriteHeader(404)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client, err := MakeAuthenticatedClientWithApiKey(ctx, server.URL, "key", "", WithHeaders(map[string]string{
+		"X-Forwarded-Access-Token": "token",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetCardWithResponse(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if received.Get("X-Forwarded-Access-Token") != "token" {
+		t.Errorf("expected the extra header to be sent, got headers %v", received)
+	}
+	if received.Get("X-Api-Key") != "key" {
+		t.Errorf("expected the API key to be sent, got headers %v", received)
+	}
+}
+
+func TestMakeAuthenticatedClientWithUsernameAndPasswordAndHeaders(t *testing.T) {
+	requestsWithoutHeader := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Forwarded-Access-Token") != "token" {
+			requestsWithoutHeader++
+		}
+
+		if r.URL.Path == "/session" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			w.Write([]byte(`{"id":"session"}`))
+			return
+		}
+
+		w.WriteHeader(404)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client, err := MakeAuthenticatedClientWithUsernameAndPassword(ctx, server.URL, "user", "password", "", WithHeaders(map[string]string{
+		"X-Forwarded-Access-Token": "token",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetCardWithResponse(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requestsWithoutHeader != 0 {
+		t.Errorf("expected the extra header to be sent with all requests, %d requests did not have it", requestsWithoutHeader)
+	}
+}
+
+func TestMakeAuthenticatedClientWithApiKeyDoesNotCreateSession(t *testing.T) {
+	sessionRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/session" {
+			sessionRequests++
+		}
+
+		w.WriteHeader(404)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client, err := MakeAuthenticatedClientWithApiKey(ctx, server.URL, "key", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetCardWithResponse(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if sessionRequests != 0 {
+		t.Errorf("expected no call to the session endpoint, got %d", sessionRequests)
+	}
+}
+
+func TestMakeAuthenticatedClientWithCustomHeaderNames(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/session" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			w.Write([]byte(`{"id":"session"}`))
+			return
+		}
+
+		received = r.Header.Clone()
+		w.WriteHeader(404)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client, err := MakeAuthenticatedClientWithApiKey(ctx, server.URL, "key", "X-Gateway-Api-Key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetCardWithResponse(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if received.Get("X-Gateway-Api-Key") != "key" || received.Get(DefaultApiKeyHeaderName) != "" {
+		t.Errorf("expected the API key to be sent in the custom header only, got headers %v", received)
+	}
+
+	client, err = MakeAuthenticatedClientWithUsernameAndPassword(ctx, server.URL, "user", "password", "X-Gateway-Session")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetCardWithResponse(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if received.Get("X-Gateway-Session") != "session" || received.Get(DefaultSessionHeaderName) != "" {
+		t.Errorf("expected the session to be sent in the custom header only, got headers %v", received)
+	}
+}
+
+func TestMakeAuthenticatedClientWithSessionToken(t *testing.T) {
+	sessionRequests := 0
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/session" {
+			sessionRequests++
+		}
+
+		received = r.Header.Clone()
+		w.WriteHeader(404)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client, err := MakeAuthenticatedClientWithSessionToken(ctx, server.URL, "existing-session", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetCardWithResponse(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if sessionRequests != 0 {
+		t.Errorf("expected no call to the session endpoint, got %d", sessionRequests)
+	}
+	if received.Get(DefaultSessionHeaderName) != "existing-session" {
+		t.Errorf("expected the session token to be sent, got headers %v", received)
+	}
+}
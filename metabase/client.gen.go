@@ -14,6 +14,7 @@ import (
 	"strings"
 
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
@@ -40,6 +41,13 @@ const (
 	CollectionPermissionLevelWrite CollectionPermissionLevel = "write"
 )
 
+// Defines values for DashboardEmbeddingParamState.
+const (
+	Disabled DashboardEmbeddingParamState = "disabled"
+	Enabled  DashboardEmbeddingParamState = "enabled"
+	Locked   DashboardEmbeddingParamState = "locked"
+)
+
 // Defines values for DatabaseDetailsBigQueryDatasetFiltersType.
 const (
 	All       DatabaseDetailsBigQueryDatasetFiltersType = "all"
@@ -50,13 +58,15 @@ const (
 // Defines values for DatabaseEngine.
 const (
 	BigqueryCloudSdk DatabaseEngine = "bigquery-cloud-sdk"
+	Clickhouse       DatabaseEngine = "clickhouse"
 )
 
 // Defines values for PermissionsGraphDatabaseAccessSchemas0.
 const (
-	PermissionsGraphDatabaseAccessSchemas0All  PermissionsGraphDatabaseAccessSchemas0 = "all"
-	PermissionsGraphDatabaseAccessSchemas0Full PermissionsGraphDatabaseAccessSchemas0 = "full"
-	PermissionsGraphDatabaseAccessSchemas0None PermissionsGraphDatabaseAccessSchemas0 = "none"
+	PermissionsGraphDatabaseAccessSchemas0All     PermissionsGraphDatabaseAccessSchemas0 = "all"
+	PermissionsGraphDatabaseAccessSchemas0Full    PermissionsGraphDatabaseAccessSchemas0 = "full"
+	PermissionsGraphDatabaseAccessSchemas0Limited PermissionsGraphDatabaseAccessSchemas0 = "limited"
+	PermissionsGraphDatabaseAccessSchemas0None    PermissionsGraphDatabaseAccessSchemas0 = "none"
 )
 
 // Defines values for PermissionsGraphDatabasePermissionsCreateQueries.
@@ -94,11 +104,47 @@ type Card struct {
 	// Id The ID of the card.
 	Id int `json:"id"`
 
+	// ModerationReviews The moderation reviews of the card. Only returned by paid versions of Metabase.
+	ModerationReviews *[]ModerationReview `json:"moderation_reviews,omitempty"`
+
 	// Name The name of the card.
 	Name                 string                 `json:"name"`
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
+// CardDashboard A dashboard in which a card is used.
+type CardDashboard struct {
+	// Id The ID of the dashboard.
+	Id int `json:"id"`
+
+	// Name The name of the dashboard.
+	Name                 string                 `json:"name"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// CardRelated The objects related to a card.
+type CardRelated struct {
+	// DashboardMates The cards placed in the same dashboards as the card.
+	DashboardMates *[]CardRelatedItem `json:"dashboard-mates,omitempty"`
+
+	// Dashboards The dashboards recommended as related to the card. They do not necessarily contain the card, and the list is capped.
+	Dashboards *[]CardRelatedItem `json:"dashboards,omitempty"`
+
+	// SimilarQuestions Questions similar to the card, e.g. querying the same table.
+	SimilarQuestions     *[]CardRelatedItem     `json:"similar-questions,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// CardRelatedItem A card or dashboard related to a card.
+type CardRelatedItem struct {
+	// Id The ID of the related object.
+	Id int `json:"id"`
+
+	// Name The name of the related object.
+	Name                 string                 `json:"name"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
 // Collection A collection that regroups dashboards and cards.
 type Collection struct {
 	// Archived Whether the collection is archived.
@@ -129,6 +175,10 @@ type Collection struct {
 
 	// Slug The slug for the collection, used in URLs.
 	Slug *string `json:"slug,omitempty"`
+
+	// Type The type of the collection, which is null for regular collections.
+	// Special collections managed by Metabase itself have a type, e.g. `instance-analytics` or `trash`.
+	Type *string `json:"type"`
 }
 
 // CollectionId0 defines model for .
@@ -243,6 +293,33 @@ type CreateDatabaseBody struct {
 
 	// Name The user-displayable name for the database.
 	Name string `json:"name"`
+
+	// Settings Database-level settings, e.g. `database-enable-actions` or `persist-models-enabled`.
+	Settings *DatabaseSettings `json:"settings,omitempty"`
+}
+
+// CreateFieldDimensionBody The payload used to set the dimension of a field.
+type CreateFieldDimensionBody struct {
+	// HumanReadableFieldId For `external` dimensions, the ID of the field whose values are displayed instead.
+	HumanReadableFieldId *int `json:"human_readable_field_id"`
+
+	// Name The name displayed for the remapped field.
+	Name string `json:"name"`
+
+	// Type The type of remapping, either `external` (using another field) or `internal` (using custom values).
+	Type string `json:"type"`
+}
+
+// CreateModerationReviewBody The payload when creating a moderation review.
+type CreateModerationReviewBody struct {
+	// ModeratedItemId The ID of the reviewed item.
+	ModeratedItemId int `json:"moderated_item_id"`
+
+	// ModeratedItemType The type of the reviewed item, e.g. `card`.
+	ModeratedItemType string `json:"moderated_item_type"`
+
+	// Status The status of the review, e.g. `verified`, or `null` to remove a previous verification.
+	Status *string `json:"status"`
 }
 
 // CreatePermissionsGroupBody The payload used to create a new permissions group.
@@ -251,6 +328,30 @@ type CreatePermissionsGroupBody struct {
 	Name string `json:"name"`
 }
 
+// CreatePermissionsMembershipBody The payload used to add a user to a permissions group.
+type CreatePermissionsMembershipBody struct {
+	// GroupId The ID of the permissions group.
+	GroupId int `json:"group_id"`
+
+	// UserId The ID of the user to add to the group.
+	UserId int `json:"user_id"`
+}
+
+// CreateSandboxBody The payload used to create a new sandbox.
+type CreateSandboxBody struct {
+	// AttributeRemappings A map between user attributes and the field or variable they filter on.
+	AttributeRemappings *SandboxAttributeRemappings `json:"attribute_remappings"`
+
+	// CardId The ID of the card (question) used to filter the table.
+	CardId *int `json:"card_id"`
+
+	// GroupId The ID of the permissions group to which the sandbox applies.
+	GroupId int `json:"group_id"`
+
+	// TableId The ID of the sandboxed table.
+	TableId int `json:"table_id"`
+}
+
 // CreateSessionBody The credentials required to create a session.
 type CreateSessionBody struct {
 	// Password The password for the account.
@@ -280,6 +381,15 @@ type Dashboard struct {
 	// Description A description for the dashboard.
 	Description *string `json:"description"`
 
+	// EmbeddingParams A map where keys are parameter slugs and values define how the parameter can be used in signed embeds.
+	EmbeddingParams *DashboardEmbeddingParams `json:"embedding_params"`
+
+	// EnableEmbedding Whether the dashboard can be embedded using signed embeds.
+	EnableEmbedding *bool `json:"enable_embedding,omitempty"`
+
+	// EntityId A unique string identifier for the dashboard, used by serialization.
+	EntityId *string `json:"entity_id,omitempty"`
+
 	// Id The ID of the dashboard.
 	Id int `json:"id"`
 
@@ -288,6 +398,9 @@ type Dashboard struct {
 
 	// Parameters A list of parameters for the dashboard, that the user can tweak.
 	Parameters []DashboardParameter `json:"parameters"`
+
+	// Tabs The list of tabs in the dashboard. Older versions of Metabase do not support tabs.
+	Tabs *[]DashboardTab `json:"tabs,omitempty"`
 }
 
 // DashboardCard A card within a dashboard.
@@ -298,6 +411,9 @@ type DashboardCard struct {
 	// Col The index of the column at which the card is placed.
 	Col int `json:"col"`
 
+	// DashboardTabId The ID of the tab in which the card is placed, if the dashboard has tabs.
+	DashboardTabId *int `json:"dashboard_tab_id,omitempty"`
+
 	// Id The ID of the dashboard card.
 	Id int `json:"id"`
 
@@ -320,6 +436,12 @@ type DashboardCard struct {
 	VisualizationSettings map[string]interface{} `json:"visualization_settings"`
 }
 
+// DashboardEmbeddingParamState How a dashboard parameter can be used in signed embeds.
+type DashboardEmbeddingParamState string
+
+// DashboardEmbeddingParams A map where keys are parameter slugs and values define how the parameter can be used in signed embeds.
+type DashboardEmbeddingParams map[string]DashboardEmbeddingParamState
+
 // DashboardParameter A parameter for a dashboard, that the user can tweak.
 type DashboardParameter struct {
 	// Default The default value for the parameter.
@@ -359,6 +481,29 @@ type DashboardParameter_Default struct {
 	union json.RawMessage
 }
 
+// DashboardQueryMetadata The metadata referenced by the cards in a dashboard.
+// Tables and fields are left untyped, as virtual tables for cards (e.g. `card__12`) use string IDs.
+type DashboardQueryMetadata struct {
+	// Fields Additional fields referenced by the cards, e.g. foreign key targets.
+	Fields *[]map[string]interface{} `json:"fields,omitempty"`
+
+	// Tables The tables referenced by the cards, along with their fields.
+	Tables               *[]map[string]interface{} `json:"tables,omitempty"`
+	AdditionalProperties map[string]interface{}    `json:"-"`
+}
+
+// DashboardTab A tab within a dashboard.
+type DashboardTab struct {
+	// Id The ID of the tab.
+	Id int `json:"id"`
+
+	// Name The name of the tab.
+	Name string `json:"name"`
+
+	// Position The position of the tab in the dashboard.
+	Position *int `json:"position,omitempty"`
+}
+
 // Database An external database that can be queried by cards and dashboards.
 type Database struct {
 	// Details Engine-specific details used to configure the connection to the database.
@@ -367,11 +512,26 @@ type Database struct {
 	// Engine The type of database to connect to.
 	Engine DatabaseEngine `json:"engine"`
 
+	// Features The list of features supported by the database engine, e.g. `nested-queries`.
+	Features *[]string `json:"features,omitempty"`
+
 	// Id The ID for the database.
 	Id int `json:"id"`
 
+	// InitialSyncStatus The status of the first sync of the database, e.g. `incomplete` or `complete`.
+	InitialSyncStatus *string `json:"initial_sync_status,omitempty"`
+
+	// IsSample Whether this is the sample database shipped with Metabase.
+	IsSample *bool `json:"is_sample,omitempty"`
+
 	// Name The user-displayable name for the database.
 	Name string `json:"name"`
+
+	// Settings Database-level settings, e.g. `database-enable-actions` or `persist-models-enabled`.
+	Settings *DatabaseSettings `json:"settings,omitempty"`
+
+	// UpdatedAt The last time the database was modified, as an ISO 8601 timestamp.
+	UpdatedAt *string `json:"updated_at,omitempty"`
 }
 
 // DatabaseDetails Engine-specific details used to configure the connection to the database.
@@ -397,6 +557,30 @@ type DatabaseDetailsBigQuery struct {
 // DatabaseDetailsBigQueryDatasetFiltersType The behavior of how BigQuery datasets should be selected.
 type DatabaseDetailsBigQueryDatasetFiltersType string
 
+// DatabaseDetailsClickHouse The content of the `details` map for a database when connecting to ClickHouse.
+type DatabaseDetailsClickHouse struct {
+	// AdditionalOptions Additional options appended to the JDBC connection string.
+	AdditionalOptions *string `json:"additional-options,omitempty"`
+
+	// Dbname The name of the database.
+	Dbname *string `json:"dbname,omitempty"`
+
+	// Host The host name or IP address of the ClickHouse server.
+	Host string `json:"host"`
+
+	// Password The password used to connect to the database.
+	Password *string `json:"password,omitempty"`
+
+	// Port The HTTP port of the ClickHouse server.
+	Port *int `json:"port,omitempty"`
+
+	// Ssl Whether to use TLS when connecting to the server.
+	Ssl *bool `json:"ssl,omitempty"`
+
+	// User The user name used to connect to the database.
+	User *string `json:"user,omitempty"`
+}
+
 // DatabaseDetailsCustom A JSON object containing database details for unsupported engines.
 type DatabaseDetailsCustom map[string]interface{}
 
@@ -412,32 +596,143 @@ type DatabaseList struct {
 	Total int `json:"total"`
 }
 
+// DatabaseSettings Database-level settings, e.g. `database-enable-actions` or `persist-models-enabled`.
+type DatabaseSettings map[string]interface{}
+
+// DatabaseValidationResult The result of the validation of connection details.
+type DatabaseValidationResult struct {
+	// Message The error returned by the driver, if any. This is not returned by all versions of Metabase.
+	Message *string `json:"message,omitempty"`
+
+	// Valid Whether Metabase could connect to the database.
+	Valid bool `json:"valid"`
+}
+
+// EnablePersistenceBody The payload when enabling or disabling model persistence for the instance.
+type EnablePersistenceBody struct {
+	// Enabled Whether model persistence is enabled.
+	Enabled bool `json:"enabled"`
+}
+
 // Field A field in a database.
 type Field struct {
+	// BaseType The type of the field in the database, as understood by Metabase (e.g. `type/Text`).
+	BaseType *string `json:"base_type,omitempty"`
+
+	// Caveats Things to be aware of about the field.
+	Caveats *string `json:"caveats"`
+
 	// Description The description of the field.
 	Description *string `json:"description"`
 
+	// Dimensions The dimensions of the field, defining how its values are remapped when displayed. Metabase supports at most one dimension per field.
+	Dimensions *[]FieldDimension `json:"dimensions,omitempty"`
+
 	// DisplayName The user-displayable name for the field.
 	DisplayName string `json:"display_name"`
 
+	// EffectiveType The type used by Metabase for the field, which can differ from the base type when a coercion strategy is set.
+	EffectiveType *string `json:"effective_type"`
+
 	// Id The ID of the field.
 	Id int `json:"id"`
 
 	// Name The name of the field (column) in the table.
 	Name string `json:"name"`
 
+	// PointsOfInterest What is useful or interesting about the field.
+	PointsOfInterest *string `json:"points_of_interest"`
+
 	// SemanticType The semantic type used by Metabase to improve the display and use of the field.
 	SemanticType *string `json:"semantic_type"`
 
+	// Settings The display settings for the field, e.g. number formatting or date styles.
+	Settings *map[string]interface{} `json:"settings"`
+
 	// TableId The ID of the parent table.
 	TableId int `json:"table_id"`
 }
 
+// FieldDimension Defines how the values of a field are remapped when displayed, e.g. to show a name instead of a foreign key.
+type FieldDimension struct {
+	// HumanReadableFieldId For `external` dimensions, the ID of the field whose values are displayed instead.
+	HumanReadableFieldId *int `json:"human_readable_field_id"`
+
+	// Id The ID of the dimension.
+	Id *int `json:"id,omitempty"`
+
+	// Name The name displayed for the remapped field.
+	Name string `json:"name"`
+
+	// Type The type of remapping, either `external` (using another field) or `internal` (using custom values).
+	Type string `json:"type"`
+}
+
+// FieldValues The distinct values of a field.
+type FieldValues struct {
+	// FieldId The ID of the field.
+	FieldId *int `json:"field_id,omitempty"`
+
+	// HasMoreValues Whether the field has more values than the ones returned.
+	HasMoreValues *bool `json:"has_more_values,omitempty"`
+
+	// Values The values of the field. Each value is an array containing the raw value, optionally followed by its human-readable label.
+	Values [][]interface{} `json:"values"`
+}
+
+// Impersonation A connection impersonation, mapping a group to a database role using a user attribute.
+type Impersonation struct {
+	// Attribute The user attribute containing the name of the database role to use.
+	Attribute string `json:"attribute"`
+
+	// DbId The ID of the database to which the impersonation applies.
+	DbId int `json:"db_id"`
+
+	// GroupId The ID of the permissions group to which the impersonation applies.
+	GroupId int `json:"group_id"`
+
+	// Id The ID of the impersonation.
+	Id int `json:"id"`
+}
+
+// ImpersonationParams The definition of a connection impersonation, as sent in the permissions graph.
+type ImpersonationParams struct {
+	// Attribute The user attribute containing the name of the database role to use.
+	Attribute string `json:"attribute"`
+
+	// DbId The ID of the database to which the impersonation applies.
+	DbId int `json:"db_id"`
+
+	// GroupId The ID of the permissions group to which the impersonation applies.
+	GroupId int `json:"group_id"`
+}
+
+// ModerationReview A moderation review, e.g. the verification of a card.
+type ModerationReview struct {
+	// Id The ID of the moderation review.
+	Id int `json:"id"`
+
+	// ModeratedItemId The ID of the reviewed item.
+	ModeratedItemId int `json:"moderated_item_id"`
+
+	// ModeratedItemType The type of the reviewed item, e.g. `card`.
+	ModeratedItemType string `json:"moderated_item_type"`
+
+	// MostRecent Whether this is the most recent review of the item.
+	MostRecent bool `json:"most_recent"`
+
+	// Status The status of the review, e.g. `verified`. A `null` status removes a previous verification.
+	Status *string `json:"status"`
+}
+
 // PermissionsGraph The entire permission graph for databases.
 type PermissionsGraph struct {
 	// Groups A map where keys are group IDs and values are permissions for this group.
 	Groups map[string]PermissionsGraphDatabasePermissionsMap `json:"groups"`
 
+	// Impersonations Connection impersonations to create or update along with the graph. Only available in paid versions of Metabase.
+	Impersonations *[]ImpersonationParams `json:"impersonations,omitempty"`
+
 	// Revision The revision of the permissions graph.
 	Revision int `json:"revision"`
 }
@@ -450,6 +745,9 @@ type PermissionsGraphDatabaseAccess struct {
 // PermissionsGraphDatabaseAccessSchemas0 Whether "Data access" is allowed.
 type PermissionsGraphDatabaseAccessSchemas0 string
 
+// PermissionsGraphDatabaseAccessSchemas1 The permissions for each schema, where keys are schema names.
+type PermissionsGraphDatabaseAccessSchemas1 map[string]string
+
 // PermissionsGraphDatabaseAccess_Schemas defines model for PermissionsGraphDatabaseAccess.Schemas.
 type PermissionsGraphDatabaseAccess_Schemas struct {
 	union json.RawMessage
@@ -490,17 +788,88 @@ type PermissionsGroup struct {
 	// Id The ID of the permissions group.
 	Id int `json:"id"`
 
+	// MemberCount The number of users in the group. Only returned when listing groups.
+	MemberCount *int `json:"member_count,omitempty"`
+
+	// Members The users in the group. Only returned when getting a single group.
+	Members *[]PermissionsGroupMember `json:"members,omitempty"`
+
 	// Name A user-displayable name for the group.
 	Name string `json:"name"`
 }
 
+// PermissionsGroupMember A user belonging to a permissions group.
+type PermissionsGroupMember struct {
+	// MembershipId The ID of the membership of the user in the group.
+	MembershipId *int `json:"membership_id,omitempty"`
+
+	// UserId The ID of the user.
+	UserId int `json:"user_id"`
+}
+
+// PersistedInfo The persistence state of a model.
+type PersistedInfo struct {
+	// CardId The ID of the model.
+	CardId int `json:"card_id"`
+
+	// DatabaseId The ID of the database the model is persisted to.
+	DatabaseId int `json:"database_id"`
+
+	// State The state of the persisted model, e.g. `persisted`, `creating`, `refreshing`, `off`, or `deletable`.
+	State string `json:"state"`
+}
+
+// Sandbox A sandbox (or group table access policy), restricting the rows of a table a group can access.
+type Sandbox struct {
+	// AttributeRemappings A map between user attributes and the field or variable they filter on.
+	AttributeRemappings *SandboxAttributeRemappings `json:"attribute_remappings"`
+
+	// CardId The ID of the card (question) used to filter the table.
+	CardId *int `json:"card_id"`
+
+	// GroupId The ID of the permissions group to which the sandbox applies.
+	GroupId int `json:"group_id"`
+
+	// Id The ID of the sandbox.
+	Id int `json:"id"`
+
+	// TableId The ID of the sandboxed table.
+	TableId int `json:"table_id"`
+}
+
+// SandboxAttributeRemappings A map between user attributes and the field or variable they filter on.
+type SandboxAttributeRemappings map[string]interface{}
+
 // Session A session that can be used to perform authenticated requests to the API.
 type Session struct {
 	Id string `json:"id"`
 }
 
+// SessionProperties The public settings of the Metabase instance.
+type SessionProperties struct {
+	// Version The version of the Metabase instance.
+	Version              *SessionProperties_Version `json:"version,omitempty"`
+	AdditionalProperties map[string]interface{}     `json:"-"`
+}
+
+// SessionProperties_Version The version of the Metabase instance.
+type SessionProperties_Version struct {
+	// Tag The version tag, e.g. `v0.50.3` or `v1.50.3` for paid versions.
+	Tag                  *string                `json:"tag,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// SetPersistenceRefreshScheduleBody The payload when setting the refresh schedule for persisted models.
+type SetPersistenceRefreshScheduleBody struct {
+	// Cron The schedule as a Quartz cron expression, e.g. `0 0 0/6 * * ? *`.
+	Cron string `json:"cron"`
+}
+
 // Table A table in a database.
 type Table struct {
+	// Caveats Things to be aware of about the table.
+	Caveats *string `json:"caveats"`
+
 	// DbId The ID of the parent database.
 	DbId int `json:"db_id"`
 
@@ -516,16 +885,57 @@ type Table struct {
 	// Id The ID of the table.
 	Id int `json:"id"`
 
+	// InitialSyncStatus The status of the first sync of the table, e.g. `incomplete` or `complete`.
+	InitialSyncStatus *string `json:"initial_sync_status,omitempty"`
+
 	// Name The name of the table.
 	Name string `json:"name"`
 
+	// PointsOfInterest What is useful or interesting about the table.
+	PointsOfInterest *string `json:"points_of_interest"`
+
 	// Schema The database schema in which the table is located.
 	// For BigQuery, this is the dataset name.
 	Schema *string `json:"schema"`
+
+	// VisibilityType Why the table is hidden (e.g. `hidden` or `technical`), or `null` if it is visible.
+	VisibilityType *string `json:"visibility_type"`
+}
+
+// TableForeignKey A foreign key referencing a field of a table.
+type TableForeignKey struct {
+	// Destination One end of a foreign key.
+	Destination TableForeignKeyField `json:"destination"`
+
+	// DestinationId The ID of the referenced field.
+	DestinationId int `json:"destination_id"`
+
+	// Origin One end of a foreign key.
+	Origin TableForeignKeyField `json:"origin"`
+
+	// OriginId The ID of the foreign key field, in the referencing table.
+	OriginId             int                    `json:"origin_id"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// TableForeignKeyField One end of a foreign key.
+type TableForeignKeyField struct {
+	// Id The ID of the field.
+	Id int `json:"id"`
+
+	// Name The name of the field.
+	Name string `json:"name"`
+
+	// TableId The ID of the table containing the field.
+	TableId              int                    `json:"table_id"`
+	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
 // TableMetadata defines model for TableMetadata.
 type TableMetadata struct {
+	// Caveats Things to be aware of about the table.
+	Caveats *string `json:"caveats"`
+
 	// DbId The ID of the parent database.
 	DbId int `json:"db_id"`
 
@@ -544,12 +954,21 @@ type TableMetadata struct {
 	// Id The ID of the table.
 	Id int `json:"id"`
 
+	// InitialSyncStatus The status of the first sync of the table, e.g. `incomplete` or `complete`.
+	InitialSyncStatus *string `json:"initial_sync_status,omitempty"`
+
 	// Name The name of the table.
 	Name string `json:"name"`
 
+	// PointsOfInterest What is useful or interesting about the table.
+	PointsOfInterest *string `json:"points_of_interest"`
+
 	// Schema The database schema in which the table is located.
 	// For BigQuery, this is the dataset name.
 	Schema *string `json:"schema"`
+
+	// VisibilityType Why the table is hidden (e.g. `hidden` or `technical`), or `null` if it is visible.
+	VisibilityType *string `json:"visibility_type"`
 }
 
 // UpdateCardBody The payload when updating an existing card.
@@ -595,11 +1014,20 @@ type UpdateDashboardBody struct {
 	// Description A description for the dashboard.
 	Description *string `json:"description"`
 
+	// EmbeddingParams A map where keys are parameter slugs and values define how the parameter can be used in signed embeds.
+	EmbeddingParams *DashboardEmbeddingParams `json:"embedding_params"`
+
+	// EnableEmbedding Whether the dashboard can be embedded using signed embeds.
+	EnableEmbedding *bool `json:"enable_embedding,omitempty"`
+
 	// Name The name of the dashboard.
 	Name *string `json:"name,omitempty"`
 
 	// Parameters A list of parameters for the dashboard, that the user can tweak.
 	Parameters *[]DashboardParameter `json:"parameters,omitempty"`
+
+	// Tabs The list of tabs in the dashboard. Older versions of Metabase do not support tabs.
+	Tabs *[]DashboardTab `json:"tabs,omitempty"`
 }
 
 // UpdateDatabaseBody The payload used to update an existing database.
@@ -612,18 +1040,36 @@ type UpdateDatabaseBody struct {
 
 	// Name The user-displayable name for the database.
 	Name *string `json:"name,omitempty"`
+
+	// Settings Database-level settings, e.g. `database-enable-actions` or `persist-models-enabled`.
+	Settings *DatabaseSettings `json:"settings,omitempty"`
 }
 
 // UpdateFieldBody The payload used to update a table field.
 type UpdateFieldBody struct {
+	// Caveats Things to be aware of about the field.
+	Caveats *string `json:"caveats,omitempty"`
+
 	// Description The description of the field.
 	Description *string `json:"description"`
 
 	// DisplayName The user-displayable name for the field.
 	DisplayName *string `json:"display_name,omitempty"`
 
+	// PointsOfInterest What is useful or interesting about the field.
+	PointsOfInterest *string `json:"points_of_interest,omitempty"`
+
 	// SemanticType The semantic type used by Metabase to improve the display and use of the field.
 	SemanticType *string `json:"semantic_type"`
+
+	// Settings The display settings for the field. This replaces all existing settings.
+	Settings *map[string]interface{} `json:"settings,omitempty"`
+}
+
+// UpdateFieldValuesBody The payload used to set the values of a field.
+type UpdateFieldValuesBody struct {
+	// Values The values of the field. Each value is an array containing the raw value, optionally followed by its human-readable label.
+	Values [][]interface{} `json:"values"`
 }
 
 // UpdatePermissionsGroupBody The payload used to update an existing permissions group.
@@ -632,8 +1078,20 @@ type UpdatePermissionsGroupBody struct {
 	Name string `json:"name"`
 }
 
+// UpdateSandboxBody The payload used to update an existing sandbox.
+type UpdateSandboxBody struct {
+	// AttributeRemappings A map between user attributes and the field or variable they filter on.
+	AttributeRemappings *SandboxAttributeRemappings `json:"attribute_remappings"`
+
+	// CardId The ID of the card (question) used to filter the table.
+	CardId *int `json:"card_id"`
+}
+
 // UpdateTableBody The payload used to update a table.
 type UpdateTableBody struct {
+	// Caveats Things to be aware of about the table.
+	Caveats *string `json:"caveats,omitempty"`
+
 	// Description A description for the table.
 	Description *string `json:"description,omitempty"`
 
@@ -642,6 +1100,24 @@ type UpdateTableBody struct {
 
 	// EntityType The type of table.
 	EntityType *string `json:"entity_type,omitempty"`
+
+	// PointsOfInterest What is useful or interesting about the table.
+	PointsOfInterest *string `json:"points_of_interest,omitempty"`
+}
+
+// ValidateDatabaseBody The payload used to validate the connection details for a database.
+type ValidateDatabaseBody struct {
+	// Details The engine and details to validate.
+	Details ValidateDatabaseDetails `json:"details"`
+}
+
+// ValidateDatabaseDetails The engine and details to validate.
+type ValidateDatabaseDetails struct {
+	// Details Engine-specific details used to configure the connection to the database.
+	Details DatabaseDetails `json:"details"`
+
+	// Engine The type of database to connect to.
+	Engine DatabaseEngine `json:"engine"`
 }
 
 // ListCollectionsParams defines parameters for ListCollections.
@@ -677,6 +1153,36 @@ type ListDatabasesParams struct {
 // ListDatabasesParamsInclude defines parameters for ListDatabases.
 type ListDatabasesParamsInclude string
 
+// GetImpersonationParams defines parameters for GetImpersonation.
+type GetImpersonationParams struct {
+	// GroupId The ID of the permissions group.
+	GroupId int `form:"group_id" json:"group_id"`
+
+	// DbId The ID of the database.
+	DbId int `form:"db_id" json:"db_id"`
+}
+
+// ExportSerializationParams defines parameters for ExportSerialization.
+type ExportSerializationParams struct {
+	// AllCollections Whether all collections should be exported.
+	AllCollections *bool `form:"all_collections,omitempty" json:"all_collections,omitempty"`
+
+	// Settings Whether instance settings should be exported.
+	Settings *bool `form:"settings,omitempty" json:"settings,omitempty"`
+
+	// DataModel Whether the data model (databases, tables, and fields) should be exported.
+	DataModel *bool `form:"data_model,omitempty" json:"data_model,omitempty"`
+
+	// FieldValues Whether the values of fields should be exported.
+	FieldValues *bool `form:"field_values,omitempty" json:"field_values,omitempty"`
+}
+
+// ImportSerializationMultipartBody defines parameters for ImportSerialization.
+type ImportSerializationMultipartBody struct {
+	// File The gzipped tarball produced by the export.
+	File openapi_types.File `json:"file"`
+}
+
 // GetTableMetadataParams defines parameters for GetTableMetadata.
 type GetTableMetadataParams struct {
 	// IncludeHiddenFields Whether the query should return hidden fields.
@@ -707,12 +1213,33 @@ type UpdateDashboardJSONRequestBody = UpdateDashboardBody
 // CreateDatabaseJSONRequestBody defines body for CreateDatabase for application/json ContentType.
 type CreateDatabaseJSONRequestBody = CreateDatabaseBody
 
+// ValidateDatabaseJSONRequestBody defines body for ValidateDatabase for application/json ContentType.
+type ValidateDatabaseJSONRequestBody = ValidateDatabaseBody
+
 // UpdateDatabaseJSONRequestBody defines body for UpdateDatabase for application/json ContentType.
 type UpdateDatabaseJSONRequestBody = UpdateDatabaseBody
 
+// ImportSerializationMultipartRequestBody defines body for ImportSerialization for multipart/form-data ContentType.
+type ImportSerializationMultipartRequestBody ImportSerializationMultipartBody
+
 // UpdateFieldJSONRequestBody defines body for UpdateField for application/json ContentType.
 type UpdateFieldJSONRequestBody = UpdateFieldBody
 
+// CreateFieldDimensionJSONRequestBody defines body for CreateFieldDimension for application/json ContentType.
+type CreateFieldDimensionJSONRequestBody = CreateFieldDimensionBody
+
+// UpdateFieldValuesJSONRequestBody defines body for UpdateFieldValues for application/json ContentType.
+type UpdateFieldValuesJSONRequestBody = UpdateFieldValuesBody
+
+// CreateModerationReviewJSONRequestBody defines body for CreateModerationReview for application/json ContentType.
+type CreateModerationReviewJSONRequestBody = CreateModerationReviewBody
+
+// CreateSandboxJSONRequestBody defines body for CreateSandbox for application/json ContentType.
+type CreateSandboxJSONRequestBody = CreateSandboxBody
+
+// UpdateSandboxJSONRequestBody defines body for UpdateSandbox for application/json ContentType.
+type UpdateSandboxJSONRequestBody = UpdateSandboxBody
+
 // ReplacePermissionsGraphJSONRequestBody defines body for ReplacePermissionsGraph for application/json ContentType.
 type ReplacePermissionsGraphJSONRequestBody = PermissionsGraph
 
@@ -722,6 +1249,15 @@ type CreatePermissionsGroupJSONRequestBody = CreatePermissionsGroupBody
 // UpdatePermissionsGroupJSONRequestBody defines body for UpdatePermissionsGroup for application/json ContentType.
 type UpdatePermissionsGroupJSONRequestBody = UpdatePermissionsGroupBody
 
+// CreatePermissionsMembershipJSONRequestBody defines body for CreatePermissionsMembership for application/json ContentType.
+type CreatePermissionsMembershipJSONRequestBody = CreatePermissionsMembershipBody
+
+// EnablePersistenceJSONRequestBody defines body for EnablePersistence for application/json ContentType.
+type EnablePersistenceJSONRequestBody = EnablePersistenceBody
+
+// SetPersistenceRefreshScheduleJSONRequestBody defines body for SetPersistenceRefreshSchedule for application/json ContentType.
+type SetPersistenceRefreshScheduleJSONRequestBody = SetPersistenceRefreshScheduleBody
+
 // CreateSessionJSONRequestBody defines body for CreateSession for application/json ContentType.
 type CreateSessionJSONRequestBody = CreateSessionBody
 
@@ -769,6 +1305,14 @@ func (a *Card) UnmarshalJSON(b []byte) error {
 		delete(object, "id")
 	}
 
+	if raw, found := object["moderation_reviews"]; found {
+		err = json.Unmarshal(raw, &a.ModerationReviews)
+		if err != nil {
+			return fmt.Errorf("error reading 'moderation_reviews': %w", err)
+		}
+		delete(object, "moderation_reviews")
+	}
+
 	if raw, found := object["name"]; found {
 		err = json.Unmarshal(raw, &a.Name)
 		if err != nil {
@@ -806,6 +1350,13 @@ func (a Card) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'id': %w", err)
 	}
 
+	if a.ModerationReviews != nil {
+		object["moderation_reviews"], err = json.Marshal(a.ModerationReviews)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'moderation_reviews': %w", err)
+		}
+	}
+
 	object["name"], err = json.Marshal(a.Name)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling 'name': %w", err)
@@ -820,47 +1371,31 @@ func (a Card) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for DashboardParameter. Returns the specified
+// Getter for additional properties for CardDashboard. Returns the specified
 // element and whether it was found
-func (a DashboardParameter) Get(fieldName string) (value interface{}, found bool) {
+func (a CardDashboard) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for DashboardParameter
-func (a *DashboardParameter) Set(fieldName string, value interface{}) {
+// Setter for additional properties for CardDashboard
+func (a *CardDashboard) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for DashboardParameter to handle AdditionalProperties
-func (a *DashboardParameter) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for CardDashboard to handle AdditionalProperties
+func (a *CardDashboard) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["default"]; found {
-		err = json.Unmarshal(raw, &a.Default)
-		if err != nil {
-			return fmt.Errorf("error reading 'default': %w", err)
-		}
-		delete(object, "default")
-	}
-
-	if raw, found := object["filteringParameters"]; found {
-		err = json.Unmarshal(raw, &a.FilteringParameters)
-		if err != nil {
-			return fmt.Errorf("error reading 'filteringParameters': %w", err)
-		}
-		delete(object, "filteringParameters")
-	}
-
 	if raw, found := object["id"]; found {
 		err = json.Unmarshal(raw, &a.Id)
 		if err != nil {
@@ -877,15 +1412,287 @@ func (a *DashboardParameter) UnmarshalJSON(b []byte) error {
 		delete(object, "name")
 	}
 
-	if raw, found := object["required"]; found {
-		err = json.Unmarshal(raw, &a.Required)
-		if err != nil {
-			return fmt.Errorf("error reading 'required': %w", err)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
 		}
-		delete(object, "required")
 	}
+	return nil
+}
 
-	if raw, found := object["sectionId"]; found {
+// Override default JSON handling for CardDashboard to handle AdditionalProperties
+func (a CardDashboard) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["id"], err = json.Marshal(a.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'id': %w", err)
+	}
+
+	object["name"], err = json.Marshal(a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for CardRelated. Returns the specified
+// element and whether it was found
+func (a CardRelated) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for CardRelated
+func (a *CardRelated) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for CardRelated to handle AdditionalProperties
+func (a *CardRelated) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["dashboard-mates"]; found {
+		err = json.Unmarshal(raw, &a.DashboardMates)
+		if err != nil {
+			return fmt.Errorf("error reading 'dashboard-mates': %w", err)
+		}
+		delete(object, "dashboard-mates")
+	}
+
+	if raw, found := object["dashboards"]; found {
+		err = json.Unmarshal(raw, &a.Dashboards)
+		if err != nil {
+			return fmt.Errorf("error reading 'dashboards': %w", err)
+		}
+		delete(object, "dashboards")
+	}
+
+	if raw, found := object["similar-questions"]; found {
+		err = json.Unmarshal(raw, &a.SimilarQuestions)
+		if err != nil {
+			return fmt.Errorf("error reading 'similar-questions': %w", err)
+		}
+		delete(object, "similar-questions")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for CardRelated to handle AdditionalProperties
+func (a CardRelated) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.DashboardMates != nil {
+		object["dashboard-mates"], err = json.Marshal(a.DashboardMates)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'dashboard-mates': %w", err)
+		}
+	}
+
+	if a.Dashboards != nil {
+		object["dashboards"], err = json.Marshal(a.Dashboards)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'dashboards': %w", err)
+		}
+	}
+
+	if a.SimilarQuestions != nil {
+		object["similar-questions"], err = json.Marshal(a.SimilarQuestions)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'similar-questions': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for CardRelatedItem. Returns the specified
+// element and whether it was found
+func (a CardRelatedItem) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for CardRelatedItem
+func (a *CardRelatedItem) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for CardRelatedItem to handle AdditionalProperties
+func (a *CardRelatedItem) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["id"]; found {
+		err = json.Unmarshal(raw, &a.Id)
+		if err != nil {
+			return fmt.Errorf("error reading 'id': %w", err)
+		}
+		delete(object, "id")
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for CardRelatedItem to handle AdditionalProperties
+func (a CardRelatedItem) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["id"], err = json.Marshal(a.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'id': %w", err)
+	}
+
+	object["name"], err = json.Marshal(a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for DashboardParameter. Returns the specified
+// element and whether it was found
+func (a DashboardParameter) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for DashboardParameter
+func (a *DashboardParameter) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for DashboardParameter to handle AdditionalProperties
+func (a *DashboardParameter) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["default"]; found {
+		err = json.Unmarshal(raw, &a.Default)
+		if err != nil {
+			return fmt.Errorf("error reading 'default': %w", err)
+		}
+		delete(object, "default")
+	}
+
+	if raw, found := object["filteringParameters"]; found {
+		err = json.Unmarshal(raw, &a.FilteringParameters)
+		if err != nil {
+			return fmt.Errorf("error reading 'filteringParameters': %w", err)
+		}
+		delete(object, "filteringParameters")
+	}
+
+	if raw, found := object["id"]; found {
+		err = json.Unmarshal(raw, &a.Id)
+		if err != nil {
+			return fmt.Errorf("error reading 'id': %w", err)
+		}
+		delete(object, "id")
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
+	if raw, found := object["required"]; found {
+		err = json.Unmarshal(raw, &a.Required)
+		if err != nil {
+			return fmt.Errorf("error reading 'required': %w", err)
+		}
+		delete(object, "required")
+	}
+
+	if raw, found := object["sectionId"]; found {
 		err = json.Unmarshal(raw, &a.SectionId)
 		if err != nil {
 			return fmt.Errorf("error reading 'sectionId': %w", err)
@@ -983,37 +1790,45 @@ func (a DashboardParameter) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for UpdateCardBody. Returns the specified
+// Getter for additional properties for DashboardQueryMetadata. Returns the specified
 // element and whether it was found
-func (a UpdateCardBody) Get(fieldName string) (value interface{}, found bool) {
+func (a DashboardQueryMetadata) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for UpdateCardBody
-func (a *UpdateCardBody) Set(fieldName string, value interface{}) {
+// Setter for additional properties for DashboardQueryMetadata
+func (a *DashboardQueryMetadata) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for UpdateCardBody to handle AdditionalProperties
-func (a *UpdateCardBody) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for DashboardQueryMetadata to handle AdditionalProperties
+func (a *DashboardQueryMetadata) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["archived"]; found {
-		err = json.Unmarshal(raw, &a.Archived)
+	if raw, found := object["fields"]; found {
+		err = json.Unmarshal(raw, &a.Fields)
 		if err != nil {
-			return fmt.Errorf("error reading 'archived': %w", err)
+			return fmt.Errorf("error reading 'fields': %w", err)
 		}
-		delete(object, "archived")
+		delete(object, "fields")
+	}
+
+	if raw, found := object["tables"]; found {
+		err = json.Unmarshal(raw, &a.Tables)
+		if err != nil {
+			return fmt.Errorf("error reading 'tables': %w", err)
+		}
+		delete(object, "tables")
 	}
 
 	if len(object) != 0 {
@@ -1030,15 +1845,22 @@ func (a *UpdateCardBody) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for UpdateCardBody to handle AdditionalProperties
-func (a UpdateCardBody) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for DashboardQueryMetadata to handle AdditionalProperties
+func (a DashboardQueryMetadata) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	if a.Archived != nil {
-		object["archived"], err = json.Marshal(a.Archived)
+	if a.Fields != nil {
+		object["fields"], err = json.Marshal(a.Fields)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'archived': %w", err)
+			return nil, fmt.Errorf("error marshaling 'fields': %w", err)
+		}
+	}
+
+	if a.Tables != nil {
+		object["tables"], err = json.Marshal(a.Tables)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'tables': %w", err)
 		}
 	}
 
@@ -1051,208 +1873,449 @@ func (a UpdateCardBody) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// AsCollectionId0 returns the union data inside the Collection_Id as a CollectionId0
-func (t Collection_Id) AsCollectionId0() (CollectionId0, error) {
-	var body CollectionId0
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+// Getter for additional properties for SessionProperties. Returns the specified
+// element and whether it was found
+func (a SessionProperties) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-// FromCollectionId0 overwrites any union data inside the Collection_Id as the provided CollectionId0
-func (t *Collection_Id) FromCollectionId0(v CollectionId0) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+// Setter for additional properties for SessionProperties
+func (a *SessionProperties) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-// MergeCollectionId0 performs a merge with any union data inside the Collection_Id, using the provided CollectionId0
-func (t *Collection_Id) MergeCollectionId0(v CollectionId0) error {
-	b, err := json.Marshal(v)
+// Override default JSON handling for SessionProperties to handle AdditionalProperties
+func (a *SessionProperties) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
-	return err
+	if raw, found := object["version"]; found {
+		err = json.Unmarshal(raw, &a.Version)
+		if err != nil {
+			return fmt.Errorf("error reading 'version': %w", err)
+		}
+		delete(object, "version")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-// AsCollectionId1 returns the union data inside the Collection_Id as a CollectionId1
-func (t Collection_Id) AsCollectionId1() (CollectionId1, error) {
-	var body CollectionId1
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+// Override default JSON handling for SessionProperties to handle AdditionalProperties
+func (a SessionProperties) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Version != nil {
+		object["version"], err = json.Marshal(a.Version)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'version': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-// FromCollectionId1 overwrites any union data inside the Collection_Id as the provided CollectionId1
-func (t *Collection_Id) FromCollectionId1(v CollectionId1) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+// Getter for additional properties for SessionProperties_Version. Returns the specified
+// element and whether it was found
+func (a SessionProperties_Version) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-// MergeCollectionId1 performs a merge with any union data inside the Collection_Id, using the provided CollectionId1
-func (t *Collection_Id) MergeCollectionId1(v CollectionId1) error {
-	b, err := json.Marshal(v)
+// Setter for additional properties for SessionProperties_Version
+func (a *SessionProperties_Version) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for SessionProperties_Version to handle AdditionalProperties
+func (a *SessionProperties_Version) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
-	return err
-}
+	if raw, found := object["tag"]; found {
+		err = json.Unmarshal(raw, &a.Tag)
+		if err != nil {
+			return fmt.Errorf("error reading 'tag': %w", err)
+		}
+		delete(object, "tag")
+	}
 
-func (t Collection_Id) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-func (t *Collection_Id) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	return err
+// Override default JSON handling for SessionProperties_Version to handle AdditionalProperties
+func (a SessionProperties_Version) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Tag != nil {
+		object["tag"], err = json.Marshal(a.Tag)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'tag': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-// AsDashboardParameterDefault0 returns the union data inside the DashboardParameter_Default as a DashboardParameterDefault0
-func (t DashboardParameter_Default) AsDashboardParameterDefault0() (DashboardParameterDefault0, error) {
-	var body DashboardParameterDefault0
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+// Getter for additional properties for TableForeignKey. Returns the specified
+// element and whether it was found
+func (a TableForeignKey) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-// FromDashboardParameterDefault0 overwrites any union data inside the DashboardParameter_Default as the provided DashboardParameterDefault0
-func (t *DashboardParameter_Default) FromDashboardParameterDefault0(v DashboardParameterDefault0) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+// Setter for additional properties for TableForeignKey
+func (a *TableForeignKey) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-// MergeDashboardParameterDefault0 performs a merge with any union data inside the DashboardParameter_Default, using the provided DashboardParameterDefault0
-func (t *DashboardParameter_Default) MergeDashboardParameterDefault0(v DashboardParameterDefault0) error {
-	b, err := json.Marshal(v)
+// Override default JSON handling for TableForeignKey to handle AdditionalProperties
+func (a *TableForeignKey) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
-	return err
-}
+	if raw, found := object["destination"]; found {
+		err = json.Unmarshal(raw, &a.Destination)
+		if err != nil {
+			return fmt.Errorf("error reading 'destination': %w", err)
+		}
+		delete(object, "destination")
+	}
 
-// AsDashboardParameterDefault1 returns the union data inside the DashboardParameter_Default as a DashboardParameterDefault1
-func (t DashboardParameter_Default) AsDashboardParameterDefault1() (DashboardParameterDefault1, error) {
-	var body DashboardParameterDefault1
-	err := json.Unmarshal(t.union, &body)
-	return body, err
-}
+	if raw, found := object["destination_id"]; found {
+		err = json.Unmarshal(raw, &a.DestinationId)
+		if err != nil {
+			return fmt.Errorf("error reading 'destination_id': %w", err)
+		}
+		delete(object, "destination_id")
+	}
 
-// FromDashboardParameterDefault1 overwrites any union data inside the DashboardParameter_Default as the provided DashboardParameterDefault1
-func (t *DashboardParameter_Default) FromDashboardParameterDefault1(v DashboardParameterDefault1) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
-}
+	if raw, found := object["origin"]; found {
+		err = json.Unmarshal(raw, &a.Origin)
+		if err != nil {
+			return fmt.Errorf("error reading 'origin': %w", err)
+		}
+		delete(object, "origin")
+	}
 
-// MergeDashboardParameterDefault1 performs a merge with any union data inside the DashboardParameter_Default, using the provided DashboardParameterDefault1
-func (t *DashboardParameter_Default) MergeDashboardParameterDefault1(v DashboardParameterDefault1) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
+	if raw, found := object["origin_id"]; found {
+		err = json.Unmarshal(raw, &a.OriginId)
+		if err != nil {
+			return fmt.Errorf("error reading 'origin_id': %w", err)
+		}
+		delete(object, "origin_id")
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
-	return err
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-func (t DashboardParameter_Default) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
-}
+// Override default JSON handling for TableForeignKey to handle AdditionalProperties
+func (a TableForeignKey) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-func (t *DashboardParameter_Default) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	return err
-}
+	object["destination"], err = json.Marshal(a.Destination)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'destination': %w", err)
+	}
 
-// AsDatabaseDetailsBigQuery returns the union data inside the DatabaseDetails as a DatabaseDetailsBigQuery
-func (t DatabaseDetails) AsDatabaseDetailsBigQuery() (DatabaseDetailsBigQuery, error) {
-	var body DatabaseDetailsBigQuery
-	err := json.Unmarshal(t.union, &body)
-	return body, err
-}
+	object["destination_id"], err = json.Marshal(a.DestinationId)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'destination_id': %w", err)
+	}
 
-// FromDatabaseDetailsBigQuery overwrites any union data inside the DatabaseDetails as the provided DatabaseDetailsBigQuery
-func (t *DatabaseDetails) FromDatabaseDetailsBigQuery(v DatabaseDetailsBigQuery) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
-}
+	object["origin"], err = json.Marshal(a.Origin)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'origin': %w", err)
+	}
 
-// MergeDatabaseDetailsBigQuery performs a merge with any union data inside the DatabaseDetails, using the provided DatabaseDetailsBigQuery
-func (t *DatabaseDetails) MergeDatabaseDetailsBigQuery(v DatabaseDetailsBigQuery) error {
-	b, err := json.Marshal(v)
+	object["origin_id"], err = json.Marshal(a.OriginId)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("error marshaling 'origin_id': %w", err)
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
-	return err
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-// AsDatabaseDetailsCustom returns the union data inside the DatabaseDetails as a DatabaseDetailsCustom
-func (t DatabaseDetails) AsDatabaseDetailsCustom() (DatabaseDetailsCustom, error) {
-	var body DatabaseDetailsCustom
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+// Getter for additional properties for TableForeignKeyField. Returns the specified
+// element and whether it was found
+func (a TableForeignKeyField) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-// FromDatabaseDetailsCustom overwrites any union data inside the DatabaseDetails as the provided DatabaseDetailsCustom
-func (t *DatabaseDetails) FromDatabaseDetailsCustom(v DatabaseDetailsCustom) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+// Setter for additional properties for TableForeignKeyField
+func (a *TableForeignKeyField) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-// MergeDatabaseDetailsCustom performs a merge with any union data inside the DatabaseDetails, using the provided DatabaseDetailsCustom
-func (t *DatabaseDetails) MergeDatabaseDetailsCustom(v DatabaseDetailsCustom) error {
-	b, err := json.Marshal(v)
+// Override default JSON handling for TableForeignKeyField to handle AdditionalProperties
+func (a *TableForeignKeyField) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
-	return err
+	if raw, found := object["id"]; found {
+		err = json.Unmarshal(raw, &a.Id)
+		if err != nil {
+			return fmt.Errorf("error reading 'id': %w", err)
+		}
+		delete(object, "id")
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
+	if raw, found := object["table_id"]; found {
+		err = json.Unmarshal(raw, &a.TableId)
+		if err != nil {
+			return fmt.Errorf("error reading 'table_id': %w", err)
+		}
+		delete(object, "table_id")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-func (t DatabaseDetails) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
+// Override default JSON handling for TableForeignKeyField to handle AdditionalProperties
+func (a TableForeignKeyField) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["id"], err = json.Marshal(a.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'id': %w", err)
+	}
+
+	object["name"], err = json.Marshal(a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
+
+	object["table_id"], err = json.Marshal(a.TableId)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'table_id': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-func (t *DatabaseDetails) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+// Getter for additional properties for UpdateCardBody. Returns the specified
+// element and whether it was found
+func (a UpdateCardBody) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdateCardBody
+func (a *UpdateCardBody) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdateCardBody to handle AdditionalProperties
+func (a *UpdateCardBody) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["archived"]; found {
+		err = json.Unmarshal(raw, &a.Archived)
+		if err != nil {
+			return fmt.Errorf("error reading 'archived': %w", err)
+		}
+		delete(object, "archived")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdateCardBody to handle AdditionalProperties
+func (a UpdateCardBody) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Archived != nil {
+		object["archived"], err = json.Marshal(a.Archived)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'archived': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// AsCollectionId0 returns the union data inside the Collection_Id as a CollectionId0
+func (t Collection_Id) AsCollectionId0() (CollectionId0, error) {
+	var body CollectionId0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCollectionId0 overwrites any union data inside the Collection_Id as the provided CollectionId0
+func (t *Collection_Id) FromCollectionId0(v CollectionId0) error {
+	b, err := json.Marshal(v)
+	t.union = b
 	return err
 }
 
-// AsPermissionsGraphDatabaseAccessSchemas0 returns the union data inside the PermissionsGraphDatabaseAccess_Schemas as a PermissionsGraphDatabaseAccessSchemas0
-func (t PermissionsGraphDatabaseAccess_Schemas) AsPermissionsGraphDatabaseAccessSchemas0() (PermissionsGraphDatabaseAccessSchemas0, error) {
-	var body PermissionsGraphDatabaseAccessSchemas0
+// MergeCollectionId0 performs a merge with any union data inside the Collection_Id, using the provided CollectionId0
+func (t *Collection_Id) MergeCollectionId0(v CollectionId0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsCollectionId1 returns the union data inside the Collection_Id as a CollectionId1
+func (t Collection_Id) AsCollectionId1() (CollectionId1, error) {
+	var body CollectionId1
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromPermissionsGraphDatabaseAccessSchemas0 overwrites any union data inside the PermissionsGraphDatabaseAccess_Schemas as the provided PermissionsGraphDatabaseAccessSchemas0
-func (t *PermissionsGraphDatabaseAccess_Schemas) FromPermissionsGraphDatabaseAccessSchemas0(v PermissionsGraphDatabaseAccessSchemas0) error {
+// FromCollectionId1 overwrites any union data inside the Collection_Id as the provided CollectionId1
+func (t *Collection_Id) FromCollectionId1(v CollectionId1) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergePermissionsGraphDatabaseAccessSchemas0 performs a merge with any union data inside the PermissionsGraphDatabaseAccess_Schemas, using the provided PermissionsGraphDatabaseAccessSchemas0
-func (t *PermissionsGraphDatabaseAccess_Schemas) MergePermissionsGraphDatabaseAccessSchemas0(v PermissionsGraphDatabaseAccessSchemas0) error {
+// MergeCollectionId1 performs a merge with any union data inside the Collection_Id, using the provided CollectionId1
+func (t *Collection_Id) MergeCollectionId1(v CollectionId1) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1263,72 +2326,284 @@ func (t *PermissionsGraphDatabaseAccess_Schemas) MergePermissionsGraphDatabaseAc
 	return err
 }
 
-func (t PermissionsGraphDatabaseAccess_Schemas) MarshalJSON() ([]byte, error) {
+func (t Collection_Id) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
 }
 
-func (t *PermissionsGraphDatabaseAccess_Schemas) UnmarshalJSON(b []byte) error {
+func (t *Collection_Id) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
 	return err
 }
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+// AsDashboardParameterDefault0 returns the union data inside the DashboardParameter_Default as a DashboardParameterDefault0
+func (t DashboardParameter_Default) AsDashboardParameterDefault0() (DashboardParameterDefault0, error) {
+	var body DashboardParameterDefault0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+// FromDashboardParameterDefault0 overwrites any union data inside the DashboardParameter_Default as the provided DashboardParameterDefault0
+func (t *DashboardParameter_Default) FromDashboardParameterDefault0(v DashboardParameterDefault0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+// MergeDashboardParameterDefault0 performs a merge with any union data inside the DashboardParameter_Default, using the provided DashboardParameterDefault0
+func (t *DashboardParameter_Default) MergeDashboardParameterDefault0(v DashboardParameterDefault0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
 
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
+// AsDashboardParameterDefault1 returns the union data inside the DashboardParameter_Default as a DashboardParameterDefault1
+func (t DashboardParameter_Default) AsDashboardParameterDefault1() (DashboardParameterDefault1, error) {
+	var body DashboardParameterDefault1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+// FromDashboardParameterDefault1 overwrites any union data inside the DashboardParameter_Default as the provided DashboardParameterDefault1
+func (t *DashboardParameter_Default) FromDashboardParameterDefault1(v DashboardParameterDefault1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
-		Server: server,
-	}
-	// mutate client and add all optional params
-	for _, o := range opts {
-		if err := o(&client); err != nil {
-			return nil, err
-		}
-	}
-	// ensure the server URL always has a trailing slash
-	if !strings.HasSuffix(client.Server, "/") {
-		client.Server += "/"
-	}
-	// create httpClient, if not already present
-	if client.Client == nil {
-		client.Client = &http.Client{}
+// MergeDashboardParameterDefault1 performs a merge with any union data inside the DashboardParameter_Default, using the provided DashboardParameterDefault1
+func (t *DashboardParameter_Default) MergeDashboardParameterDefault1(v DashboardParameterDefault1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
 	}
-	return &client, nil
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+func (t DashboardParameter_Default) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *DashboardParameter_Default) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsDatabaseDetailsBigQuery returns the union data inside the DatabaseDetails as a DatabaseDetailsBigQuery
+func (t DatabaseDetails) AsDatabaseDetailsBigQuery() (DatabaseDetailsBigQuery, error) {
+	var body DatabaseDetailsBigQuery
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDatabaseDetailsBigQuery overwrites any union data inside the DatabaseDetails as the provided DatabaseDetailsBigQuery
+func (t *DatabaseDetails) FromDatabaseDetailsBigQuery(v DatabaseDetailsBigQuery) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDatabaseDetailsBigQuery performs a merge with any union data inside the DatabaseDetails, using the provided DatabaseDetailsBigQuery
+func (t *DatabaseDetails) MergeDatabaseDetailsBigQuery(v DatabaseDetailsBigQuery) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDatabaseDetailsClickHouse returns the union data inside the DatabaseDetails as a DatabaseDetailsClickHouse
+func (t DatabaseDetails) AsDatabaseDetailsClickHouse() (DatabaseDetailsClickHouse, error) {
+	var body DatabaseDetailsClickHouse
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDatabaseDetailsClickHouse overwrites any union data inside the DatabaseDetails as the provided DatabaseDetailsClickHouse
+func (t *DatabaseDetails) FromDatabaseDetailsClickHouse(v DatabaseDetailsClickHouse) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDatabaseDetailsClickHouse performs a merge with any union data inside the DatabaseDetails, using the provided DatabaseDetailsClickHouse
+func (t *DatabaseDetails) MergeDatabaseDetailsClickHouse(v DatabaseDetailsClickHouse) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDatabaseDetailsCustom returns the union data inside the DatabaseDetails as a DatabaseDetailsCustom
+func (t DatabaseDetails) AsDatabaseDetailsCustom() (DatabaseDetailsCustom, error) {
+	var body DatabaseDetailsCustom
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDatabaseDetailsCustom overwrites any union data inside the DatabaseDetails as the provided DatabaseDetailsCustom
+func (t *DatabaseDetails) FromDatabaseDetailsCustom(v DatabaseDetailsCustom) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDatabaseDetailsCustom performs a merge with any union data inside the DatabaseDetails, using the provided DatabaseDetailsCustom
+func (t *DatabaseDetails) MergeDatabaseDetailsCustom(v DatabaseDetailsCustom) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t DatabaseDetails) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *DatabaseDetails) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsPermissionsGraphDatabaseAccessSchemas0 returns the union data inside the PermissionsGraphDatabaseAccess_Schemas as a PermissionsGraphDatabaseAccessSchemas0
+func (t PermissionsGraphDatabaseAccess_Schemas) AsPermissionsGraphDatabaseAccessSchemas0() (PermissionsGraphDatabaseAccessSchemas0, error) {
+	var body PermissionsGraphDatabaseAccessSchemas0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromPermissionsGraphDatabaseAccessSchemas0 overwrites any union data inside the PermissionsGraphDatabaseAccess_Schemas as the provided PermissionsGraphDatabaseAccessSchemas0
+func (t *PermissionsGraphDatabaseAccess_Schemas) FromPermissionsGraphDatabaseAccessSchemas0(v PermissionsGraphDatabaseAccessSchemas0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergePermissionsGraphDatabaseAccessSchemas0 performs a merge with any union data inside the PermissionsGraphDatabaseAccess_Schemas, using the provided PermissionsGraphDatabaseAccessSchemas0
+func (t *PermissionsGraphDatabaseAccess_Schemas) MergePermissionsGraphDatabaseAccessSchemas0(v PermissionsGraphDatabaseAccessSchemas0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsPermissionsGraphDatabaseAccessSchemas1 returns the union data inside the PermissionsGraphDatabaseAccess_Schemas as a PermissionsGraphDatabaseAccessSchemas1
+func (t PermissionsGraphDatabaseAccess_Schemas) AsPermissionsGraphDatabaseAccessSchemas1() (PermissionsGraphDatabaseAccessSchemas1, error) {
+	var body PermissionsGraphDatabaseAccessSchemas1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromPermissionsGraphDatabaseAccessSchemas1 overwrites any union data inside the PermissionsGraphDatabaseAccess_Schemas as the provided PermissionsGraphDatabaseAccessSchemas1
+func (t *PermissionsGraphDatabaseAccess_Schemas) FromPermissionsGraphDatabaseAccessSchemas1(v PermissionsGraphDatabaseAccessSchemas1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergePermissionsGraphDatabaseAccessSchemas1 performs a merge with any union data inside the PermissionsGraphDatabaseAccess_Schemas, using the provided PermissionsGraphDatabaseAccessSchemas1
+func (t *PermissionsGraphDatabaseAccess_Schemas) MergePermissionsGraphDatabaseAccessSchemas1(v PermissionsGraphDatabaseAccessSchemas1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t PermissionsGraphDatabaseAccess_Schemas) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *PermissionsGraphDatabaseAccess_Schemas) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
 	return func(c *Client) error {
 		c.Client = doer
 		return nil
@@ -1359,6 +2634,12 @@ type ClientInterface interface {
 
 	UpdateCard(ctx context.Context, cardId int, body UpdateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetCardDashboards request
+	GetCardDashboards(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCardRelated request
+	GetCardRelated(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListCollections request
 	ListCollections(ctx context.Context, params *ListCollectionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1402,6 +2683,9 @@ type ClientInterface interface {
 
 	UpdateDashboard(ctx context.Context, dashboardId int, body UpdateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetDashboardQueryMetadata request
+	GetDashboardQueryMetadata(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListDatabases request
 	ListDatabases(ctx context.Context, params *ListDatabasesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1410,6 +2694,11 @@ type ClientInterface interface {
 
 	CreateDatabase(ctx context.Context, body CreateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ValidateDatabaseWithBody request with any body
+	ValidateDatabaseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ValidateDatabase(ctx context.Context, body ValidateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteDatabase request
 	DeleteDatabase(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1421,6 +2710,21 @@ type ClientInterface interface {
 
 	UpdateDatabase(ctx context.Context, databaseId int, body UpdateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListDatabaseSchemas request
+	ListDatabaseSchemas(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetImpersonation request
+	GetImpersonation(ctx context.Context, params *GetImpersonationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteImpersonation request
+	DeleteImpersonation(ctx context.Context, impersonationId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportSerialization request
+	ExportSerialization(ctx context.Context, params *ExportSerializationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportSerializationWithBody request with any body
+	ImportSerializationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetField request
 	GetField(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1429,6 +2733,43 @@ type ClientInterface interface {
 
 	UpdateField(ctx context.Context, fieldId int, body UpdateFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteFieldDimension request
+	DeleteFieldDimension(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateFieldDimensionWithBody request with any body
+	CreateFieldDimensionWithBody(ctx context.Context, fieldId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateFieldDimension(ctx context.Context, fieldId int, body CreateFieldDimensionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFieldValues request
+	GetFieldValues(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateFieldValuesWithBody request with any body
+	UpdateFieldValuesWithBody(ctx context.Context, fieldId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateFieldValues(ctx context.Context, fieldId int, body UpdateFieldValuesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateModerationReviewWithBody request with any body
+	CreateModerationReviewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateModerationReview(ctx context.Context, body CreateModerationReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSandboxWithBody request with any body
+	CreateSandboxWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSandbox(ctx context.Context, body CreateSandboxJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSandbox request
+	DeleteSandbox(ctx context.Context, sandboxId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSandbox request
+	GetSandbox(ctx context.Context, sandboxId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSandboxWithBody request with any body
+	UpdateSandboxWithBody(ctx context.Context, sandboxId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSandbox(ctx context.Context, sandboxId int, body UpdateSandboxJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetPermissionsGraph request
 	GetPermissionsGraph(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1437,6 +2778,9 @@ type ClientInterface interface {
 
 	ReplacePermissionsGraph(ctx context.Context, body ReplacePermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListPermissionsGroups request
+	ListPermissionsGroups(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreatePermissionsGroupWithBody request with any body
 	CreatePermissionsGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1453,11 +2797,47 @@ type ClientInterface interface {
 
 	UpdatePermissionsGroup(ctx context.Context, groupId int, body UpdatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreatePermissionsMembershipWithBody request with any body
+	CreatePermissionsMembershipWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePermissionsMembership(ctx context.Context, body CreatePermissionsMembershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePermissionsMembership request
+	DeletePermissionsMembership(ctx context.Context, membershipId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPersistedInfo request
+	GetPersistedInfo(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PersistModel request
+	PersistModel(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnpersistModel request
+	UnpersistModel(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PersistDatabase request
+	PersistDatabase(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnpersistDatabase request
+	UnpersistDatabase(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EnablePersistenceWithBody request with any body
+	EnablePersistenceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EnablePersistence(ctx context.Context, body EnablePersistenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetPersistenceRefreshScheduleWithBody request with any body
+	SetPersistenceRefreshScheduleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetPersistenceRefreshSchedule(ctx context.Context, body SetPersistenceRefreshScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateSessionWithBody request with any body
 	CreateSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	CreateSession(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetSessionProperties request
+	GetSessionProperties(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListTables request
 	ListTables(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1466,6 +2846,9 @@ type ClientInterface interface {
 
 	UpdateTable(ctx context.Context, tableId int, body UpdateTableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetTableForeignKeys request
+	GetTableForeignKeys(ctx context.Context, tableId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetTableMetadata request
 	GetTableMetadata(ctx context.Context, tableId int, params *GetTableMetadataParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
@@ -1530,8 +2913,8 @@ func (c *Client) UpdateCard(ctx context.Context, cardId int, body UpdateCardJSON
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListCollections(ctx context.Context, params *ListCollectionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListCollectionsRequest(c.Server, params)
+func (c *Client) GetCardDashboards(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCardDashboardsRequest(c.Server, cardId)
 	if err != nil {
 		return nil, err
 	}
@@ -1542,8 +2925,8 @@ func (c *Client) ListCollections(ctx context.Context, params *ListCollectionsPar
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateCollectionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateCollectionRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetCardRelated(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCardRelatedRequest(c.Server, cardId)
 	if err != nil {
 		return nil, err
 	}
@@ -1554,8 +2937,8 @@ func (c *Client) CreateCollectionWithBody(ctx context.Context, contentType strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateCollection(ctx context.Context, body CreateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateCollectionRequest(c.Server, body)
+func (c *Client) ListCollections(ctx context.Context, params *ListCollectionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCollectionsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1566,8 +2949,8 @@ func (c *Client) CreateCollection(ctx context.Context, body CreateCollectionJSON
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetCollectionPermissionsGraph(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetCollectionPermissionsGraphRequest(c.Server)
+func (c *Client) CreateCollectionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCollectionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1578,9 +2961,33 @@ func (c *Client) GetCollectionPermissionsGraph(ctx context.Context, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) ReplaceCollectionPermissionsGraphWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewReplaceCollectionPermissionsGraphRequestWithBody(c.Server, contentType, body)
-	if err != nil {
+func (c *Client) CreateCollection(ctx context.Context, body CreateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCollectionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCollectionPermissionsGraph(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCollectionPermissionsGraphRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReplaceCollectionPermissionsGraphWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplaceCollectionPermissionsGraphRequestWithBody(c.Server, contentType, body)
+	if err != nil {
 		return nil, err
 	}
 	req = req.WithContext(ctx)
@@ -1722,6 +3129,18 @@ func (c *Client) UpdateDashboard(ctx context.Context, dashboardId int, body Upda
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetDashboardQueryMetadata(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDashboardQueryMetadataRequest(c.Server, dashboardId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ListDatabases(ctx context.Context, params *ListDatabasesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewListDatabasesRequest(c.Server, params)
 	if err != nil {
@@ -1758,6 +3177,30 @@ func (c *Client) CreateDatabase(ctx context.Context, body CreateDatabaseJSONRequ
 	return c.Client.Do(req)
 }
 
+func (c *Client) ValidateDatabaseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateDatabaseRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateDatabase(ctx context.Context, body ValidateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateDatabaseRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DeleteDatabase(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDeleteDatabaseRequest(c.Server, databaseId)
 	if err != nil {
@@ -1806,8 +3249,8 @@ func (c *Client) UpdateDatabase(ctx context.Context, databaseId int, body Update
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetField(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetFieldRequest(c.Server, fieldId)
+func (c *Client) ListDatabaseSchemas(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDatabaseSchemasRequest(c.Server, databaseId)
 	if err != nil {
 		return nil, err
 	}
@@ -1818,8 +3261,8 @@ func (c *Client) GetField(ctx context.Context, fieldId int, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateFieldWithBody(ctx context.Context, fieldId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateFieldRequestWithBody(c.Server, fieldId, contentType, body)
+func (c *Client) GetImpersonation(ctx context.Context, params *GetImpersonationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetImpersonationRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1830,8 +3273,8 @@ func (c *Client) UpdateFieldWithBody(ctx context.Context, fieldId int, contentTy
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateField(ctx context.Context, fieldId int, body UpdateFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateFieldRequest(c.Server, fieldId, body)
+func (c *Client) DeleteImpersonation(ctx context.Context, impersonationId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteImpersonationRequest(c.Server, impersonationId)
 	if err != nil {
 		return nil, err
 	}
@@ -1842,8 +3285,8 @@ func (c *Client) UpdateField(ctx context.Context, fieldId int, body UpdateFieldJ
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPermissionsGraph(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPermissionsGraphRequest(c.Server)
+func (c *Client) ExportSerialization(ctx context.Context, params *ExportSerializationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportSerializationRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1854,8 +3297,8 @@ func (c *Client) GetPermissionsGraph(ctx context.Context, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) ReplacePermissionsGraphWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewReplacePermissionsGraphRequestWithBody(c.Server, contentType, body)
+func (c *Client) ImportSerializationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportSerializationRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1866,8 +3309,8 @@ func (c *Client) ReplacePermissionsGraphWithBody(ctx context.Context, contentTyp
 	return c.Client.Do(req)
 }
 
-func (c *Client) ReplacePermissionsGraph(ctx context.Context, body ReplacePermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewReplacePermissionsGraphRequest(c.Server, body)
+func (c *Client) GetField(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFieldRequest(c.Server, fieldId)
 	if err != nil {
 		return nil, err
 	}
@@ -1878,8 +3321,8 @@ func (c *Client) ReplacePermissionsGraph(ctx context.Context, body ReplacePermis
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePermissionsGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePermissionsGroupRequestWithBody(c.Server, contentType, body)
+func (c *Client) UpdateFieldWithBody(ctx context.Context, fieldId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateFieldRequestWithBody(c.Server, fieldId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1890,8 +3333,8 @@ func (c *Client) CreatePermissionsGroupWithBody(ctx context.Context, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePermissionsGroup(ctx context.Context, body CreatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePermissionsGroupRequest(c.Server, body)
+func (c *Client) UpdateField(ctx context.Context, fieldId int, body UpdateFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateFieldRequest(c.Server, fieldId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1902,8 +3345,8 @@ func (c *Client) CreatePermissionsGroup(ctx context.Context, body CreatePermissi
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeletePermissionsGroup(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeletePermissionsGroupRequest(c.Server, groupId)
+func (c *Client) DeleteFieldDimension(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteFieldDimensionRequest(c.Server, fieldId)
 	if err != nil {
 		return nil, err
 	}
@@ -1914,8 +3357,8 @@ func (c *Client) DeletePermissionsGroup(ctx context.Context, groupId int, reqEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPermissionsGroup(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPermissionsGroupRequest(c.Server, groupId)
+func (c *Client) CreateFieldDimensionWithBody(ctx context.Context, fieldId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateFieldDimensionRequestWithBody(c.Server, fieldId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1926,8 +3369,8 @@ func (c *Client) GetPermissionsGroup(ctx context.Context, groupId int, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePermissionsGroupWithBody(ctx context.Context, groupId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePermissionsGroupRequestWithBody(c.Server, groupId, contentType, body)
+func (c *Client) CreateFieldDimension(ctx context.Context, fieldId int, body CreateFieldDimensionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateFieldDimensionRequest(c.Server, fieldId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1938,8 +3381,8 @@ func (c *Client) UpdatePermissionsGroupWithBody(ctx context.Context, groupId int
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePermissionsGroup(ctx context.Context, groupId int, body UpdatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePermissionsGroupRequest(c.Server, groupId, body)
+func (c *Client) GetFieldValues(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFieldValuesRequest(c.Server, fieldId)
 	if err != nil {
 		return nil, err
 	}
@@ -1950,8 +3393,8 @@ func (c *Client) UpdatePermissionsGroup(ctx context.Context, groupId int, body U
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateSessionRequestWithBody(c.Server, contentType, body)
+func (c *Client) UpdateFieldValuesWithBody(ctx context.Context, fieldId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateFieldValuesRequestWithBody(c.Server, fieldId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1962,8 +3405,8 @@ func (c *Client) CreateSessionWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateSession(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateSessionRequest(c.Server, body)
+func (c *Client) UpdateFieldValues(ctx context.Context, fieldId int, body UpdateFieldValuesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateFieldValuesRequest(c.Server, fieldId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1974,8 +3417,8 @@ func (c *Client) CreateSession(ctx context.Context, body CreateSessionJSONReques
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListTables(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListTablesRequest(c.Server)
+func (c *Client) CreateModerationReviewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateModerationReviewRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1986,8 +3429,8 @@ func (c *Client) ListTables(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTableWithBody(ctx context.Context, tableId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTableRequestWithBody(c.Server, tableId, contentType, body)
+func (c *Client) CreateModerationReview(ctx context.Context, body CreateModerationReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateModerationReviewRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1998,8 +3441,8 @@ func (c *Client) UpdateTableWithBody(ctx context.Context, tableId int, contentTy
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTable(ctx context.Context, tableId int, body UpdateTableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTableRequest(c.Server, tableId, body)
+func (c *Client) CreateSandboxWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSandboxRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2010,8 +3453,8 @@ func (c *Client) UpdateTable(ctx context.Context, tableId int, body UpdateTableJ
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTableMetadata(ctx context.Context, tableId int, params *GetTableMetadataParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTableMetadataRequest(c.Server, tableId, params)
+func (c *Client) CreateSandbox(ctx context.Context, body CreateSandboxJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSandboxRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -2022,256 +3465,427 @@ func (c *Client) GetTableMetadata(ctx context.Context, tableId int, params *GetT
 	return c.Client.Do(req)
 }
 
-// NewCreateCardRequest calls the generic CreateCard builder with application/json body
-func NewCreateCardRequest(server string, body CreateCardJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeleteSandbox(ctx context.Context, sandboxId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSandboxRequest(c.Server, sandboxId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateCardRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateCardRequestWithBody generates requests for CreateCard with any type of body
-func NewCreateCardRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetSandbox(ctx context.Context, sandboxId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSandboxRequest(c.Server, sandboxId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/card")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateSandboxWithBody(ctx context.Context, sandboxId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSandboxRequestWithBody(c.Server, sandboxId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetCardRequest generates requests for GetCard
-func NewGetCardRequest(server string, cardId int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cardId", runtime.ParamLocationPath, cardId)
+func (c *Client) UpdateSandbox(ctx context.Context, sandboxId int, body UpdateSandboxJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSandboxRequest(c.Server, sandboxId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetPermissionsGraph(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPermissionsGraphRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/card/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ReplacePermissionsGraphWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplacePermissionsGraphRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateCardRequest calls the generic UpdateCard builder with application/json body
-func NewUpdateCardRequest(server string, cardId int, body UpdateCardJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ReplacePermissionsGraph(ctx context.Context, body ReplacePermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplacePermissionsGraphRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateCardRequestWithBody(server, cardId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateCardRequestWithBody generates requests for UpdateCard with any type of body
-func NewUpdateCardRequestWithBody(server string, cardId int, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cardId", runtime.ParamLocationPath, cardId)
+func (c *Client) ListPermissionsGroups(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPermissionsGroupsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreatePermissionsGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePermissionsGroupRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/card/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreatePermissionsGroup(ctx context.Context, body CreatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePermissionsGroupRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) DeletePermissionsGroup(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePermissionsGroupRequest(c.Server, groupId)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListCollectionsRequest generates requests for ListCollections
-func NewListCollectionsRequest(server string, params *ListCollectionsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetPermissionsGroup(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPermissionsGroupRequest(c.Server, groupId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/collection")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdatePermissionsGroupWithBody(ctx context.Context, groupId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePermissionsGroupRequestWithBody(c.Server, groupId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+func (c *Client) UpdatePermissionsGroup(ctx context.Context, groupId int, body UpdatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePermissionsGroupRequest(c.Server, groupId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if params.Archived != nil {
+func (c *Client) CreatePermissionsMembershipWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePermissionsMembershipRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "archived", runtime.ParamLocationQuery, *params.Archived); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+func (c *Client) CreatePermissionsMembership(ctx context.Context, body CreatePermissionsMembershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePermissionsMembershipRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		}
+func (c *Client) DeletePermissionsMembership(ctx context.Context, membershipId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePermissionsMembershipRequest(c.Server, membershipId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		queryURL.RawQuery = queryValues.Encode()
+func (c *Client) GetPersistedInfo(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPersistedInfoRequest(c.Server, cardId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PersistModel(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPersistModelRequest(c.Server, cardId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) UnpersistModel(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnpersistModelRequest(c.Server, cardId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateCollectionRequest calls the generic CreateCollection builder with application/json body
-func NewCreateCollectionRequest(server string, body CreateCollectionJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PersistDatabase(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPersistDatabaseRequest(c.Server, databaseId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateCollectionRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateCollectionRequestWithBody generates requests for CreateCollection with any type of body
-func NewCreateCollectionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (c *Client) UnpersistDatabase(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnpersistDatabaseRequest(c.Server, databaseId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) EnablePersistenceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnablePersistenceRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/collection")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) EnablePersistence(ctx context.Context, body EnablePersistenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnablePersistenceRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SetPersistenceRefreshScheduleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetPersistenceRefreshScheduleRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) SetPersistenceRefreshSchedule(ctx context.Context, body SetPersistenceRefreshScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetPersistenceRefreshScheduleRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) CreateSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSessionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) CreateSession(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSessionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetCollectionPermissionsGraphRequest generates requests for GetCollectionPermissionsGraph
-func NewGetCollectionPermissionsGraphRequest(server string) (*http.Request, error) {
-	var err error
+func (c *Client) GetSessionProperties(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSessionPropertiesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListTables(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTablesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/collection/graph")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) UpdateTableWithBody(ctx context.Context, tableId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTableRequestWithBody(c.Server, tableId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateTable(ctx context.Context, tableId int, body UpdateTableJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTableRequest(c.Server, tableId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetTableForeignKeys(ctx context.Context, tableId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTableForeignKeysRequest(c.Server, tableId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) GetTableMetadata(ctx context.Context, tableId int, params *GetTableMetadataParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTableMetadataRequest(c.Server, tableId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewReplaceCollectionPermissionsGraphRequest calls the generic ReplaceCollectionPermissionsGraph builder with application/json body
-func NewReplaceCollectionPermissionsGraphRequest(server string, body ReplaceCollectionPermissionsGraphJSONRequestBody) (*http.Request, error) {
+// NewCreateCardRequest calls the generic CreateCard builder with application/json body
+func NewCreateCardRequest(server string, body CreateCardJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewReplaceCollectionPermissionsGraphRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateCardRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewReplaceCollectionPermissionsGraphRequestWithBody generates requests for ReplaceCollectionPermissionsGraph with any type of body
-func NewReplaceCollectionPermissionsGraphRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateCardRequestWithBody generates requests for CreateCard with any type of body
+func NewCreateCardRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2279,7 +3893,7 @@ func NewReplaceCollectionPermissionsGraphRequestWithBody(server string, contentT
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/collection/graph")
+	operationPath := fmt.Sprintf("/card")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2289,7 +3903,7 @@ func NewReplaceCollectionPermissionsGraphRequestWithBody(server string, contentT
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2299,13 +3913,13 @@ func NewReplaceCollectionPermissionsGraphRequestWithBody(server string, contentT
 	return req, nil
 }
 
-// NewGetCollectionRequest generates requests for GetCollection
-func NewGetCollectionRequest(server string, collectionId string) (*http.Request, error) {
+// NewGetCardRequest generates requests for GetCard
+func NewGetCardRequest(server string, cardId int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "collectionId", runtime.ParamLocationPath, collectionId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cardId", runtime.ParamLocationPath, cardId)
 	if err != nil {
 		return nil, err
 	}
@@ -2315,7 +3929,7 @@ func NewGetCollectionRequest(server string, collectionId string) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/collection/%s", pathParam0)
+	operationPath := fmt.Sprintf("/card/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2333,24 +3947,24 @@ func NewGetCollectionRequest(server string, collectionId string) (*http.Request,
 	return req, nil
 }
 
-// NewUpdateCollectionRequest calls the generic UpdateCollection builder with application/json body
-func NewUpdateCollectionRequest(server string, collectionId string, body UpdateCollectionJSONRequestBody) (*http.Request, error) {
+// NewUpdateCardRequest calls the generic UpdateCard builder with application/json body
+func NewUpdateCardRequest(server string, cardId int, body UpdateCardJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateCollectionRequestWithBody(server, collectionId, "application/json", bodyReader)
+	return NewUpdateCardRequestWithBody(server, cardId, "application/json", bodyReader)
 }
 
-// NewUpdateCollectionRequestWithBody generates requests for UpdateCollection with any type of body
-func NewUpdateCollectionRequestWithBody(server string, collectionId string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateCardRequestWithBody generates requests for UpdateCard with any type of body
+func NewUpdateCardRequestWithBody(server string, cardId int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "collectionId", runtime.ParamLocationPath, collectionId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cardId", runtime.ParamLocationPath, cardId)
 	if err != nil {
 		return nil, err
 	}
@@ -2360,7 +3974,7 @@ func NewUpdateCollectionRequestWithBody(server string, collectionId string, cont
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/collection/%s", pathParam0)
+	operationPath := fmt.Sprintf("/card/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2380,13 +3994,13 @@ func NewUpdateCollectionRequestWithBody(server string, collectionId string, cont
 	return req, nil
 }
 
-// NewListCollectionItemsRequest generates requests for ListCollectionItems
-func NewListCollectionItemsRequest(server string, collectionId string, params *ListCollectionItemsParams) (*http.Request, error) {
+// NewGetCardDashboardsRequest generates requests for GetCardDashboards
+func NewGetCardDashboardsRequest(server string, cardId int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "collectionId", runtime.ParamLocationPath, collectionId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cardId", runtime.ParamLocationPath, cardId)
 	if err != nil {
 		return nil, err
 	}
@@ -2396,7 +4010,7 @@ func NewListCollectionItemsRequest(server string, collectionId string, params *L
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/collection/%s/items", pathParam0)
+	operationPath := fmt.Sprintf("/card/%s/dashboards", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2406,60 +4020,73 @@ func NewListCollectionItemsRequest(server string, collectionId string, params *L
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Models != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "models", runtime.ParamLocationQuery, *params.Models); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewGetCardRelatedRequest generates requests for GetCardRelated
+func NewGetCardRelatedRequest(server string, cardId int) (*http.Request, error) {
+	var err error
 
-		}
+	var pathParam0 string
 
-		if params.Archived != nil {
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cardId", runtime.ParamLocationPath, cardId)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "archived", runtime.ParamLocationQuery, *params.Archived); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/card/%s/related", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if params.Limit != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.Offset != nil {
+// NewListCollectionsRequest generates requests for ListCollections
+func NewListCollectionsRequest(server string, params *ListCollectionsParams) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/collection")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Archived != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "archived", runtime.ParamLocationQuery, *params.Archived); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2484,19 +4111,19 @@ func NewListCollectionItemsRequest(server string, collectionId string, params *L
 	return req, nil
 }
 
-// NewCreateDashboardRequest calls the generic CreateDashboard builder with application/json body
-func NewCreateDashboardRequest(server string, body CreateDashboardJSONRequestBody) (*http.Request, error) {
+// NewCreateCollectionRequest calls the generic CreateCollection builder with application/json body
+func NewCreateCollectionRequest(server string, body CreateCollectionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateDashboardRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateCollectionRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateDashboardRequestWithBody generates requests for CreateDashboard with any type of body
-func NewCreateDashboardRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateCollectionRequestWithBody generates requests for CreateCollection with any type of body
+func NewCreateCollectionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2504,7 +4131,7 @@ func NewCreateDashboardRequestWithBody(server string, contentType string, body i
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/dashboard")
+	operationPath := fmt.Sprintf("/collection")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2524,23 +4151,54 @@ func NewCreateDashboardRequestWithBody(server string, contentType string, body i
 	return req, nil
 }
 
-// NewDeleteDashboardRequest generates requests for DeleteDashboard
-func NewDeleteDashboardRequest(server string, dashboardId int) (*http.Request, error) {
+// NewGetCollectionPermissionsGraphRequest generates requests for GetCollectionPermissionsGraph
+func NewGetCollectionPermissionsGraphRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dashboardId", runtime.ParamLocationPath, dashboardId)
+	operationPath := fmt.Sprintf("/collection/graph")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReplaceCollectionPermissionsGraphRequest calls the generic ReplaceCollectionPermissionsGraph builder with application/json body
+func NewReplaceCollectionPermissionsGraphRequest(server string, body ReplaceCollectionPermissionsGraphJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReplaceCollectionPermissionsGraphRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewReplaceCollectionPermissionsGraphRequestWithBody generates requests for ReplaceCollectionPermissionsGraph with any type of body
+func NewReplaceCollectionPermissionsGraphRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/dashboard/%s", pathParam0)
+	operationPath := fmt.Sprintf("/collection/graph")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2550,21 +4208,23 @@ func NewDeleteDashboardRequest(server string, dashboardId int) (*http.Request, e
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetDashboardRequest generates requests for GetDashboard
-func NewGetDashboardRequest(server string, dashboardId int) (*http.Request, error) {
+// NewGetCollectionRequest generates requests for GetCollection
+func NewGetCollectionRequest(server string, collectionId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dashboardId", runtime.ParamLocationPath, dashboardId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "collectionId", runtime.ParamLocationPath, collectionId)
 	if err != nil {
 		return nil, err
 	}
@@ -2574,7 +4234,7 @@ func NewGetDashboardRequest(server string, dashboardId int) (*http.Request, erro
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/dashboard/%s", pathParam0)
+	operationPath := fmt.Sprintf("/collection/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2592,24 +4252,24 @@ func NewGetDashboardRequest(server string, dashboardId int) (*http.Request, erro
 	return req, nil
 }
 
-// NewUpdateDashboardRequest calls the generic UpdateDashboard builder with application/json body
-func NewUpdateDashboardRequest(server string, dashboardId int, body UpdateDashboardJSONRequestBody) (*http.Request, error) {
+// NewUpdateCollectionRequest calls the generic UpdateCollection builder with application/json body
+func NewUpdateCollectionRequest(server string, collectionId string, body UpdateCollectionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateDashboardRequestWithBody(server, dashboardId, "application/json", bodyReader)
+	return NewUpdateCollectionRequestWithBody(server, collectionId, "application/json", bodyReader)
 }
 
-// NewUpdateDashboardRequestWithBody generates requests for UpdateDashboard with any type of body
-func NewUpdateDashboardRequestWithBody(server string, dashboardId int, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateCollectionRequestWithBody generates requests for UpdateCollection with any type of body
+func NewUpdateCollectionRequestWithBody(server string, collectionId string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dashboardId", runtime.ParamLocationPath, dashboardId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "collectionId", runtime.ParamLocationPath, collectionId)
 	if err != nil {
 		return nil, err
 	}
@@ -2619,7 +4279,7 @@ func NewUpdateDashboardRequestWithBody(server string, dashboardId int, contentTy
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/dashboard/%s", pathParam0)
+	operationPath := fmt.Sprintf("/collection/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2639,16 +4299,23 @@ func NewUpdateDashboardRequestWithBody(server string, dashboardId int, contentTy
 	return req, nil
 }
 
-// NewListDatabasesRequest generates requests for ListDatabases
-func NewListDatabasesRequest(server string, params *ListDatabasesParams) (*http.Request, error) {
+// NewListCollectionItemsRequest generates requests for ListCollectionItems
+func NewListCollectionItemsRequest(server string, collectionId string, params *ListCollectionItemsParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "collectionId", runtime.ParamLocationPath, collectionId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/database")
+	operationPath := fmt.Sprintf("/collection/%s/items", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2661,9 +4328,9 @@ func NewListDatabasesRequest(server string, params *ListDatabasesParams) (*http.
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Include != nil {
+		if params.Models != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include", runtime.ParamLocationQuery, *params.Include); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "models", runtime.ParamLocationQuery, *params.Models); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2677,9 +4344,41 @@ func NewListDatabasesRequest(server string, params *ListDatabasesParams) (*http.
 
 		}
 
-		if params.Saved != nil {
+		if params.Archived != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "saved", runtime.ParamLocationQuery, *params.Saved); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "archived", runtime.ParamLocationQuery, *params.Archived); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2704,19 +4403,19 @@ func NewListDatabasesRequest(server string, params *ListDatabasesParams) (*http.
 	return req, nil
 }
 
-// NewCreateDatabaseRequest calls the generic CreateDatabase builder with application/json body
-func NewCreateDatabaseRequest(server string, body CreateDatabaseJSONRequestBody) (*http.Request, error) {
+// NewCreateDashboardRequest calls the generic CreateDashboard builder with application/json body
+func NewCreateDashboardRequest(server string, body CreateDashboardJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateDatabaseRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateDashboardRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateDatabaseRequestWithBody generates requests for CreateDatabase with any type of body
-func NewCreateDatabaseRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateDashboardRequestWithBody generates requests for CreateDashboard with any type of body
+func NewCreateDashboardRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2724,7 +4423,7 @@ func NewCreateDatabaseRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/database")
+	operationPath := fmt.Sprintf("/dashboard")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2744,13 +4443,13 @@ func NewCreateDatabaseRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
-// NewDeleteDatabaseRequest generates requests for DeleteDatabase
-func NewDeleteDatabaseRequest(server string, databaseId int) (*http.Request, error) {
+// NewDeleteDashboardRequest generates requests for DeleteDashboard
+func NewDeleteDashboardRequest(server string, dashboardId int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "databaseId", runtime.ParamLocationPath, databaseId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dashboardId", runtime.ParamLocationPath, dashboardId)
 	if err != nil {
 		return nil, err
 	}
@@ -2760,7 +4459,7 @@ func NewDeleteDatabaseRequest(server string, databaseId int) (*http.Request, err
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/database/%s", pathParam0)
+	operationPath := fmt.Sprintf("/dashboard/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2778,13 +4477,13 @@ func NewDeleteDatabaseRequest(server string, databaseId int) (*http.Request, err
 	return req, nil
 }
 
-// NewGetDatabaseRequest generates requests for GetDatabase
-func NewGetDatabaseRequest(server string, databaseId int) (*http.Request, error) {
+// NewGetDashboardRequest generates requests for GetDashboard
+func NewGetDashboardRequest(server string, dashboardId int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "databaseId", runtime.ParamLocationPath, databaseId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dashboardId", runtime.ParamLocationPath, dashboardId)
 	if err != nil {
 		return nil, err
 	}
@@ -2794,7 +4493,7 @@ func NewGetDatabaseRequest(server string, databaseId int) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/database/%s", pathParam0)
+	operationPath := fmt.Sprintf("/dashboard/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2812,24 +4511,24 @@ func NewGetDatabaseRequest(server string, databaseId int) (*http.Request, error)
 	return req, nil
 }
 
-// NewUpdateDatabaseRequest calls the generic UpdateDatabase builder with application/json body
-func NewUpdateDatabaseRequest(server string, databaseId int, body UpdateDatabaseJSONRequestBody) (*http.Request, error) {
+// NewUpdateDashboardRequest calls the generic UpdateDashboard builder with application/json body
+func NewUpdateDashboardRequest(server string, dashboardId int, body UpdateDashboardJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateDatabaseRequestWithBody(server, databaseId, "application/json", bodyReader)
+	return NewUpdateDashboardRequestWithBody(server, dashboardId, "application/json", bodyReader)
 }
 
-// NewUpdateDatabaseRequestWithBody generates requests for UpdateDatabase with any type of body
-func NewUpdateDatabaseRequestWithBody(server string, databaseId int, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateDashboardRequestWithBody generates requests for UpdateDashboard with any type of body
+func NewUpdateDashboardRequestWithBody(server string, dashboardId int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "databaseId", runtime.ParamLocationPath, databaseId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dashboardId", runtime.ParamLocationPath, dashboardId)
 	if err != nil {
 		return nil, err
 	}
@@ -2839,7 +4538,7 @@ func NewUpdateDatabaseRequestWithBody(server string, databaseId int, contentType
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/database/%s", pathParam0)
+	operationPath := fmt.Sprintf("/dashboard/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2859,13 +4558,13 @@ func NewUpdateDatabaseRequestWithBody(server string, databaseId int, contentType
 	return req, nil
 }
 
-// NewGetFieldRequest generates requests for GetField
-func NewGetFieldRequest(server string, fieldId int) (*http.Request, error) {
+// NewGetDashboardQueryMetadataRequest generates requests for GetDashboardQueryMetadata
+func NewGetDashboardQueryMetadataRequest(server string, dashboardId int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "fieldId", runtime.ParamLocationPath, fieldId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "dashboardId", runtime.ParamLocationPath, dashboardId)
 	if err != nil {
 		return nil, err
 	}
@@ -2875,7 +4574,7 @@ func NewGetFieldRequest(server string, fieldId int) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/field/%s", pathParam0)
+	operationPath := fmt.Sprintf("/dashboard/%s/query_metadata", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2893,34 +4592,16 @@ func NewGetFieldRequest(server string, fieldId int) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUpdateFieldRequest calls the generic UpdateField builder with application/json body
-func NewUpdateFieldRequest(server string, fieldId int, body UpdateFieldJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateFieldRequestWithBody(server, fieldId, "application/json", bodyReader)
-}
-
-// NewUpdateFieldRequestWithBody generates requests for UpdateField with any type of body
-func NewUpdateFieldRequestWithBody(server string, fieldId int, contentType string, body io.Reader) (*http.Request, error) {
+// NewListDatabasesRequest generates requests for ListDatabases
+func NewListDatabasesRequest(server string, params *ListDatabasesParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "fieldId", runtime.ParamLocationPath, fieldId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/field/%s", pathParam0)
+	operationPath := fmt.Sprintf("/database")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2930,33 +4611,42 @@ func NewUpdateFieldRequestWithBody(server string, fieldId int, contentType strin
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	req.Header.Add("Content-Type", contentType)
+		if params.Include != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include", runtime.ParamLocationQuery, *params.Include); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewGetPermissionsGraphRequest generates requests for GetPermissionsGraph
-func NewGetPermissionsGraphRequest(server string) (*http.Request, error) {
-	var err error
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		if params.Saved != nil {
 
-	operationPath := fmt.Sprintf("/permissions/graph")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "saved", runtime.ParamLocationQuery, *params.Saved); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -2967,19 +4657,19 @@ func NewGetPermissionsGraphRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewReplacePermissionsGraphRequest calls the generic ReplacePermissionsGraph builder with application/json body
-func NewReplacePermissionsGraphRequest(server string, body ReplacePermissionsGraphJSONRequestBody) (*http.Request, error) {
+// NewCreateDatabaseRequest calls the generic CreateDatabase builder with application/json body
+func NewCreateDatabaseRequest(server string, body CreateDatabaseJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewReplacePermissionsGraphRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateDatabaseRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewReplacePermissionsGraphRequestWithBody generates requests for ReplacePermissionsGraph with any type of body
-func NewReplacePermissionsGraphRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateDatabaseRequestWithBody generates requests for CreateDatabase with any type of body
+func NewCreateDatabaseRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2987,7 +4677,7 @@ func NewReplacePermissionsGraphRequestWithBody(server string, contentType string
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/permissions/graph")
+	operationPath := fmt.Sprintf("/database")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2997,7 +4687,7 @@ func NewReplacePermissionsGraphRequestWithBody(server string, contentType string
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3007,19 +4697,19 @@ func NewReplacePermissionsGraphRequestWithBody(server string, contentType string
 	return req, nil
 }
 
-// NewCreatePermissionsGroupRequest calls the generic CreatePermissionsGroup builder with application/json body
-func NewCreatePermissionsGroupRequest(server string, body CreatePermissionsGroupJSONRequestBody) (*http.Request, error) {
+// NewValidateDatabaseRequest calls the generic ValidateDatabase builder with application/json body
+func NewValidateDatabaseRequest(server string, body ValidateDatabaseJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreatePermissionsGroupRequestWithBody(server, "application/json", bodyReader)
+	return NewValidateDatabaseRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreatePermissionsGroupRequestWithBody generates requests for CreatePermissionsGroup with any type of body
-func NewCreatePermissionsGroupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewValidateDatabaseRequestWithBody generates requests for ValidateDatabase with any type of body
+func NewValidateDatabaseRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3027,7 +4717,7 @@ func NewCreatePermissionsGroupRequestWithBody(server string, contentType string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/permissions/group")
+	operationPath := fmt.Sprintf("/database/validate")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3047,13 +4737,13 @@ func NewCreatePermissionsGroupRequestWithBody(server string, contentType string,
 	return req, nil
 }
 
-// NewDeletePermissionsGroupRequest generates requests for DeletePermissionsGroup
-func NewDeletePermissionsGroupRequest(server string, groupId int) (*http.Request, error) {
+// NewDeleteDatabaseRequest generates requests for DeleteDatabase
+func NewDeleteDatabaseRequest(server string, databaseId int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "databaseId", runtime.ParamLocationPath, databaseId)
 	if err != nil {
 		return nil, err
 	}
@@ -3063,7 +4753,7 @@ func NewDeletePermissionsGroupRequest(server string, groupId int) (*http.Request
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/permissions/group/%s", pathParam0)
+	operationPath := fmt.Sprintf("/database/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3081,13 +4771,13 @@ func NewDeletePermissionsGroupRequest(server string, groupId int) (*http.Request
 	return req, nil
 }
 
-// NewGetPermissionsGroupRequest generates requests for GetPermissionsGroup
-func NewGetPermissionsGroupRequest(server string, groupId int) (*http.Request, error) {
+// NewGetDatabaseRequest generates requests for GetDatabase
+func NewGetDatabaseRequest(server string, databaseId int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "databaseId", runtime.ParamLocationPath, databaseId)
 	if err != nil {
 		return nil, err
 	}
@@ -3097,7 +4787,7 @@ func NewGetPermissionsGroupRequest(server string, groupId int) (*http.Request, e
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/permissions/group/%s", pathParam0)
+	operationPath := fmt.Sprintf("/database/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3115,24 +4805,24 @@ func NewGetPermissionsGroupRequest(server string, groupId int) (*http.Request, e
 	return req, nil
 }
 
-// NewUpdatePermissionsGroupRequest calls the generic UpdatePermissionsGroup builder with application/json body
-func NewUpdatePermissionsGroupRequest(server string, groupId int, body UpdatePermissionsGroupJSONRequestBody) (*http.Request, error) {
+// NewUpdateDatabaseRequest calls the generic UpdateDatabase builder with application/json body
+func NewUpdateDatabaseRequest(server string, databaseId int, body UpdateDatabaseJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdatePermissionsGroupRequestWithBody(server, groupId, "application/json", bodyReader)
+	return NewUpdateDatabaseRequestWithBody(server, databaseId, "application/json", bodyReader)
 }
 
-// NewUpdatePermissionsGroupRequestWithBody generates requests for UpdatePermissionsGroup with any type of body
-func NewUpdatePermissionsGroupRequestWithBody(server string, groupId int, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateDatabaseRequestWithBody generates requests for UpdateDatabase with any type of body
+func NewUpdateDatabaseRequestWithBody(server string, databaseId int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "databaseId", runtime.ParamLocationPath, databaseId)
 	if err != nil {
 		return nil, err
 	}
@@ -3142,7 +4832,7 @@ func NewUpdatePermissionsGroupRequestWithBody(server string, groupId int, conten
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/permissions/group/%s", pathParam0)
+	operationPath := fmt.Sprintf("/database/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3162,27 +4852,23 @@ func NewUpdatePermissionsGroupRequestWithBody(server string, groupId int, conten
 	return req, nil
 }
 
-// NewCreateSessionRequest calls the generic CreateSession builder with application/json body
-func NewCreateSessionRequest(server string, body CreateSessionJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewListDatabaseSchemasRequest generates requests for ListDatabaseSchemas
+func NewListDatabaseSchemasRequest(server string, databaseId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "databaseId", runtime.ParamLocationPath, databaseId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateSessionRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewCreateSessionRequestWithBody generates requests for CreateSession with any type of body
-func NewCreateSessionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/session")
+	operationPath := fmt.Sprintf("/database/%s/schemas", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3192,18 +4878,16 @@ func NewCreateSessionRequestWithBody(server string, contentType string, body io.
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewListTablesRequest generates requests for ListTables
-func NewListTablesRequest(server string) (*http.Request, error) {
+// NewGetImpersonationRequest generates requests for GetImpersonation
+func NewGetImpersonationRequest(server string, params *GetImpersonationParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3211,7 +4895,7 @@ func NewListTablesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/table")
+	operationPath := fmt.Sprintf("/ee/advanced-permissions/impersonation")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3221,6 +4905,36 @@ func NewListTablesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group_id", runtime.ParamLocationQuery, params.GroupId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "db_id", runtime.ParamLocationQuery, params.DbId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -3229,24 +4943,13 @@ func NewListTablesRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUpdateTableRequest calls the generic UpdateTable builder with application/json body
-func NewUpdateTableRequest(server string, tableId int, body UpdateTableJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateTableRequestWithBody(server, tableId, "application/json", bodyReader)
-}
-
-// NewUpdateTableRequestWithBody generates requests for UpdateTable with any type of body
-func NewUpdateTableRequestWithBody(server string, tableId int, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteImpersonationRequest generates requests for DeleteImpersonation
+func NewDeleteImpersonationRequest(server string, impersonationId int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableId", runtime.ParamLocationPath, tableId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "impersonationId", runtime.ParamLocationPath, impersonationId)
 	if err != nil {
 		return nil, err
 	}
@@ -3256,7 +4959,7 @@ func NewUpdateTableRequestWithBody(server string, tableId int, contentType strin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/table/%s", pathParam0)
+	operationPath := fmt.Sprintf("/ee/advanced-permissions/impersonation/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3266,33 +4969,24 @@ func NewUpdateTableRequestWithBody(server string, tableId int, contentType strin
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetTableMetadataRequest generates requests for GetTableMetadata
-func NewGetTableMetadataRequest(server string, tableId int, params *GetTableMetadataParams) (*http.Request, error) {
+// NewExportSerializationRequest generates requests for ExportSerialization
+func NewExportSerializationRequest(server string, params *ExportSerializationParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableId", runtime.ParamLocationPath, tableId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/table/%s/query_metadata", pathParam0)
+	operationPath := fmt.Sprintf("/ee/serialization/export")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3305,9 +4999,9 @@ func NewGetTableMetadataRequest(server string, tableId int, params *GetTableMeta
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.IncludeHiddenFields != nil {
+		if params.AllCollections != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_hidden_fields", runtime.ParamLocationQuery, *params.IncludeHiddenFields); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "all_collections", runtime.ParamLocationQuery, *params.AllCollections); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -3321,1278 +5015,4372 @@ func NewGetTableMetadataRequest(server string, tableId int, params *GetTableMeta
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+		if params.Settings != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "settings", runtime.ParamLocationQuery, *params.Settings); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
 		}
-	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+		if params.DataModel != nil {
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "data_model", runtime.ParamLocationQuery, *params.DataModel); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.FieldValues != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "field_values", runtime.ParamLocationQuery, *params.FieldValues); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+
+	return req, nil
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// NewImportSerializationRequestWithBody generates requests for ImportSerialization with any type of body
+func NewImportSerializationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// CreateCardWithBodyWithResponse request with any body
-	CreateCardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCardResponse, error)
+	operationPath := fmt.Sprintf("/ee/serialization/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	CreateCardWithResponse(ctx context.Context, body CreateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCardResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetCardWithResponse request
-	GetCardWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*GetCardResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateCardWithBodyWithResponse request with any body
-	UpdateCardWithBodyWithResponse(ctx context.Context, cardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCardResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	UpdateCardWithResponse(ctx context.Context, cardId int, body UpdateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCardResponse, error)
+	return req, nil
+}
 
-	// ListCollectionsWithResponse request
-	ListCollectionsWithResponse(ctx context.Context, params *ListCollectionsParams, reqEditors ...RequestEditorFn) (*ListCollectionsResponse, error)
+// NewGetFieldRequest generates requests for GetField
+func NewGetFieldRequest(server string, fieldId int) (*http.Request, error) {
+	var err error
 
-	// CreateCollectionWithBodyWithResponse request with any body
-	CreateCollectionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCollectionResponse, error)
+	var pathParam0 string
 
-	CreateCollectionWithResponse(ctx context.Context, body CreateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCollectionResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "fieldId", runtime.ParamLocationPath, fieldId)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetCollectionPermissionsGraphWithResponse request
-	GetCollectionPermissionsGraphWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCollectionPermissionsGraphResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// ReplaceCollectionPermissionsGraphWithBodyWithResponse request with any body
-	ReplaceCollectionPermissionsGraphWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceCollectionPermissionsGraphResponse, error)
+	operationPath := fmt.Sprintf("/field/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	ReplaceCollectionPermissionsGraphWithResponse(ctx context.Context, body ReplaceCollectionPermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceCollectionPermissionsGraphResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetCollectionWithResponse request
-	GetCollectionWithResponse(ctx context.Context, collectionId string, reqEditors ...RequestEditorFn) (*GetCollectionResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateCollectionWithBodyWithResponse request with any body
-	UpdateCollectionWithBodyWithResponse(ctx context.Context, collectionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCollectionResponse, error)
+	return req, nil
+}
 
-	UpdateCollectionWithResponse(ctx context.Context, collectionId string, body UpdateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCollectionResponse, error)
+// NewUpdateFieldRequest calls the generic UpdateField builder with application/json body
+func NewUpdateFieldRequest(server string, fieldId int, body UpdateFieldJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateFieldRequestWithBody(server, fieldId, "application/json", bodyReader)
+}
 
-	// ListCollectionItemsWithResponse request
-	ListCollectionItemsWithResponse(ctx context.Context, collectionId string, params *ListCollectionItemsParams, reqEditors ...RequestEditorFn) (*ListCollectionItemsResponse, error)
+// NewUpdateFieldRequestWithBody generates requests for UpdateField with any type of body
+func NewUpdateFieldRequestWithBody(server string, fieldId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// CreateDashboardWithBodyWithResponse request with any body
-	CreateDashboardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDashboardResponse, error)
+	var pathParam0 string
 
-	CreateDashboardWithResponse(ctx context.Context, body CreateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDashboardResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "fieldId", runtime.ParamLocationPath, fieldId)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteDashboardWithResponse request
-	DeleteDashboardWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*DeleteDashboardResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetDashboardWithResponse request
-	GetDashboardWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*GetDashboardResponse, error)
+	operationPath := fmt.Sprintf("/field/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// UpdateDashboardWithBodyWithResponse request with any body
-	UpdateDashboardWithBodyWithResponse(ctx context.Context, dashboardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDashboardResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateDashboardWithResponse(ctx context.Context, dashboardId int, body UpdateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDashboardResponse, error)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListDatabasesWithResponse request
-	ListDatabasesWithResponse(ctx context.Context, params *ListDatabasesParams, reqEditors ...RequestEditorFn) (*ListDatabasesResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// CreateDatabaseWithBodyWithResponse request with any body
-	CreateDatabaseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error)
+	return req, nil
+}
 
-	CreateDatabaseWithResponse(ctx context.Context, body CreateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error)
+// NewDeleteFieldDimensionRequest generates requests for DeleteFieldDimension
+func NewDeleteFieldDimensionRequest(server string, fieldId int) (*http.Request, error) {
+	var err error
 
-	// DeleteDatabaseWithResponse request
-	DeleteDatabaseWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*DeleteDatabaseResponse, error)
+	var pathParam0 string
 
-	// GetDatabaseWithResponse request
-	GetDatabaseWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*GetDatabaseResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "fieldId", runtime.ParamLocationPath, fieldId)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateDatabaseWithBodyWithResponse request with any body
-	UpdateDatabaseWithBodyWithResponse(ctx context.Context, databaseId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateDatabaseWithResponse(ctx context.Context, databaseId int, body UpdateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error)
+	operationPath := fmt.Sprintf("/field/%s/dimension", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetFieldWithResponse request
-	GetFieldWithResponse(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*GetFieldResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateFieldWithBodyWithResponse request with any body
-	UpdateFieldWithBodyWithResponse(ctx context.Context, fieldId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFieldResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateFieldWithResponse(ctx context.Context, fieldId int, body UpdateFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFieldResponse, error)
+	return req, nil
+}
 
-	// GetPermissionsGraphWithResponse request
-	GetPermissionsGraphWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPermissionsGraphResponse, error)
+// NewCreateFieldDimensionRequest calls the generic CreateFieldDimension builder with application/json body
+func NewCreateFieldDimensionRequest(server string, fieldId int, body CreateFieldDimensionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateFieldDimensionRequestWithBody(server, fieldId, "application/json", bodyReader)
+}
 
-	// ReplacePermissionsGraphWithBodyWithResponse request with any body
-	ReplacePermissionsGraphWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplacePermissionsGraphResponse, error)
+// NewCreateFieldDimensionRequestWithBody generates requests for CreateFieldDimension with any type of body
+func NewCreateFieldDimensionRequestWithBody(server string, fieldId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	ReplacePermissionsGraphWithResponse(ctx context.Context, body ReplacePermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplacePermissionsGraphResponse, error)
+	var pathParam0 string
 
-	// CreatePermissionsGroupWithBodyWithResponse request with any body
-	CreatePermissionsGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePermissionsGroupResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "fieldId", runtime.ParamLocationPath, fieldId)
+	if err != nil {
+		return nil, err
+	}
 
-	CreatePermissionsGroupWithResponse(ctx context.Context, body CreatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePermissionsGroupResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeletePermissionsGroupWithResponse request
-	DeletePermissionsGroupWithResponse(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*DeletePermissionsGroupResponse, error)
+	operationPath := fmt.Sprintf("/field/%s/dimension", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetPermissionsGroupWithResponse request
-	GetPermissionsGroupWithResponse(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*GetPermissionsGroupResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdatePermissionsGroupWithBodyWithResponse request with any body
-	UpdatePermissionsGroupWithBodyWithResponse(ctx context.Context, groupId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePermissionsGroupResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdatePermissionsGroupWithResponse(ctx context.Context, groupId int, body UpdatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePermissionsGroupResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// CreateSessionWithBodyWithResponse request with any body
-	CreateSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error)
+	return req, nil
+}
 
-	CreateSessionWithResponse(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error)
+// NewGetFieldValuesRequest generates requests for GetFieldValues
+func NewGetFieldValuesRequest(server string, fieldId int) (*http.Request, error) {
+	var err error
 
-	// ListTablesWithResponse request
-	ListTablesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTablesResponse, error)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "fieldId", runtime.ParamLocationPath, fieldId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/field/%s/values", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateFieldValuesRequest calls the generic UpdateFieldValues builder with application/json body
+func NewUpdateFieldValuesRequest(server string, fieldId int, body UpdateFieldValuesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateFieldValuesRequestWithBody(server, fieldId, "application/json", bodyReader)
+}
+
+// NewUpdateFieldValuesRequestWithBody generates requests for UpdateFieldValues with any type of body
+func NewUpdateFieldValuesRequestWithBody(server string, fieldId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "fieldId", runtime.ParamLocationPath, fieldId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/field/%s/values", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateModerationReviewRequest calls the generic CreateModerationReview builder with application/json body
+func NewCreateModerationReviewRequest(server string, body CreateModerationReviewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateModerationReviewRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateModerationReviewRequestWithBody generates requests for CreateModerationReview with any type of body
+func NewCreateModerationReviewRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/moderation-review")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateSandboxRequest calls the generic CreateSandbox builder with application/json body
+func NewCreateSandboxRequest(server string, body CreateSandboxJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSandboxRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSandboxRequestWithBody generates requests for CreateSandbox with any type of body
+func NewCreateSandboxRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/mt/gtap")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteSandboxRequest generates requests for DeleteSandbox
+func NewDeleteSandboxRequest(server string, sandboxId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sandboxId", runtime.ParamLocationPath, sandboxId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/mt/gtap/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSandboxRequest generates requests for GetSandbox
+func NewGetSandboxRequest(server string, sandboxId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sandboxId", runtime.ParamLocationPath, sandboxId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/mt/gtap/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateSandboxRequest calls the generic UpdateSandbox builder with application/json body
+func NewUpdateSandboxRequest(server string, sandboxId int, body UpdateSandboxJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSandboxRequestWithBody(server, sandboxId, "application/json", bodyReader)
+}
+
+// NewUpdateSandboxRequestWithBody generates requests for UpdateSandbox with any type of body
+func NewUpdateSandboxRequestWithBody(server string, sandboxId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sandboxId", runtime.ParamLocationPath, sandboxId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/mt/gtap/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPermissionsGraphRequest generates requests for GetPermissionsGraph
+func NewGetPermissionsGraphRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions/graph")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReplacePermissionsGraphRequest calls the generic ReplacePermissionsGraph builder with application/json body
+func NewReplacePermissionsGraphRequest(server string, body ReplacePermissionsGraphJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReplacePermissionsGraphRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewReplacePermissionsGraphRequestWithBody generates requests for ReplacePermissionsGraph with any type of body
+func NewReplacePermissionsGraphRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions/graph")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListPermissionsGroupsRequest generates requests for ListPermissionsGroups
+func NewListPermissionsGroupsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions/group")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePermissionsGroupRequest calls the generic CreatePermissionsGroup builder with application/json body
+func NewCreatePermissionsGroupRequest(server string, body CreatePermissionsGroupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePermissionsGroupRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePermissionsGroupRequestWithBody generates requests for CreatePermissionsGroup with any type of body
+func NewCreatePermissionsGroupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions/group")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePermissionsGroupRequest generates requests for DeletePermissionsGroup
+func NewDeletePermissionsGroupRequest(server string, groupId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions/group/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPermissionsGroupRequest generates requests for GetPermissionsGroup
+func NewGetPermissionsGroupRequest(server string, groupId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions/group/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePermissionsGroupRequest calls the generic UpdatePermissionsGroup builder with application/json body
+func NewUpdatePermissionsGroupRequest(server string, groupId int, body UpdatePermissionsGroupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePermissionsGroupRequestWithBody(server, groupId, "application/json", bodyReader)
+}
+
+// NewUpdatePermissionsGroupRequestWithBody generates requests for UpdatePermissionsGroup with any type of body
+func NewUpdatePermissionsGroupRequestWithBody(server string, groupId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "groupId", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions/group/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreatePermissionsMembershipRequest calls the generic CreatePermissionsMembership builder with application/json body
+func NewCreatePermissionsMembershipRequest(server string, body CreatePermissionsMembershipJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePermissionsMembershipRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePermissionsMembershipRequestWithBody generates requests for CreatePermissionsMembership with any type of body
+func NewCreatePermissionsMembershipRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions/membership")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePermissionsMembershipRequest generates requests for DeletePermissionsMembership
+func NewDeletePermissionsMembershipRequest(server string, membershipId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "membershipId", runtime.ParamLocationPath, membershipId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/permissions/membership/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPersistedInfoRequest generates requests for GetPersistedInfo
+func NewGetPersistedInfoRequest(server string, cardId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cardId", runtime.ParamLocationPath, cardId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/persist/card/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPersistModelRequest generates requests for PersistModel
+func NewPersistModelRequest(server string, cardId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cardId", runtime.ParamLocationPath, cardId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/persist/card/%s/persist", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUnpersistModelRequest generates requests for UnpersistModel
+func NewUnpersistModelRequest(server string, cardId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cardId", runtime.ParamLocationPath, cardId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/persist/card/%s/unpersist", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPersistDatabaseRequest generates requests for PersistDatabase
+func NewPersistDatabaseRequest(server string, databaseId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "databaseId", runtime.ParamLocationPath, databaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/persist/database/%s/persist", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUnpersistDatabaseRequest generates requests for UnpersistDatabase
+func NewUnpersistDatabaseRequest(server string, databaseId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "databaseId", runtime.ParamLocationPath, databaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/persist/database/%s/unpersist", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEnablePersistenceRequest calls the generic EnablePersistence builder with application/json body
+func NewEnablePersistenceRequest(server string, body EnablePersistenceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEnablePersistenceRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewEnablePersistenceRequestWithBody generates requests for EnablePersistence with any type of body
+func NewEnablePersistenceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/persist/enable")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetPersistenceRefreshScheduleRequest calls the generic SetPersistenceRefreshSchedule builder with application/json body
+func NewSetPersistenceRefreshScheduleRequest(server string, body SetPersistenceRefreshScheduleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetPersistenceRefreshScheduleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSetPersistenceRefreshScheduleRequestWithBody generates requests for SetPersistenceRefreshSchedule with any type of body
+func NewSetPersistenceRefreshScheduleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/persist/set-refresh-schedule")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateSessionRequest calls the generic CreateSession builder with application/json body
+func NewCreateSessionRequest(server string, body CreateSessionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSessionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSessionRequestWithBody generates requests for CreateSession with any type of body
+func NewCreateSessionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/session")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSessionPropertiesRequest generates requests for GetSessionProperties
+func NewGetSessionPropertiesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/session/properties")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListTablesRequest generates requests for ListTables
+func NewListTablesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/table")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTableRequest calls the generic UpdateTable builder with application/json body
+func NewUpdateTableRequest(server string, tableId int, body UpdateTableJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTableRequestWithBody(server, tableId, "application/json", bodyReader)
+}
+
+// NewUpdateTableRequestWithBody generates requests for UpdateTable with any type of body
+func NewUpdateTableRequestWithBody(server string, tableId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableId", runtime.ParamLocationPath, tableId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/table/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTableForeignKeysRequest generates requests for GetTableForeignKeys
+func NewGetTableForeignKeysRequest(server string, tableId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableId", runtime.ParamLocationPath, tableId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/table/%s/fks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTableMetadataRequest generates requests for GetTableMetadata
+func NewGetTableMetadataRequest(server string, tableId int, params *GetTableMetadataParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tableId", runtime.ParamLocationPath, tableId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/table/%s/query_metadata", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeHiddenFields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_hidden_fields", runtime.ParamLocationQuery, *params.IncludeHiddenFields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// CreateCardWithBodyWithResponse request with any body
+	CreateCardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCardResponse, error)
+
+	CreateCardWithResponse(ctx context.Context, body CreateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCardResponse, error)
+
+	// GetCardWithResponse request
+	GetCardWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*GetCardResponse, error)
+
+	// UpdateCardWithBodyWithResponse request with any body
+	UpdateCardWithBodyWithResponse(ctx context.Context, cardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCardResponse, error)
+
+	UpdateCardWithResponse(ctx context.Context, cardId int, body UpdateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCardResponse, error)
+
+	// GetCardDashboardsWithResponse request
+	GetCardDashboardsWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*GetCardDashboardsResponse, error)
+
+	// GetCardRelatedWithResponse request
+	GetCardRelatedWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*GetCardRelatedResponse, error)
+
+	// ListCollectionsWithResponse request
+	ListCollectionsWithResponse(ctx context.Context, params *ListCollectionsParams, reqEditors ...RequestEditorFn) (*ListCollectionsResponse, error)
+
+	// CreateCollectionWithBodyWithResponse request with any body
+	CreateCollectionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCollectionResponse, error)
+
+	CreateCollectionWithResponse(ctx context.Context, body CreateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCollectionResponse, error)
+
+	// GetCollectionPermissionsGraphWithResponse request
+	GetCollectionPermissionsGraphWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCollectionPermissionsGraphResponse, error)
+
+	// ReplaceCollectionPermissionsGraphWithBodyWithResponse request with any body
+	ReplaceCollectionPermissionsGraphWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceCollectionPermissionsGraphResponse, error)
+
+	ReplaceCollectionPermissionsGraphWithResponse(ctx context.Context, body ReplaceCollectionPermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceCollectionPermissionsGraphResponse, error)
+
+	// GetCollectionWithResponse request
+	GetCollectionWithResponse(ctx context.Context, collectionId string, reqEditors ...RequestEditorFn) (*GetCollectionResponse, error)
+
+	// UpdateCollectionWithBodyWithResponse request with any body
+	UpdateCollectionWithBodyWithResponse(ctx context.Context, collectionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCollectionResponse, error)
+
+	UpdateCollectionWithResponse(ctx context.Context, collectionId string, body UpdateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCollectionResponse, error)
+
+	// ListCollectionItemsWithResponse request
+	ListCollectionItemsWithResponse(ctx context.Context, collectionId string, params *ListCollectionItemsParams, reqEditors ...RequestEditorFn) (*ListCollectionItemsResponse, error)
+
+	// CreateDashboardWithBodyWithResponse request with any body
+	CreateDashboardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDashboardResponse, error)
+
+	CreateDashboardWithResponse(ctx context.Context, body CreateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDashboardResponse, error)
+
+	// DeleteDashboardWithResponse request
+	DeleteDashboardWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*DeleteDashboardResponse, error)
+
+	// GetDashboardWithResponse request
+	GetDashboardWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*GetDashboardResponse, error)
+
+	// UpdateDashboardWithBodyWithResponse request with any body
+	UpdateDashboardWithBodyWithResponse(ctx context.Context, dashboardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDashboardResponse, error)
+
+	UpdateDashboardWithResponse(ctx context.Context, dashboardId int, body UpdateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDashboardResponse, error)
+
+	// GetDashboardQueryMetadataWithResponse request
+	GetDashboardQueryMetadataWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*GetDashboardQueryMetadataResponse, error)
+
+	// ListDatabasesWithResponse request
+	ListDatabasesWithResponse(ctx context.Context, params *ListDatabasesParams, reqEditors ...RequestEditorFn) (*ListDatabasesResponse, error)
+
+	// CreateDatabaseWithBodyWithResponse request with any body
+	CreateDatabaseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error)
+
+	CreateDatabaseWithResponse(ctx context.Context, body CreateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error)
+
+	// ValidateDatabaseWithBodyWithResponse request with any body
+	ValidateDatabaseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateDatabaseResponse, error)
+
+	ValidateDatabaseWithResponse(ctx context.Context, body ValidateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateDatabaseResponse, error)
+
+	// DeleteDatabaseWithResponse request
+	DeleteDatabaseWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*DeleteDatabaseResponse, error)
+
+	// GetDatabaseWithResponse request
+	GetDatabaseWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*GetDatabaseResponse, error)
+
+	// UpdateDatabaseWithBodyWithResponse request with any body
+	UpdateDatabaseWithBodyWithResponse(ctx context.Context, databaseId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error)
+
+	UpdateDatabaseWithResponse(ctx context.Context, databaseId int, body UpdateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error)
+
+	// ListDatabaseSchemasWithResponse request
+	ListDatabaseSchemasWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*ListDatabaseSchemasResponse, error)
+
+	// GetImpersonationWithResponse request
+	GetImpersonationWithResponse(ctx context.Context, params *GetImpersonationParams, reqEditors ...RequestEditorFn) (*GetImpersonationResponse, error)
+
+	// DeleteImpersonationWithResponse request
+	DeleteImpersonationWithResponse(ctx context.Context, impersonationId int, reqEditors ...RequestEditorFn) (*DeleteImpersonationResponse, error)
+
+	// ExportSerializationWithResponse request
+	ExportSerializationWithResponse(ctx context.Context, params *ExportSerializationParams, reqEditors ...RequestEditorFn) (*ExportSerializationResponse, error)
+
+	// ImportSerializationWithBodyWithResponse request with any body
+	ImportSerializationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportSerializationResponse, error)
+
+	// GetFieldWithResponse request
+	GetFieldWithResponse(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*GetFieldResponse, error)
+
+	// UpdateFieldWithBodyWithResponse request with any body
+	UpdateFieldWithBodyWithResponse(ctx context.Context, fieldId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFieldResponse, error)
+
+	UpdateFieldWithResponse(ctx context.Context, fieldId int, body UpdateFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFieldResponse, error)
+
+	// DeleteFieldDimensionWithResponse request
+	DeleteFieldDimensionWithResponse(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*DeleteFieldDimensionResponse, error)
+
+	// CreateFieldDimensionWithBodyWithResponse request with any body
+	CreateFieldDimensionWithBodyWithResponse(ctx context.Context, fieldId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFieldDimensionResponse, error)
+
+	CreateFieldDimensionWithResponse(ctx context.Context, fieldId int, body CreateFieldDimensionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFieldDimensionResponse, error)
+
+	// GetFieldValuesWithResponse request
+	GetFieldValuesWithResponse(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*GetFieldValuesResponse, error)
+
+	// UpdateFieldValuesWithBodyWithResponse request with any body
+	UpdateFieldValuesWithBodyWithResponse(ctx context.Context, fieldId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFieldValuesResponse, error)
+
+	UpdateFieldValuesWithResponse(ctx context.Context, fieldId int, body UpdateFieldValuesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFieldValuesResponse, error)
+
+	// CreateModerationReviewWithBodyWithResponse request with any body
+	CreateModerationReviewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateModerationReviewResponse, error)
+
+	CreateModerationReviewWithResponse(ctx context.Context, body CreateModerationReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateModerationReviewResponse, error)
+
+	// CreateSandboxWithBodyWithResponse request with any body
+	CreateSandboxWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSandboxResponse, error)
+
+	CreateSandboxWithResponse(ctx context.Context, body CreateSandboxJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSandboxResponse, error)
+
+	// DeleteSandboxWithResponse request
+	DeleteSandboxWithResponse(ctx context.Context, sandboxId int, reqEditors ...RequestEditorFn) (*DeleteSandboxResponse, error)
+
+	// GetSandboxWithResponse request
+	GetSandboxWithResponse(ctx context.Context, sandboxId int, reqEditors ...RequestEditorFn) (*GetSandboxResponse, error)
+
+	// UpdateSandboxWithBodyWithResponse request with any body
+	UpdateSandboxWithBodyWithResponse(ctx context.Context, sandboxId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSandboxResponse, error)
+
+	UpdateSandboxWithResponse(ctx context.Context, sandboxId int, body UpdateSandboxJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSandboxResponse, error)
+
+	// GetPermissionsGraphWithResponse request
+	GetPermissionsGraphWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPermissionsGraphResponse, error)
+
+	// ReplacePermissionsGraphWithBodyWithResponse request with any body
+	ReplacePermissionsGraphWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplacePermissionsGraphResponse, error)
+
+	ReplacePermissionsGraphWithResponse(ctx context.Context, body ReplacePermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplacePermissionsGraphResponse, error)
+
+	// ListPermissionsGroupsWithResponse request
+	ListPermissionsGroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPermissionsGroupsResponse, error)
+
+	// CreatePermissionsGroupWithBodyWithResponse request with any body
+	CreatePermissionsGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePermissionsGroupResponse, error)
+
+	CreatePermissionsGroupWithResponse(ctx context.Context, body CreatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePermissionsGroupResponse, error)
+
+	// DeletePermissionsGroupWithResponse request
+	DeletePermissionsGroupWithResponse(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*DeletePermissionsGroupResponse, error)
+
+	// GetPermissionsGroupWithResponse request
+	GetPermissionsGroupWithResponse(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*GetPermissionsGroupResponse, error)
+
+	// UpdatePermissionsGroupWithBodyWithResponse request with any body
+	UpdatePermissionsGroupWithBodyWithResponse(ctx context.Context, groupId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePermissionsGroupResponse, error)
+
+	UpdatePermissionsGroupWithResponse(ctx context.Context, groupId int, body UpdatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePermissionsGroupResponse, error)
+
+	// CreatePermissionsMembershipWithBodyWithResponse request with any body
+	CreatePermissionsMembershipWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePermissionsMembershipResponse, error)
+
+	CreatePermissionsMembershipWithResponse(ctx context.Context, body CreatePermissionsMembershipJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePermissionsMembershipResponse, error)
+
+	// DeletePermissionsMembershipWithResponse request
+	DeletePermissionsMembershipWithResponse(ctx context.Context, membershipId int, reqEditors ...RequestEditorFn) (*DeletePermissionsMembershipResponse, error)
+
+	// GetPersistedInfoWithResponse request
+	GetPersistedInfoWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*GetPersistedInfoResponse, error)
+
+	// PersistModelWithResponse request
+	PersistModelWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*PersistModelResponse, error)
+
+	// UnpersistModelWithResponse request
+	UnpersistModelWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*UnpersistModelResponse, error)
+
+	// PersistDatabaseWithResponse request
+	PersistDatabaseWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*PersistDatabaseResponse, error)
+
+	// UnpersistDatabaseWithResponse request
+	UnpersistDatabaseWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*UnpersistDatabaseResponse, error)
+
+	// EnablePersistenceWithBodyWithResponse request with any body
+	EnablePersistenceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EnablePersistenceResponse, error)
+
+	EnablePersistenceWithResponse(ctx context.Context, body EnablePersistenceJSONRequestBody, reqEditors ...RequestEditorFn) (*EnablePersistenceResponse, error)
+
+	// SetPersistenceRefreshScheduleWithBodyWithResponse request with any body
+	SetPersistenceRefreshScheduleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetPersistenceRefreshScheduleResponse, error)
+
+	SetPersistenceRefreshScheduleWithResponse(ctx context.Context, body SetPersistenceRefreshScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetPersistenceRefreshScheduleResponse, error)
+
+	// CreateSessionWithBodyWithResponse request with any body
+	CreateSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error)
+
+	CreateSessionWithResponse(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error)
+
+	// GetSessionPropertiesWithResponse request
+	GetSessionPropertiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSessionPropertiesResponse, error)
+
+	// ListTablesWithResponse request
+	ListTablesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTablesResponse, error)
 
 	// UpdateTableWithBodyWithResponse request with any body
 	UpdateTableWithBodyWithResponse(ctx context.Context, tableId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTableResponse, error)
 
-	UpdateTableWithResponse(ctx context.Context, tableId int, body UpdateTableJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTableResponse, error)
+	UpdateTableWithResponse(ctx context.Context, tableId int, body UpdateTableJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTableResponse, error)
+
+	// GetTableForeignKeysWithResponse request
+	GetTableForeignKeysWithResponse(ctx context.Context, tableId int, reqEditors ...RequestEditorFn) (*GetTableForeignKeysResponse, error)
+
+	// GetTableMetadataWithResponse request
+	GetTableMetadataWithResponse(ctx context.Context, tableId int, params *GetTableMetadataParams, reqEditors ...RequestEditorFn) (*GetTableMetadataResponse, error)
+}
+
+type CreateCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Card
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Card
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Card
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCardDashboardsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CardDashboard
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCardDashboardsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCardDashboardsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCardRelatedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CardRelated
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCardRelatedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCardRelatedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCollectionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Collection
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCollectionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCollectionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCollectionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Collection
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCollectionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCollectionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCollectionPermissionsGraphResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CollectionPermissionsGraph
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCollectionPermissionsGraphResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCollectionPermissionsGraphResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReplaceCollectionPermissionsGraphResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CollectionPermissionsGraph
+}
+
+// Status returns HTTPResponse.Status
+func (r ReplaceCollectionPermissionsGraphResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReplaceCollectionPermissionsGraphResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCollectionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Collection
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCollectionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCollectionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCollectionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Collection
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCollectionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCollectionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCollectionItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CollectionItemList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCollectionItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCollectionItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Dashboard
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Dashboard
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Dashboard
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDashboardQueryMetadataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DashboardQueryMetadata
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDashboardQueryMetadataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDashboardQueryMetadataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatabasesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseList
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatabasesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatabasesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Database
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ValidateDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatabaseValidationResult
+}
+
+// Status returns HTTPResponse.Status
+func (r ValidateDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ValidateDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Database
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Database
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatabaseSchemasResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]string
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatabaseSchemasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatabaseSchemasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetImpersonationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Impersonation
+}
+
+// Status returns HTTPResponse.Status
+func (r GetImpersonationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetImpersonationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteImpersonationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteImpersonationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteImpersonationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportSerializationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportSerializationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportSerializationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportSerializationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportSerializationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportSerializationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFieldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Field
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFieldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFieldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateFieldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Field
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateFieldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateFieldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteFieldDimensionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteFieldDimensionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteFieldDimensionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateFieldDimensionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FieldDimension
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateFieldDimensionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateFieldDimensionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFieldValuesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FieldValues
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFieldValuesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFieldValuesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateFieldValuesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateFieldValuesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateFieldValuesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateModerationReviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ModerationReview
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateModerationReviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateModerationReviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSandboxResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Sandbox
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSandboxResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSandboxResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSandboxResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSandboxResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSandboxResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSandboxResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Sandbox
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSandboxResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSandboxResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSandboxResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Sandbox
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSandboxResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSandboxResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPermissionsGraphResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PermissionsGraph
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPermissionsGraphResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPermissionsGraphResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReplacePermissionsGraphResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PermissionsGraph
+}
+
+// Status returns HTTPResponse.Status
+func (r ReplacePermissionsGraphResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReplacePermissionsGraphResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPermissionsGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PermissionsGroup
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPermissionsGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPermissionsGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePermissionsGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PermissionsGroup
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePermissionsGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePermissionsGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePermissionsGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePermissionsGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePermissionsGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPermissionsGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PermissionsGroup
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPermissionsGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPermissionsGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePermissionsGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PermissionsGroup
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePermissionsGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePermissionsGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePermissionsMembershipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PermissionsGroupMember
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePermissionsMembershipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePermissionsMembershipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePermissionsMembershipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePermissionsMembershipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePermissionsMembershipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPersistedInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PersistedInfo
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPersistedInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPersistedInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PersistModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PersistModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PersistModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnpersistModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UnpersistModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnpersistModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PersistDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PersistDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PersistDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnpersistDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UnpersistDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnpersistDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EnablePersistenceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r EnablePersistenceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EnablePersistenceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetPersistenceRefreshScheduleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r SetPersistenceRefreshScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetPersistenceRefreshScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Session
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSessionPropertiesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SessionProperties
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSessionPropertiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSessionPropertiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTablesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Table
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTablesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTablesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTableResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Table
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTableResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTableResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTableForeignKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TableForeignKey
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTableForeignKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTableForeignKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTableMetadataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TableMetadata
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTableMetadataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTableMetadataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CreateCardWithBodyWithResponse request with arbitrary body returning *CreateCardResponse
+func (c *ClientWithResponses) CreateCardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCardResponse, error) {
+	rsp, err := c.CreateCardWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCardResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCardWithResponse(ctx context.Context, body CreateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCardResponse, error) {
+	rsp, err := c.CreateCard(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCardResponse(rsp)
+}
+
+// GetCardWithResponse request returning *GetCardResponse
+func (c *ClientWithResponses) GetCardWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*GetCardResponse, error) {
+	rsp, err := c.GetCard(ctx, cardId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCardResponse(rsp)
+}
+
+// UpdateCardWithBodyWithResponse request with arbitrary body returning *UpdateCardResponse
+func (c *ClientWithResponses) UpdateCardWithBodyWithResponse(ctx context.Context, cardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCardResponse, error) {
+	rsp, err := c.UpdateCardWithBody(ctx, cardId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCardResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCardWithResponse(ctx context.Context, cardId int, body UpdateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCardResponse, error) {
+	rsp, err := c.UpdateCard(ctx, cardId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCardResponse(rsp)
+}
+
+// GetCardDashboardsWithResponse request returning *GetCardDashboardsResponse
+func (c *ClientWithResponses) GetCardDashboardsWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*GetCardDashboardsResponse, error) {
+	rsp, err := c.GetCardDashboards(ctx, cardId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCardDashboardsResponse(rsp)
+}
+
+// GetCardRelatedWithResponse request returning *GetCardRelatedResponse
+func (c *ClientWithResponses) GetCardRelatedWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*GetCardRelatedResponse, error) {
+	rsp, err := c.GetCardRelated(ctx, cardId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCardRelatedResponse(rsp)
+}
+
+// ListCollectionsWithResponse request returning *ListCollectionsResponse
+func (c *ClientWithResponses) ListCollectionsWithResponse(ctx context.Context, params *ListCollectionsParams, reqEditors ...RequestEditorFn) (*ListCollectionsResponse, error) {
+	rsp, err := c.ListCollections(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCollectionsResponse(rsp)
+}
 
-	// GetTableMetadataWithResponse request
-	GetTableMetadataWithResponse(ctx context.Context, tableId int, params *GetTableMetadataParams, reqEditors ...RequestEditorFn) (*GetTableMetadataResponse, error)
+// CreateCollectionWithBodyWithResponse request with arbitrary body returning *CreateCollectionResponse
+func (c *ClientWithResponses) CreateCollectionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCollectionResponse, error) {
+	rsp, err := c.CreateCollectionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCollectionResponse(rsp)
 }
 
-type CreateCardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Card
+func (c *ClientWithResponses) CreateCollectionWithResponse(ctx context.Context, body CreateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCollectionResponse, error) {
+	rsp, err := c.CreateCollection(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCollectionResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateCardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetCollectionPermissionsGraphWithResponse request returning *GetCollectionPermissionsGraphResponse
+func (c *ClientWithResponses) GetCollectionPermissionsGraphWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCollectionPermissionsGraphResponse, error) {
+	rsp, err := c.GetCollectionPermissionsGraph(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetCollectionPermissionsGraphResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateCardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ReplaceCollectionPermissionsGraphWithBodyWithResponse request with arbitrary body returning *ReplaceCollectionPermissionsGraphResponse
+func (c *ClientWithResponses) ReplaceCollectionPermissionsGraphWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceCollectionPermissionsGraphResponse, error) {
+	rsp, err := c.ReplaceCollectionPermissionsGraphWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseReplaceCollectionPermissionsGraphResponse(rsp)
 }
 
-type GetCardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Card
+func (c *ClientWithResponses) ReplaceCollectionPermissionsGraphWithResponse(ctx context.Context, body ReplaceCollectionPermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceCollectionPermissionsGraphResponse, error) {
+	rsp, err := c.ReplaceCollectionPermissionsGraph(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplaceCollectionPermissionsGraphResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetCardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetCollectionWithResponse request returning *GetCollectionResponse
+func (c *ClientWithResponses) GetCollectionWithResponse(ctx context.Context, collectionId string, reqEditors ...RequestEditorFn) (*GetCollectionResponse, error) {
+	rsp, err := c.GetCollection(ctx, collectionId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetCollectionResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UpdateCollectionWithBodyWithResponse request with arbitrary body returning *UpdateCollectionResponse
+func (c *ClientWithResponses) UpdateCollectionWithBodyWithResponse(ctx context.Context, collectionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCollectionResponse, error) {
+	rsp, err := c.UpdateCollectionWithBody(ctx, collectionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateCollectionResponse(rsp)
 }
 
-type UpdateCardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Card
+func (c *ClientWithResponses) UpdateCollectionWithResponse(ctx context.Context, collectionId string, body UpdateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCollectionResponse, error) {
+	rsp, err := c.UpdateCollection(ctx, collectionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCollectionResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateCardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ListCollectionItemsWithResponse request returning *ListCollectionItemsResponse
+func (c *ClientWithResponses) ListCollectionItemsWithResponse(ctx context.Context, collectionId string, params *ListCollectionItemsParams, reqEditors ...RequestEditorFn) (*ListCollectionItemsResponse, error) {
+	rsp, err := c.ListCollectionItems(ctx, collectionId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseListCollectionItemsResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateCardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CreateDashboardWithBodyWithResponse request with arbitrary body returning *CreateDashboardResponse
+func (c *ClientWithResponses) CreateDashboardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDashboardResponse, error) {
+	rsp, err := c.CreateDashboardWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDashboardResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDashboardWithResponse(ctx context.Context, body CreateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDashboardResponse, error) {
+	rsp, err := c.CreateDashboard(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDashboardResponse(rsp)
+}
+
+// DeleteDashboardWithResponse request returning *DeleteDashboardResponse
+func (c *ClientWithResponses) DeleteDashboardWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*DeleteDashboardResponse, error) {
+	rsp, err := c.DeleteDashboard(ctx, dashboardId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDashboardResponse(rsp)
+}
+
+// GetDashboardWithResponse request returning *GetDashboardResponse
+func (c *ClientWithResponses) GetDashboardWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*GetDashboardResponse, error) {
+	rsp, err := c.GetDashboard(ctx, dashboardId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDashboardResponse(rsp)
+}
+
+// UpdateDashboardWithBodyWithResponse request with arbitrary body returning *UpdateDashboardResponse
+func (c *ClientWithResponses) UpdateDashboardWithBodyWithResponse(ctx context.Context, dashboardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDashboardResponse, error) {
+	rsp, err := c.UpdateDashboardWithBody(ctx, dashboardId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDashboardResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDashboardWithResponse(ctx context.Context, dashboardId int, body UpdateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDashboardResponse, error) {
+	rsp, err := c.UpdateDashboard(ctx, dashboardId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDashboardResponse(rsp)
+}
+
+// GetDashboardQueryMetadataWithResponse request returning *GetDashboardQueryMetadataResponse
+func (c *ClientWithResponses) GetDashboardQueryMetadataWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*GetDashboardQueryMetadataResponse, error) {
+	rsp, err := c.GetDashboardQueryMetadata(ctx, dashboardId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDashboardQueryMetadataResponse(rsp)
+}
+
+// ListDatabasesWithResponse request returning *ListDatabasesResponse
+func (c *ClientWithResponses) ListDatabasesWithResponse(ctx context.Context, params *ListDatabasesParams, reqEditors ...RequestEditorFn) (*ListDatabasesResponse, error) {
+	rsp, err := c.ListDatabases(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDatabasesResponse(rsp)
+}
+
+// CreateDatabaseWithBodyWithResponse request with arbitrary body returning *CreateDatabaseResponse
+func (c *ClientWithResponses) CreateDatabaseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error) {
+	rsp, err := c.CreateDatabaseWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDatabaseWithResponse(ctx context.Context, body CreateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error) {
+	rsp, err := c.CreateDatabase(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDatabaseResponse(rsp)
+}
+
+// ValidateDatabaseWithBodyWithResponse request with arbitrary body returning *ValidateDatabaseResponse
+func (c *ClientWithResponses) ValidateDatabaseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateDatabaseResponse, error) {
+	rsp, err := c.ValidateDatabaseWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateDatabaseResponse(rsp)
+}
+
+func (c *ClientWithResponses) ValidateDatabaseWithResponse(ctx context.Context, body ValidateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateDatabaseResponse, error) {
+	rsp, err := c.ValidateDatabase(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateDatabaseResponse(rsp)
+}
+
+// DeleteDatabaseWithResponse request returning *DeleteDatabaseResponse
+func (c *ClientWithResponses) DeleteDatabaseWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*DeleteDatabaseResponse, error) {
+	rsp, err := c.DeleteDatabase(ctx, databaseId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDatabaseResponse(rsp)
+}
+
+// GetDatabaseWithResponse request returning *GetDatabaseResponse
+func (c *ClientWithResponses) GetDatabaseWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*GetDatabaseResponse, error) {
+	rsp, err := c.GetDatabase(ctx, databaseId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseResponse(rsp)
+}
+
+// UpdateDatabaseWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseResponse
+func (c *ClientWithResponses) UpdateDatabaseWithBodyWithResponse(ctx context.Context, databaseId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error) {
+	rsp, err := c.UpdateDatabaseWithBody(ctx, databaseId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDatabaseWithResponse(ctx context.Context, databaseId int, body UpdateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error) {
+	rsp, err := c.UpdateDatabase(ctx, databaseId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseResponse(rsp)
+}
+
+// ListDatabaseSchemasWithResponse request returning *ListDatabaseSchemasResponse
+func (c *ClientWithResponses) ListDatabaseSchemasWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*ListDatabaseSchemasResponse, error) {
+	rsp, err := c.ListDatabaseSchemas(ctx, databaseId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDatabaseSchemasResponse(rsp)
+}
+
+// GetImpersonationWithResponse request returning *GetImpersonationResponse
+func (c *ClientWithResponses) GetImpersonationWithResponse(ctx context.Context, params *GetImpersonationParams, reqEditors ...RequestEditorFn) (*GetImpersonationResponse, error) {
+	rsp, err := c.GetImpersonation(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetImpersonationResponse(rsp)
+}
+
+// DeleteImpersonationWithResponse request returning *DeleteImpersonationResponse
+func (c *ClientWithResponses) DeleteImpersonationWithResponse(ctx context.Context, impersonationId int, reqEditors ...RequestEditorFn) (*DeleteImpersonationResponse, error) {
+	rsp, err := c.DeleteImpersonation(ctx, impersonationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteImpersonationResponse(rsp)
+}
+
+// ExportSerializationWithResponse request returning *ExportSerializationResponse
+func (c *ClientWithResponses) ExportSerializationWithResponse(ctx context.Context, params *ExportSerializationParams, reqEditors ...RequestEditorFn) (*ExportSerializationResponse, error) {
+	rsp, err := c.ExportSerialization(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportSerializationResponse(rsp)
+}
+
+// ImportSerializationWithBodyWithResponse request with arbitrary body returning *ImportSerializationResponse
+func (c *ClientWithResponses) ImportSerializationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportSerializationResponse, error) {
+	rsp, err := c.ImportSerializationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseImportSerializationResponse(rsp)
 }
 
-type ListCollectionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Collection
+// GetFieldWithResponse request returning *GetFieldResponse
+func (c *ClientWithResponses) GetFieldWithResponse(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*GetFieldResponse, error) {
+	rsp, err := c.GetField(ctx, fieldId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFieldResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListCollectionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UpdateFieldWithBodyWithResponse request with arbitrary body returning *UpdateFieldResponse
+func (c *ClientWithResponses) UpdateFieldWithBodyWithResponse(ctx context.Context, fieldId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFieldResponse, error) {
+	rsp, err := c.UpdateFieldWithBody(ctx, fieldId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateFieldResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListCollectionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) UpdateFieldWithResponse(ctx context.Context, fieldId int, body UpdateFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFieldResponse, error) {
+	rsp, err := c.UpdateField(ctx, fieldId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateFieldResponse(rsp)
 }
 
-type CreateCollectionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Collection
+// DeleteFieldDimensionWithResponse request returning *DeleteFieldDimensionResponse
+func (c *ClientWithResponses) DeleteFieldDimensionWithResponse(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*DeleteFieldDimensionResponse, error) {
+	rsp, err := c.DeleteFieldDimension(ctx, fieldId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteFieldDimensionResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateCollectionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CreateFieldDimensionWithBodyWithResponse request with arbitrary body returning *CreateFieldDimensionResponse
+func (c *ClientWithResponses) CreateFieldDimensionWithBodyWithResponse(ctx context.Context, fieldId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFieldDimensionResponse, error) {
+	rsp, err := c.CreateFieldDimensionWithBody(ctx, fieldId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreateFieldDimensionResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateCollectionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) CreateFieldDimensionWithResponse(ctx context.Context, fieldId int, body CreateFieldDimensionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFieldDimensionResponse, error) {
+	rsp, err := c.CreateFieldDimension(ctx, fieldId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreateFieldDimensionResponse(rsp)
 }
 
-type GetCollectionPermissionsGraphResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CollectionPermissionsGraph
+// GetFieldValuesWithResponse request returning *GetFieldValuesResponse
+func (c *ClientWithResponses) GetFieldValuesWithResponse(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*GetFieldValuesResponse, error) {
+	rsp, err := c.GetFieldValues(ctx, fieldId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFieldValuesResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetCollectionPermissionsGraphResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UpdateFieldValuesWithBodyWithResponse request with arbitrary body returning *UpdateFieldValuesResponse
+func (c *ClientWithResponses) UpdateFieldValuesWithBodyWithResponse(ctx context.Context, fieldId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFieldValuesResponse, error) {
+	rsp, err := c.UpdateFieldValuesWithBody(ctx, fieldId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateFieldValuesResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCollectionPermissionsGraphResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) UpdateFieldValuesWithResponse(ctx context.Context, fieldId int, body UpdateFieldValuesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFieldValuesResponse, error) {
+	rsp, err := c.UpdateFieldValues(ctx, fieldId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateFieldValuesResponse(rsp)
 }
 
-type ReplaceCollectionPermissionsGraphResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CollectionPermissionsGraph
+// CreateModerationReviewWithBodyWithResponse request with arbitrary body returning *CreateModerationReviewResponse
+func (c *ClientWithResponses) CreateModerationReviewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateModerationReviewResponse, error) {
+	rsp, err := c.CreateModerationReviewWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateModerationReviewResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ReplaceCollectionPermissionsGraphResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) CreateModerationReviewWithResponse(ctx context.Context, body CreateModerationReviewJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateModerationReviewResponse, error) {
+	rsp, err := c.CreateModerationReview(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreateModerationReviewResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ReplaceCollectionPermissionsGraphResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CreateSandboxWithBodyWithResponse request with arbitrary body returning *CreateSandboxResponse
+func (c *ClientWithResponses) CreateSandboxWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSandboxResponse, error) {
+	rsp, err := c.CreateSandboxWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCreateSandboxResponse(rsp)
 }
 
-type GetCollectionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Collection
+func (c *ClientWithResponses) CreateSandboxWithResponse(ctx context.Context, body CreateSandboxJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSandboxResponse, error) {
+	rsp, err := c.CreateSandbox(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSandboxResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetCollectionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteSandboxWithResponse request returning *DeleteSandboxResponse
+func (c *ClientWithResponses) DeleteSandboxWithResponse(ctx context.Context, sandboxId int, reqEditors ...RequestEditorFn) (*DeleteSandboxResponse, error) {
+	rsp, err := c.DeleteSandbox(ctx, sandboxId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeleteSandboxResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCollectionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetSandboxWithResponse request returning *GetSandboxResponse
+func (c *ClientWithResponses) GetSandboxWithResponse(ctx context.Context, sandboxId int, reqEditors ...RequestEditorFn) (*GetSandboxResponse, error) {
+	rsp, err := c.GetSandbox(ctx, sandboxId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetSandboxResponse(rsp)
 }
 
-type UpdateCollectionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Collection
+// UpdateSandboxWithBodyWithResponse request with arbitrary body returning *UpdateSandboxResponse
+func (c *ClientWithResponses) UpdateSandboxWithBodyWithResponse(ctx context.Context, sandboxId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSandboxResponse, error) {
+	rsp, err := c.UpdateSandboxWithBody(ctx, sandboxId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSandboxResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateCollectionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) UpdateSandboxWithResponse(ctx context.Context, sandboxId int, body UpdateSandboxJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSandboxResponse, error) {
+	rsp, err := c.UpdateSandbox(ctx, sandboxId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateSandboxResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateCollectionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetPermissionsGraphWithResponse request returning *GetPermissionsGraphResponse
+func (c *ClientWithResponses) GetPermissionsGraphWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPermissionsGraphResponse, error) {
+	rsp, err := c.GetPermissionsGraph(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetPermissionsGraphResponse(rsp)
 }
 
-type ListCollectionItemsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CollectionItemList
+// ReplacePermissionsGraphWithBodyWithResponse request with arbitrary body returning *ReplacePermissionsGraphResponse
+func (c *ClientWithResponses) ReplacePermissionsGraphWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplacePermissionsGraphResponse, error) {
+	rsp, err := c.ReplacePermissionsGraphWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplacePermissionsGraphResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListCollectionItemsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) ReplacePermissionsGraphWithResponse(ctx context.Context, body ReplacePermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplacePermissionsGraphResponse, error) {
+	rsp, err := c.ReplacePermissionsGraph(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseReplacePermissionsGraphResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListCollectionItemsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ListPermissionsGroupsWithResponse request returning *ListPermissionsGroupsResponse
+func (c *ClientWithResponses) ListPermissionsGroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPermissionsGroupsResponse, error) {
+	rsp, err := c.ListPermissionsGroups(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseListPermissionsGroupsResponse(rsp)
 }
 
-type CreateDashboardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Dashboard
+// CreatePermissionsGroupWithBodyWithResponse request with arbitrary body returning *CreatePermissionsGroupResponse
+func (c *ClientWithResponses) CreatePermissionsGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePermissionsGroupResponse, error) {
+	rsp, err := c.CreatePermissionsGroupWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePermissionsGroupResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateDashboardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) CreatePermissionsGroupWithResponse(ctx context.Context, body CreatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePermissionsGroupResponse, error) {
+	rsp, err := c.CreatePermissionsGroup(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreatePermissionsGroupResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDashboardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DeletePermissionsGroupWithResponse request returning *DeletePermissionsGroupResponse
+func (c *ClientWithResponses) DeletePermissionsGroupWithResponse(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*DeletePermissionsGroupResponse, error) {
+	rsp, err := c.DeletePermissionsGroup(ctx, groupId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDeletePermissionsGroupResponse(rsp)
 }
 
-type DeleteDashboardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// GetPermissionsGroupWithResponse request returning *GetPermissionsGroupResponse
+func (c *ClientWithResponses) GetPermissionsGroupWithResponse(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*GetPermissionsGroupResponse, error) {
+	rsp, err := c.GetPermissionsGroup(ctx, groupId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPermissionsGroupResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteDashboardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UpdatePermissionsGroupWithBodyWithResponse request with arbitrary body returning *UpdatePermissionsGroupResponse
+func (c *ClientWithResponses) UpdatePermissionsGroupWithBodyWithResponse(ctx context.Context, groupId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePermissionsGroupResponse, error) {
+	rsp, err := c.UpdatePermissionsGroupWithBody(ctx, groupId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdatePermissionsGroupResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDashboardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) UpdatePermissionsGroupWithResponse(ctx context.Context, groupId int, body UpdatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePermissionsGroupResponse, error) {
+	rsp, err := c.UpdatePermissionsGroup(ctx, groupId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdatePermissionsGroupResponse(rsp)
 }
 
-type GetDashboardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Dashboard
+// CreatePermissionsMembershipWithBodyWithResponse request with arbitrary body returning *CreatePermissionsMembershipResponse
+func (c *ClientWithResponses) CreatePermissionsMembershipWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePermissionsMembershipResponse, error) {
+	rsp, err := c.CreatePermissionsMembershipWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePermissionsMembershipResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetDashboardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) CreatePermissionsMembershipWithResponse(ctx context.Context, body CreatePermissionsMembershipJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePermissionsMembershipResponse, error) {
+	rsp, err := c.CreatePermissionsMembership(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreatePermissionsMembershipResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDashboardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DeletePermissionsMembershipWithResponse request returning *DeletePermissionsMembershipResponse
+func (c *ClientWithResponses) DeletePermissionsMembershipWithResponse(ctx context.Context, membershipId int, reqEditors ...RequestEditorFn) (*DeletePermissionsMembershipResponse, error) {
+	rsp, err := c.DeletePermissionsMembership(ctx, membershipId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDeletePermissionsMembershipResponse(rsp)
 }
 
-type UpdateDashboardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Dashboard
+// GetPersistedInfoWithResponse request returning *GetPersistedInfoResponse
+func (c *ClientWithResponses) GetPersistedInfoWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*GetPersistedInfoResponse, error) {
+	rsp, err := c.GetPersistedInfo(ctx, cardId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPersistedInfoResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateDashboardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PersistModelWithResponse request returning *PersistModelResponse
+func (c *ClientWithResponses) PersistModelWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*PersistModelResponse, error) {
+	rsp, err := c.PersistModel(ctx, cardId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePersistModelResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateDashboardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UnpersistModelWithResponse request returning *UnpersistModelResponse
+func (c *ClientWithResponses) UnpersistModelWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*UnpersistModelResponse, error) {
+	rsp, err := c.UnpersistModel(ctx, cardId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUnpersistModelResponse(rsp)
 }
 
-type ListDatabasesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DatabaseList
+// PersistDatabaseWithResponse request returning *PersistDatabaseResponse
+func (c *ClientWithResponses) PersistDatabaseWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*PersistDatabaseResponse, error) {
+	rsp, err := c.PersistDatabase(ctx, databaseId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePersistDatabaseResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListDatabasesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UnpersistDatabaseWithResponse request returning *UnpersistDatabaseResponse
+func (c *ClientWithResponses) UnpersistDatabaseWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*UnpersistDatabaseResponse, error) {
+	rsp, err := c.UnpersistDatabase(ctx, databaseId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUnpersistDatabaseResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDatabasesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// EnablePersistenceWithBodyWithResponse request with arbitrary body returning *EnablePersistenceResponse
+func (c *ClientWithResponses) EnablePersistenceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EnablePersistenceResponse, error) {
+	rsp, err := c.EnablePersistenceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseEnablePersistenceResponse(rsp)
 }
 
-type CreateDatabaseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Database
+func (c *ClientWithResponses) EnablePersistenceWithResponse(ctx context.Context, body EnablePersistenceJSONRequestBody, reqEditors ...RequestEditorFn) (*EnablePersistenceResponse, error) {
+	rsp, err := c.EnablePersistence(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnablePersistenceResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateDatabaseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// SetPersistenceRefreshScheduleWithBodyWithResponse request with arbitrary body returning *SetPersistenceRefreshScheduleResponse
+func (c *ClientWithResponses) SetPersistenceRefreshScheduleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetPersistenceRefreshScheduleResponse, error) {
+	rsp, err := c.SetPersistenceRefreshScheduleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseSetPersistenceRefreshScheduleResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDatabaseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) SetPersistenceRefreshScheduleWithResponse(ctx context.Context, body SetPersistenceRefreshScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetPersistenceRefreshScheduleResponse, error) {
+	rsp, err := c.SetPersistenceRefreshSchedule(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseSetPersistenceRefreshScheduleResponse(rsp)
 }
 
-type DeleteDatabaseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// CreateSessionWithBodyWithResponse request with arbitrary body returning *CreateSessionResponse
+func (c *ClientWithResponses) CreateSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error) {
+	rsp, err := c.CreateSessionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSessionResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteDatabaseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) CreateSessionWithResponse(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error) {
+	rsp, err := c.CreateSession(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCreateSessionResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDatabaseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetSessionPropertiesWithResponse request returning *GetSessionPropertiesResponse
+func (c *ClientWithResponses) GetSessionPropertiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSessionPropertiesResponse, error) {
+	rsp, err := c.GetSessionProperties(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetSessionPropertiesResponse(rsp)
 }
 
-type GetDatabaseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Database
+// ListTablesWithResponse request returning *ListTablesResponse
+func (c *ClientWithResponses) ListTablesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTablesResponse, error) {
+	rsp, err := c.ListTables(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTablesResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UpdateTableWithBodyWithResponse request with arbitrary body returning *UpdateTableResponse
+func (c *ClientWithResponses) UpdateTableWithBodyWithResponse(ctx context.Context, tableId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTableResponse, error) {
+	rsp, err := c.UpdateTableWithBody(ctx, tableId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateTableResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) UpdateTableWithResponse(ctx context.Context, tableId int, body UpdateTableJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTableResponse, error) {
+	rsp, err := c.UpdateTable(ctx, tableId, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateTableResponse(rsp)
 }
 
-type UpdateDatabaseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Database
+// GetTableForeignKeysWithResponse request returning *GetTableForeignKeysResponse
+func (c *ClientWithResponses) GetTableForeignKeysWithResponse(ctx context.Context, tableId int, reqEditors ...RequestEditorFn) (*GetTableForeignKeysResponse, error) {
+	rsp, err := c.GetTableForeignKeys(ctx, tableId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTableForeignKeysResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateDatabaseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetTableMetadataWithResponse request returning *GetTableMetadataResponse
+func (c *ClientWithResponses) GetTableMetadataWithResponse(ctx context.Context, tableId int, params *GetTableMetadataParams, reqEditors ...RequestEditorFn) (*GetTableMetadataResponse, error) {
+	rsp, err := c.GetTableMetadata(ctx, tableId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetTableMetadataResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateDatabaseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseCreateCardResponse parses an HTTP response from a CreateCardWithResponse call
+func ParseCreateCardResponse(rsp *http.Response) (*CreateCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetFieldResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Field
-}
+	response := &CreateCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetFieldResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetFieldResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseGetCardResponse parses an HTTP response from a GetCardWithResponse call
+func ParseGetCardResponse(rsp *http.Response) (*GetCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateFieldResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Field
-}
+	response := &GetCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdateFieldResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateFieldResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseUpdateCardResponse parses an HTTP response from a UpdateCardWithResponse call
+func ParseUpdateCardResponse(rsp *http.Response) (*UpdateCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetPermissionsGraphResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PermissionsGraph
+	response := &UpdateCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Card
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetPermissionsGraphResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetCardDashboardsResponse parses an HTTP response from a GetCardDashboardsWithResponse call
+func ParseGetCardDashboardsResponse(rsp *http.Response) (*GetCardDashboardsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCardDashboardsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPermissionsGraphResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CardDashboard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type ReplacePermissionsGraphResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PermissionsGraph
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ReplacePermissionsGraphResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetCardRelatedResponse parses an HTTP response from a GetCardRelatedWithResponse call
+func ParseGetCardRelatedResponse(rsp *http.Response) (*GetCardRelatedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ReplacePermissionsGraphResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetCardRelatedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type CreatePermissionsGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PermissionsGroup
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CardRelated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r CreatePermissionsGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreatePermissionsGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type DeletePermissionsGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+// ParseListCollectionsResponse parses an HTTP response from a ListCollectionsWithResponse call
+func ParseListCollectionsResponse(rsp *http.Response) (*ListCollectionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeletePermissionsGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &ListCollectionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeletePermissionsGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Collection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type GetPermissionsGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PermissionsGroup
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetPermissionsGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCreateCollectionResponse parses an HTTP response from a CreateCollectionWithResponse call
+func ParseCreateCollectionResponse(rsp *http.Response) (*CreateCollectionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPermissionsGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &CreateCollectionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UpdatePermissionsGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PermissionsGroup
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Collection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdatePermissionsGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdatePermissionsGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type CreateSessionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Session
-}
+// ParseGetCollectionPermissionsGraphResponse parses an HTTP response from a GetCollectionPermissionsGraphWithResponse call
+func ParseGetCollectionPermissionsGraphResponse(rsp *http.Response) (*GetCollectionPermissionsGraphResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateSessionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &GetCollectionPermissionsGraphResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateSessionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CollectionPermissionsGraph
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type ListTablesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Table
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListTablesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseReplaceCollectionPermissionsGraphResponse parses an HTTP response from a ReplaceCollectionPermissionsGraphWithResponse call
+func ParseReplaceCollectionPermissionsGraphResponse(rsp *http.Response) (*ReplaceCollectionPermissionsGraphResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListTablesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ReplaceCollectionPermissionsGraphResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UpdateTableResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Table
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CollectionPermissionsGraph
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdateTableResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateTableResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseGetCollectionResponse parses an HTTP response from a GetCollectionWithResponse call
+func ParseGetCollectionResponse(rsp *http.Response) (*GetCollectionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetTableMetadataResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TableMetadata
-}
+	response := &GetCollectionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Collection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetTableMetadataResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTableMetadataResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-// CreateCardWithBodyWithResponse request with arbitrary body returning *CreateCardResponse
-func (c *ClientWithResponses) CreateCardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCardResponse, error) {
-	rsp, err := c.CreateCardWithBody(ctx, contentType, body, reqEditors...)
+// ParseUpdateCollectionResponse parses an HTTP response from a UpdateCollectionWithResponse call
+func ParseUpdateCollectionResponse(rsp *http.Response) (*UpdateCollectionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateCardResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateCardWithResponse(ctx context.Context, body CreateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCardResponse, error) {
-	rsp, err := c.CreateCard(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateCollectionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateCardResponse(rsp)
-}
 
-// GetCardWithResponse request returning *GetCardResponse
-func (c *ClientWithResponses) GetCardWithResponse(ctx context.Context, cardId int, reqEditors ...RequestEditorFn) (*GetCardResponse, error) {
-	rsp, err := c.GetCard(ctx, cardId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Collection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetCardResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateCardWithBodyWithResponse request with arbitrary body returning *UpdateCardResponse
-func (c *ClientWithResponses) UpdateCardWithBodyWithResponse(ctx context.Context, cardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCardResponse, error) {
-	rsp, err := c.UpdateCardWithBody(ctx, cardId, contentType, body, reqEditors...)
+// ParseListCollectionItemsResponse parses an HTTP response from a ListCollectionItemsWithResponse call
+func ParseListCollectionItemsResponse(rsp *http.Response) (*ListCollectionItemsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateCardResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateCardWithResponse(ctx context.Context, cardId int, body UpdateCardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCardResponse, error) {
-	rsp, err := c.UpdateCard(ctx, cardId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListCollectionItemsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateCardResponse(rsp)
-}
 
-// ListCollectionsWithResponse request returning *ListCollectionsResponse
-func (c *ClientWithResponses) ListCollectionsWithResponse(ctx context.Context, params *ListCollectionsParams, reqEditors ...RequestEditorFn) (*ListCollectionsResponse, error) {
-	rsp, err := c.ListCollections(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CollectionItemList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseListCollectionsResponse(rsp)
+
+	return response, nil
 }
 
-// CreateCollectionWithBodyWithResponse request with arbitrary body returning *CreateCollectionResponse
-func (c *ClientWithResponses) CreateCollectionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCollectionResponse, error) {
-	rsp, err := c.CreateCollectionWithBody(ctx, contentType, body, reqEditors...)
+// ParseCreateDashboardResponse parses an HTTP response from a CreateDashboardWithResponse call
+func ParseCreateDashboardResponse(rsp *http.Response) (*CreateDashboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateCollectionResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateCollectionWithResponse(ctx context.Context, body CreateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCollectionResponse, error) {
-	rsp, err := c.CreateCollection(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateDashboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateCollectionResponse(rsp)
-}
 
-// GetCollectionPermissionsGraphWithResponse request returning *GetCollectionPermissionsGraphResponse
-func (c *ClientWithResponses) GetCollectionPermissionsGraphWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCollectionPermissionsGraphResponse, error) {
-	rsp, err := c.GetCollectionPermissionsGraph(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Dashboard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetCollectionPermissionsGraphResponse(rsp)
+
+	return response, nil
 }
 
-// ReplaceCollectionPermissionsGraphWithBodyWithResponse request with arbitrary body returning *ReplaceCollectionPermissionsGraphResponse
-func (c *ClientWithResponses) ReplaceCollectionPermissionsGraphWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplaceCollectionPermissionsGraphResponse, error) {
-	rsp, err := c.ReplaceCollectionPermissionsGraphWithBody(ctx, contentType, body, reqEditors...)
+// ParseDeleteDashboardResponse parses an HTTP response from a DeleteDashboardWithResponse call
+func ParseDeleteDashboardResponse(rsp *http.Response) (*DeleteDashboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseReplaceCollectionPermissionsGraphResponse(rsp)
-}
 
-func (c *ClientWithResponses) ReplaceCollectionPermissionsGraphWithResponse(ctx context.Context, body ReplaceCollectionPermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplaceCollectionPermissionsGraphResponse, error) {
-	rsp, err := c.ReplaceCollectionPermissionsGraph(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteDashboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseReplaceCollectionPermissionsGraphResponse(rsp)
+
+	return response, nil
 }
 
-// GetCollectionWithResponse request returning *GetCollectionResponse
-func (c *ClientWithResponses) GetCollectionWithResponse(ctx context.Context, collectionId string, reqEditors ...RequestEditorFn) (*GetCollectionResponse, error) {
-	rsp, err := c.GetCollection(ctx, collectionId, reqEditors...)
+// ParseGetDashboardResponse parses an HTTP response from a GetDashboardWithResponse call
+func ParseGetDashboardResponse(rsp *http.Response) (*GetDashboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetCollectionResponse(rsp)
-}
 
-// UpdateCollectionWithBodyWithResponse request with arbitrary body returning *UpdateCollectionResponse
-func (c *ClientWithResponses) UpdateCollectionWithBodyWithResponse(ctx context.Context, collectionId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCollectionResponse, error) {
-	rsp, err := c.UpdateCollectionWithBody(ctx, collectionId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetDashboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateCollectionResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateCollectionWithResponse(ctx context.Context, collectionId string, body UpdateCollectionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCollectionResponse, error) {
-	rsp, err := c.UpdateCollection(ctx, collectionId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Dashboard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdateCollectionResponse(rsp)
+
+	return response, nil
 }
 
-// ListCollectionItemsWithResponse request returning *ListCollectionItemsResponse
-func (c *ClientWithResponses) ListCollectionItemsWithResponse(ctx context.Context, collectionId string, params *ListCollectionItemsParams, reqEditors ...RequestEditorFn) (*ListCollectionItemsResponse, error) {
-	rsp, err := c.ListCollectionItems(ctx, collectionId, params, reqEditors...)
+// ParseUpdateDashboardResponse parses an HTTP response from a UpdateDashboardWithResponse call
+func ParseUpdateDashboardResponse(rsp *http.Response) (*UpdateDashboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListCollectionItemsResponse(rsp)
-}
 
-// CreateDashboardWithBodyWithResponse request with arbitrary body returning *CreateDashboardResponse
-func (c *ClientWithResponses) CreateDashboardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDashboardResponse, error) {
-	rsp, err := c.CreateDashboardWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateDashboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateDashboardResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateDashboardWithResponse(ctx context.Context, body CreateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDashboardResponse, error) {
-	rsp, err := c.CreateDashboard(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Dashboard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCreateDashboardResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteDashboardWithResponse request returning *DeleteDashboardResponse
-func (c *ClientWithResponses) DeleteDashboardWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*DeleteDashboardResponse, error) {
-	rsp, err := c.DeleteDashboard(ctx, dashboardId, reqEditors...)
+// ParseGetDashboardQueryMetadataResponse parses an HTTP response from a GetDashboardQueryMetadataWithResponse call
+func ParseGetDashboardQueryMetadataResponse(rsp *http.Response) (*GetDashboardQueryMetadataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteDashboardResponse(rsp)
-}
 
-// GetDashboardWithResponse request returning *GetDashboardResponse
-func (c *ClientWithResponses) GetDashboardWithResponse(ctx context.Context, dashboardId int, reqEditors ...RequestEditorFn) (*GetDashboardResponse, error) {
-	rsp, err := c.GetDashboard(ctx, dashboardId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetDashboardQueryMetadataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetDashboardResponse(rsp)
-}
 
-// UpdateDashboardWithBodyWithResponse request with arbitrary body returning *UpdateDashboardResponse
-func (c *ClientWithResponses) UpdateDashboardWithBodyWithResponse(ctx context.Context, dashboardId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDashboardResponse, error) {
-	rsp, err := c.UpdateDashboardWithBody(ctx, dashboardId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DashboardQueryMetadata
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdateDashboardResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateDashboardWithResponse(ctx context.Context, dashboardId int, body UpdateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDashboardResponse, error) {
-	rsp, err := c.UpdateDashboard(ctx, dashboardId, body, reqEditors...)
+// ParseListDatabasesResponse parses an HTTP response from a ListDatabasesWithResponse call
+func ParseListDatabasesResponse(rsp *http.Response) (*ListDatabasesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateDashboardResponse(rsp)
+
+	response := &ListDatabasesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ListDatabasesWithResponse request returning *ListDatabasesResponse
-func (c *ClientWithResponses) ListDatabasesWithResponse(ctx context.Context, params *ListDatabasesParams, reqEditors ...RequestEditorFn) (*ListDatabasesResponse, error) {
-	rsp, err := c.ListDatabases(ctx, params, reqEditors...)
+// ParseCreateDatabaseResponse parses an HTTP response from a CreateDatabaseWithResponse call
+func ParseCreateDatabaseResponse(rsp *http.Response) (*CreateDatabaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListDatabasesResponse(rsp)
-}
 
-// CreateDatabaseWithBodyWithResponse request with arbitrary body returning *CreateDatabaseResponse
-func (c *ClientWithResponses) CreateDatabaseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error) {
-	rsp, err := c.CreateDatabaseWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateDatabaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateDatabaseResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateDatabaseWithResponse(ctx context.Context, body CreateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDatabaseResponse, error) {
-	rsp, err := c.CreateDatabase(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Database
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCreateDatabaseResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteDatabaseWithResponse request returning *DeleteDatabaseResponse
-func (c *ClientWithResponses) DeleteDatabaseWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*DeleteDatabaseResponse, error) {
-	rsp, err := c.DeleteDatabase(ctx, databaseId, reqEditors...)
+// ParseValidateDatabaseResponse parses an HTTP response from a ValidateDatabaseWithResponse call
+func ParseValidateDatabaseResponse(rsp *http.Response) (*ValidateDatabaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteDatabaseResponse(rsp)
-}
 
-// GetDatabaseWithResponse request returning *GetDatabaseResponse
-func (c *ClientWithResponses) GetDatabaseWithResponse(ctx context.Context, databaseId int, reqEditors ...RequestEditorFn) (*GetDatabaseResponse, error) {
-	rsp, err := c.GetDatabase(ctx, databaseId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ValidateDatabaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetDatabaseResponse(rsp)
-}
 
-// UpdateDatabaseWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseResponse
-func (c *ClientWithResponses) UpdateDatabaseWithBodyWithResponse(ctx context.Context, databaseId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error) {
-	rsp, err := c.UpdateDatabaseWithBody(ctx, databaseId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatabaseValidationResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdateDatabaseResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateDatabaseWithResponse(ctx context.Context, databaseId int, body UpdateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error) {
-	rsp, err := c.UpdateDatabase(ctx, databaseId, body, reqEditors...)
+// ParseDeleteDatabaseResponse parses an HTTP response from a DeleteDatabaseWithResponse call
+func ParseDeleteDatabaseResponse(rsp *http.Response) (*DeleteDatabaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateDatabaseResponse(rsp)
-}
 
-// GetFieldWithResponse request returning *GetFieldResponse
-func (c *ClientWithResponses) GetFieldWithResponse(ctx context.Context, fieldId int, reqEditors ...RequestEditorFn) (*GetFieldResponse, error) {
-	rsp, err := c.GetField(ctx, fieldId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteDatabaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetFieldResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateFieldWithBodyWithResponse request with arbitrary body returning *UpdateFieldResponse
-func (c *ClientWithResponses) UpdateFieldWithBodyWithResponse(ctx context.Context, fieldId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFieldResponse, error) {
-	rsp, err := c.UpdateFieldWithBody(ctx, fieldId, contentType, body, reqEditors...)
+// ParseGetDatabaseResponse parses an HTTP response from a GetDatabaseWithResponse call
+func ParseGetDatabaseResponse(rsp *http.Response) (*GetDatabaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateFieldResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateFieldWithResponse(ctx context.Context, fieldId int, body UpdateFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFieldResponse, error) {
-	rsp, err := c.UpdateField(ctx, fieldId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetDatabaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateFieldResponse(rsp)
-}
 
-// GetPermissionsGraphWithResponse request returning *GetPermissionsGraphResponse
-func (c *ClientWithResponses) GetPermissionsGraphWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPermissionsGraphResponse, error) {
-	rsp, err := c.GetPermissionsGraph(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Database
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetPermissionsGraphResponse(rsp)
+
+	return response, nil
 }
 
-// ReplacePermissionsGraphWithBodyWithResponse request with arbitrary body returning *ReplacePermissionsGraphResponse
-func (c *ClientWithResponses) ReplacePermissionsGraphWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplacePermissionsGraphResponse, error) {
-	rsp, err := c.ReplacePermissionsGraphWithBody(ctx, contentType, body, reqEditors...)
+// ParseUpdateDatabaseResponse parses an HTTP response from a UpdateDatabaseWithResponse call
+func ParseUpdateDatabaseResponse(rsp *http.Response) (*UpdateDatabaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseReplacePermissionsGraphResponse(rsp)
-}
 
-func (c *ClientWithResponses) ReplacePermissionsGraphWithResponse(ctx context.Context, body ReplacePermissionsGraphJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplacePermissionsGraphResponse, error) {
-	rsp, err := c.ReplacePermissionsGraph(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateDatabaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseReplacePermissionsGraphResponse(rsp)
-}
 
-// CreatePermissionsGroupWithBodyWithResponse request with arbitrary body returning *CreatePermissionsGroupResponse
-func (c *ClientWithResponses) CreatePermissionsGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePermissionsGroupResponse, error) {
-	rsp, err := c.CreatePermissionsGroupWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Database
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCreatePermissionsGroupResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreatePermissionsGroupWithResponse(ctx context.Context, body CreatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePermissionsGroupResponse, error) {
-	rsp, err := c.CreatePermissionsGroup(ctx, body, reqEditors...)
+// ParseListDatabaseSchemasResponse parses an HTTP response from a ListDatabaseSchemasWithResponse call
+func ParseListDatabaseSchemasResponse(rsp *http.Response) (*ListDatabaseSchemasResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreatePermissionsGroupResponse(rsp)
-}
 
-// DeletePermissionsGroupWithResponse request returning *DeletePermissionsGroupResponse
-func (c *ClientWithResponses) DeletePermissionsGroupWithResponse(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*DeletePermissionsGroupResponse, error) {
-	rsp, err := c.DeletePermissionsGroup(ctx, groupId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListDatabaseSchemasResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeletePermissionsGroupResponse(rsp)
-}
 
-// GetPermissionsGroupWithResponse request returning *GetPermissionsGroupResponse
-func (c *ClientWithResponses) GetPermissionsGroupWithResponse(ctx context.Context, groupId int, reqEditors ...RequestEditorFn) (*GetPermissionsGroupResponse, error) {
-	rsp, err := c.GetPermissionsGroup(ctx, groupId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetPermissionsGroupResponse(rsp)
+
+	return response, nil
 }
 
-// UpdatePermissionsGroupWithBodyWithResponse request with arbitrary body returning *UpdatePermissionsGroupResponse
-func (c *ClientWithResponses) UpdatePermissionsGroupWithBodyWithResponse(ctx context.Context, groupId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePermissionsGroupResponse, error) {
-	rsp, err := c.UpdatePermissionsGroupWithBody(ctx, groupId, contentType, body, reqEditors...)
+// ParseGetImpersonationResponse parses an HTTP response from a GetImpersonationWithResponse call
+func ParseGetImpersonationResponse(rsp *http.Response) (*GetImpersonationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdatePermissionsGroupResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdatePermissionsGroupWithResponse(ctx context.Context, groupId int, body UpdatePermissionsGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePermissionsGroupResponse, error) {
-	rsp, err := c.UpdatePermissionsGroup(ctx, groupId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetImpersonationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdatePermissionsGroupResponse(rsp)
-}
 
-// CreateSessionWithBodyWithResponse request with arbitrary body returning *CreateSessionResponse
-func (c *ClientWithResponses) CreateSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error) {
-	rsp, err := c.CreateSessionWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Impersonation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCreateSessionResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateSessionWithResponse(ctx context.Context, body CreateSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSessionResponse, error) {
-	rsp, err := c.CreateSession(ctx, body, reqEditors...)
+// ParseDeleteImpersonationResponse parses an HTTP response from a DeleteImpersonationWithResponse call
+func ParseDeleteImpersonationResponse(rsp *http.Response) (*DeleteImpersonationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateSessionResponse(rsp)
-}
 
-// ListTablesWithResponse request returning *ListTablesResponse
-func (c *ClientWithResponses) ListTablesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTablesResponse, error) {
-	rsp, err := c.ListTables(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteImpersonationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListTablesResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateTableWithBodyWithResponse request with arbitrary body returning *UpdateTableResponse
-func (c *ClientWithResponses) UpdateTableWithBodyWithResponse(ctx context.Context, tableId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTableResponse, error) {
-	rsp, err := c.UpdateTableWithBody(ctx, tableId, contentType, body, reqEditors...)
+// ParseExportSerializationResponse parses an HTTP response from a ExportSerializationWithResponse call
+func ParseExportSerializationResponse(rsp *http.Response) (*ExportSerializationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateTableResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateTableWithResponse(ctx context.Context, tableId int, body UpdateTableJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTableResponse, error) {
-	rsp, err := c.UpdateTable(ctx, tableId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ExportSerializationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateTableResponse(rsp)
+
+	return response, nil
 }
 
-// GetTableMetadataWithResponse request returning *GetTableMetadataResponse
-func (c *ClientWithResponses) GetTableMetadataWithResponse(ctx context.Context, tableId int, params *GetTableMetadataParams, reqEditors ...RequestEditorFn) (*GetTableMetadataResponse, error) {
-	rsp, err := c.GetTableMetadata(ctx, tableId, params, reqEditors...)
+// ParseImportSerializationResponse parses an HTTP response from a ImportSerializationWithResponse call
+func ParseImportSerializationResponse(rsp *http.Response) (*ImportSerializationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetTableMetadataResponse(rsp)
+
+	response := &ImportSerializationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// ParseCreateCardResponse parses an HTTP response from a CreateCardWithResponse call
-func ParseCreateCardResponse(rsp *http.Response) (*CreateCardResponse, error) {
+// ParseGetFieldResponse parses an HTTP response from a GetFieldWithResponse call
+func ParseGetFieldResponse(rsp *http.Response) (*GetFieldResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateCardResponse{
+	response := &GetFieldResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Card
+		var dest Field
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4603,22 +9391,22 @@ func ParseCreateCardResponse(rsp *http.Response) (*CreateCardResponse, error) {
 	return response, nil
 }
 
-// ParseGetCardResponse parses an HTTP response from a GetCardWithResponse call
-func ParseGetCardResponse(rsp *http.Response) (*GetCardResponse, error) {
+// ParseUpdateFieldResponse parses an HTTP response from a UpdateFieldWithResponse call
+func ParseUpdateFieldResponse(rsp *http.Response) (*UpdateFieldResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCardResponse{
+	response := &UpdateFieldResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Card
+		var dest Field
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4629,48 +9417,38 @@ func ParseGetCardResponse(rsp *http.Response) (*GetCardResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateCardResponse parses an HTTP response from a UpdateCardWithResponse call
-func ParseUpdateCardResponse(rsp *http.Response) (*UpdateCardResponse, error) {
+// ParseDeleteFieldDimensionResponse parses an HTTP response from a DeleteFieldDimensionWithResponse call
+func ParseDeleteFieldDimensionResponse(rsp *http.Response) (*DeleteFieldDimensionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateCardResponse{
+	response := &DeleteFieldDimensionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Card
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseListCollectionsResponse parses an HTTP response from a ListCollectionsWithResponse call
-func ParseListCollectionsResponse(rsp *http.Response) (*ListCollectionsResponse, error) {
+// ParseCreateFieldDimensionResponse parses an HTTP response from a CreateFieldDimensionWithResponse call
+func ParseCreateFieldDimensionResponse(rsp *http.Response) (*CreateFieldDimensionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListCollectionsResponse{
+	response := &CreateFieldDimensionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Collection
+		var dest FieldDimension
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4681,22 +9459,22 @@ func ParseListCollectionsResponse(rsp *http.Response) (*ListCollectionsResponse,
 	return response, nil
 }
 
-// ParseCreateCollectionResponse parses an HTTP response from a CreateCollectionWithResponse call
-func ParseCreateCollectionResponse(rsp *http.Response) (*CreateCollectionResponse, error) {
+// ParseGetFieldValuesResponse parses an HTTP response from a GetFieldValuesWithResponse call
+func ParseGetFieldValuesResponse(rsp *http.Response) (*GetFieldValuesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateCollectionResponse{
+	response := &GetFieldValuesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Collection
+		var dest FieldValues
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4707,48 +9485,38 @@ func ParseCreateCollectionResponse(rsp *http.Response) (*CreateCollectionRespons
 	return response, nil
 }
 
-// ParseGetCollectionPermissionsGraphResponse parses an HTTP response from a GetCollectionPermissionsGraphWithResponse call
-func ParseGetCollectionPermissionsGraphResponse(rsp *http.Response) (*GetCollectionPermissionsGraphResponse, error) {
+// ParseUpdateFieldValuesResponse parses an HTTP response from a UpdateFieldValuesWithResponse call
+func ParseUpdateFieldValuesResponse(rsp *http.Response) (*UpdateFieldValuesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCollectionPermissionsGraphResponse{
+	response := &UpdateFieldValuesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CollectionPermissionsGraph
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseReplaceCollectionPermissionsGraphResponse parses an HTTP response from a ReplaceCollectionPermissionsGraphWithResponse call
-func ParseReplaceCollectionPermissionsGraphResponse(rsp *http.Response) (*ReplaceCollectionPermissionsGraphResponse, error) {
+// ParseCreateModerationReviewResponse parses an HTTP response from a CreateModerationReviewWithResponse call
+func ParseCreateModerationReviewResponse(rsp *http.Response) (*CreateModerationReviewResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ReplaceCollectionPermissionsGraphResponse{
+	response := &CreateModerationReviewResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CollectionPermissionsGraph
+		var dest ModerationReview
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4759,22 +9527,22 @@ func ParseReplaceCollectionPermissionsGraphResponse(rsp *http.Response) (*Replac
 	return response, nil
 }
 
-// ParseGetCollectionResponse parses an HTTP response from a GetCollectionWithResponse call
-func ParseGetCollectionResponse(rsp *http.Response) (*GetCollectionResponse, error) {
+// ParseCreateSandboxResponse parses an HTTP response from a CreateSandboxWithResponse call
+func ParseCreateSandboxResponse(rsp *http.Response) (*CreateSandboxResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCollectionResponse{
+	response := &CreateSandboxResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Collection
+		var dest Sandbox
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4785,48 +9553,38 @@ func ParseGetCollectionResponse(rsp *http.Response) (*GetCollectionResponse, err
 	return response, nil
 }
 
-// ParseUpdateCollectionResponse parses an HTTP response from a UpdateCollectionWithResponse call
-func ParseUpdateCollectionResponse(rsp *http.Response) (*UpdateCollectionResponse, error) {
+// ParseDeleteSandboxResponse parses an HTTP response from a DeleteSandboxWithResponse call
+func ParseDeleteSandboxResponse(rsp *http.Response) (*DeleteSandboxResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateCollectionResponse{
+	response := &DeleteSandboxResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Collection
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseListCollectionItemsResponse parses an HTTP response from a ListCollectionItemsWithResponse call
-func ParseListCollectionItemsResponse(rsp *http.Response) (*ListCollectionItemsResponse, error) {
+// ParseGetSandboxResponse parses an HTTP response from a GetSandboxWithResponse call
+func ParseGetSandboxResponse(rsp *http.Response) (*GetSandboxResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListCollectionItemsResponse{
+	response := &GetSandboxResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CollectionItemList
+		var dest Sandbox
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4837,22 +9595,22 @@ func ParseListCollectionItemsResponse(rsp *http.Response) (*ListCollectionItemsR
 	return response, nil
 }
 
-// ParseCreateDashboardResponse parses an HTTP response from a CreateDashboardWithResponse call
-func ParseCreateDashboardResponse(rsp *http.Response) (*CreateDashboardResponse, error) {
+// ParseUpdateSandboxResponse parses an HTTP response from a UpdateSandboxWithResponse call
+func ParseUpdateSandboxResponse(rsp *http.Response) (*UpdateSandboxResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDashboardResponse{
+	response := &UpdateSandboxResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Dashboard
+		var dest Sandbox
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4863,38 +9621,22 @@ func ParseCreateDashboardResponse(rsp *http.Response) (*CreateDashboardResponse,
 	return response, nil
 }
 
-// ParseDeleteDashboardResponse parses an HTTP response from a DeleteDashboardWithResponse call
-func ParseDeleteDashboardResponse(rsp *http.Response) (*DeleteDashboardResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &DeleteDashboardResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
-}
-
-// ParseGetDashboardResponse parses an HTTP response from a GetDashboardWithResponse call
-func ParseGetDashboardResponse(rsp *http.Response) (*GetDashboardResponse, error) {
+// ParseGetPermissionsGraphResponse parses an HTTP response from a GetPermissionsGraphWithResponse call
+func ParseGetPermissionsGraphResponse(rsp *http.Response) (*GetPermissionsGraphResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDashboardResponse{
+	response := &GetPermissionsGraphResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Dashboard
+		var dest PermissionsGraph
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4905,22 +9647,22 @@ func ParseGetDashboardResponse(rsp *http.Response) (*GetDashboardResponse, error
 	return response, nil
 }
 
-// ParseUpdateDashboardResponse parses an HTTP response from a UpdateDashboardWithResponse call
-func ParseUpdateDashboardResponse(rsp *http.Response) (*UpdateDashboardResponse, error) {
+// ParseReplacePermissionsGraphResponse parses an HTTP response from a ReplacePermissionsGraphWithResponse call
+func ParseReplacePermissionsGraphResponse(rsp *http.Response) (*ReplacePermissionsGraphResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateDashboardResponse{
+	response := &ReplacePermissionsGraphResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Dashboard
+		var dest PermissionsGraph
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4931,22 +9673,22 @@ func ParseUpdateDashboardResponse(rsp *http.Response) (*UpdateDashboardResponse,
 	return response, nil
 }
 
-// ParseListDatabasesResponse parses an HTTP response from a ListDatabasesWithResponse call
-func ParseListDatabasesResponse(rsp *http.Response) (*ListDatabasesResponse, error) {
+// ParseListPermissionsGroupsResponse parses an HTTP response from a ListPermissionsGroupsWithResponse call
+func ParseListPermissionsGroupsResponse(rsp *http.Response) (*ListPermissionsGroupsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDatabasesResponse{
+	response := &ListPermissionsGroupsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DatabaseList
+		var dest []PermissionsGroup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4957,22 +9699,22 @@ func ParseListDatabasesResponse(rsp *http.Response) (*ListDatabasesResponse, err
 	return response, nil
 }
 
-// ParseCreateDatabaseResponse parses an HTTP response from a CreateDatabaseWithResponse call
-func ParseCreateDatabaseResponse(rsp *http.Response) (*CreateDatabaseResponse, error) {
+// ParseCreatePermissionsGroupResponse parses an HTTP response from a CreatePermissionsGroupWithResponse call
+func ParseCreatePermissionsGroupResponse(rsp *http.Response) (*CreatePermissionsGroupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDatabaseResponse{
+	response := &CreatePermissionsGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Database
+		var dest PermissionsGroup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4983,15 +9725,15 @@ func ParseCreateDatabaseResponse(rsp *http.Response) (*CreateDatabaseResponse, e
 	return response, nil
 }
 
-// ParseDeleteDatabaseResponse parses an HTTP response from a DeleteDatabaseWithResponse call
-func ParseDeleteDatabaseResponse(rsp *http.Response) (*DeleteDatabaseResponse, error) {
+// ParseDeletePermissionsGroupResponse parses an HTTP response from a DeletePermissionsGroupWithResponse call
+func ParseDeletePermissionsGroupResponse(rsp *http.Response) (*DeletePermissionsGroupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDatabaseResponse{
+	response := &DeletePermissionsGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4999,22 +9741,22 @@ func ParseDeleteDatabaseResponse(rsp *http.Response) (*DeleteDatabaseResponse, e
 	return response, nil
 }
 
-// ParseGetDatabaseResponse parses an HTTP response from a GetDatabaseWithResponse call
-func ParseGetDatabaseResponse(rsp *http.Response) (*GetDatabaseResponse, error) {
+// ParseGetPermissionsGroupResponse parses an HTTP response from a GetPermissionsGroupWithResponse call
+func ParseGetPermissionsGroupResponse(rsp *http.Response) (*GetPermissionsGroupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDatabaseResponse{
+	response := &GetPermissionsGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Database
+		var dest PermissionsGroup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5025,22 +9767,22 @@ func ParseGetDatabaseResponse(rsp *http.Response) (*GetDatabaseResponse, error)
 	return response, nil
 }
 
-// ParseUpdateDatabaseResponse parses an HTTP response from a UpdateDatabaseWithResponse call
-func ParseUpdateDatabaseResponse(rsp *http.Response) (*UpdateDatabaseResponse, error) {
+// ParseUpdatePermissionsGroupResponse parses an HTTP response from a UpdatePermissionsGroupWithResponse call
+func ParseUpdatePermissionsGroupResponse(rsp *http.Response) (*UpdatePermissionsGroupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateDatabaseResponse{
+	response := &UpdatePermissionsGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Database
+		var dest PermissionsGroup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5051,22 +9793,22 @@ func ParseUpdateDatabaseResponse(rsp *http.Response) (*UpdateDatabaseResponse, e
 	return response, nil
 }
 
-// ParseGetFieldResponse parses an HTTP response from a GetFieldWithResponse call
-func ParseGetFieldResponse(rsp *http.Response) (*GetFieldResponse, error) {
+// ParseCreatePermissionsMembershipResponse parses an HTTP response from a CreatePermissionsMembershipWithResponse call
+func ParseCreatePermissionsMembershipResponse(rsp *http.Response) (*CreatePermissionsMembershipResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFieldResponse{
+	response := &CreatePermissionsMembershipResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Field
+		var dest []PermissionsGroupMember
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5077,48 +9819,38 @@ func ParseGetFieldResponse(rsp *http.Response) (*GetFieldResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateFieldResponse parses an HTTP response from a UpdateFieldWithResponse call
-func ParseUpdateFieldResponse(rsp *http.Response) (*UpdateFieldResponse, error) {
+// ParseDeletePermissionsMembershipResponse parses an HTTP response from a DeletePermissionsMembershipWithResponse call
+func ParseDeletePermissionsMembershipResponse(rsp *http.Response) (*DeletePermissionsMembershipResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateFieldResponse{
+	response := &DeletePermissionsMembershipResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Field
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseGetPermissionsGraphResponse parses an HTTP response from a GetPermissionsGraphWithResponse call
-func ParseGetPermissionsGraphResponse(rsp *http.Response) (*GetPermissionsGraphResponse, error) {
+// ParseGetPersistedInfoResponse parses an HTTP response from a GetPersistedInfoWithResponse call
+func ParseGetPersistedInfoResponse(rsp *http.Response) (*GetPersistedInfoResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetPermissionsGraphResponse{
+	response := &GetPersistedInfoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PermissionsGraph
+		var dest PersistedInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5129,67 +9861,79 @@ func ParseGetPermissionsGraphResponse(rsp *http.Response) (*GetPermissionsGraphR
 	return response, nil
 }
 
-// ParseReplacePermissionsGraphResponse parses an HTTP response from a ReplacePermissionsGraphWithResponse call
-func ParseReplacePermissionsGraphResponse(rsp *http.Response) (*ReplacePermissionsGraphResponse, error) {
+// ParsePersistModelResponse parses an HTTP response from a PersistModelWithResponse call
+func ParsePersistModelResponse(rsp *http.Response) (*PersistModelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ReplacePermissionsGraphResponse{
+	response := &PersistModelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PermissionsGraph
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return response, nil
+}
+
+// ParseUnpersistModelResponse parses an HTTP response from a UnpersistModelWithResponse call
+func ParseUnpersistModelResponse(rsp *http.Response) (*UnpersistModelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
+	response := &UnpersistModelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseCreatePermissionsGroupResponse parses an HTTP response from a CreatePermissionsGroupWithResponse call
-func ParseCreatePermissionsGroupResponse(rsp *http.Response) (*CreatePermissionsGroupResponse, error) {
+// ParsePersistDatabaseResponse parses an HTTP response from a PersistDatabaseWithResponse call
+func ParsePersistDatabaseResponse(rsp *http.Response) (*PersistDatabaseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreatePermissionsGroupResponse{
+	response := &PersistDatabaseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PermissionsGroup
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return response, nil
+}
 
+// ParseUnpersistDatabaseResponse parses an HTTP response from a UnpersistDatabaseWithResponse call
+func ParseUnpersistDatabaseResponse(rsp *http.Response) (*UnpersistDatabaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnpersistDatabaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseDeletePermissionsGroupResponse parses an HTTP response from a DeletePermissionsGroupWithResponse call
-func ParseDeletePermissionsGroupResponse(rsp *http.Response) (*DeletePermissionsGroupResponse, error) {
+// ParseEnablePersistenceResponse parses an HTTP response from a EnablePersistenceWithResponse call
+func ParseEnablePersistenceResponse(rsp *http.Response) (*EnablePersistenceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeletePermissionsGroupResponse{
+	response := &EnablePersistenceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -5197,22 +9941,38 @@ func ParseDeletePermissionsGroupResponse(rsp *http.Response) (*DeletePermissions
 	return response, nil
 }
 
-// ParseGetPermissionsGroupResponse parses an HTTP response from a GetPermissionsGroupWithResponse call
-func ParseGetPermissionsGroupResponse(rsp *http.Response) (*GetPermissionsGroupResponse, error) {
+// ParseSetPersistenceRefreshScheduleResponse parses an HTTP response from a SetPersistenceRefreshScheduleWithResponse call
+func ParseSetPersistenceRefreshScheduleResponse(rsp *http.Response) (*SetPersistenceRefreshScheduleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetPermissionsGroupResponse{
+	response := &SetPersistenceRefreshScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseCreateSessionResponse parses an HTTP response from a CreateSessionWithResponse call
+func ParseCreateSessionResponse(rsp *http.Response) (*CreateSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSessionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PermissionsGroup
+		var dest Session
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5223,22 +9983,22 @@ func ParseGetPermissionsGroupResponse(rsp *http.Response) (*GetPermissionsGroupR
 	return response, nil
 }
 
-// ParseUpdatePermissionsGroupResponse parses an HTTP response from a UpdatePermissionsGroupWithResponse call
-func ParseUpdatePermissionsGroupResponse(rsp *http.Response) (*UpdatePermissionsGroupResponse, error) {
+// ParseGetSessionPropertiesResponse parses an HTTP response from a GetSessionPropertiesWithResponse call
+func ParseGetSessionPropertiesResponse(rsp *http.Response) (*GetSessionPropertiesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdatePermissionsGroupResponse{
+	response := &GetSessionPropertiesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PermissionsGroup
+		var dest SessionProperties
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5249,22 +10009,22 @@ func ParseUpdatePermissionsGroupResponse(rsp *http.Response) (*UpdatePermissions
 	return response, nil
 }
 
-// ParseCreateSessionResponse parses an HTTP response from a CreateSessionWithResponse call
-func ParseCreateSessionResponse(rsp *http.Response) (*CreateSessionResponse, error) {
+// ParseListTablesResponse parses an HTTP response from a ListTablesWithResponse call
+func ParseListTablesResponse(rsp *http.Response) (*ListTablesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateSessionResponse{
+	response := &ListTablesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Session
+		var dest []Table
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5275,22 +10035,22 @@ func ParseCreateSessionResponse(rsp *http.Response) (*CreateSessionResponse, err
 	return response, nil
 }
 
-// ParseListTablesResponse parses an HTTP response from a ListTablesWithResponse call
-func ParseListTablesResponse(rsp *http.Response) (*ListTablesResponse, error) {
+// ParseUpdateTableResponse parses an HTTP response from a UpdateTableWithResponse call
+func ParseUpdateTableResponse(rsp *http.Response) (*UpdateTableResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListTablesResponse{
+	response := &UpdateTableResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Table
+		var dest Table
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5301,22 +10061,22 @@ func ParseListTablesResponse(rsp *http.Response) (*ListTablesResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateTableResponse parses an HTTP response from a UpdateTableWithResponse call
-func ParseUpdateTableResponse(rsp *http.Response) (*UpdateTableResponse, error) {
+// ParseGetTableForeignKeysResponse parses an HTTP response from a GetTableForeignKeysWithResponse call
+func ParseGetTableForeignKeysResponse(rsp *http.Response) (*GetTableForeignKeysResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateTableResponse{
+	response := &GetTableForeignKeysResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Table
+		var dest []TableForeignKey
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
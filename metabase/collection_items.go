@@ -0,0 +1,38 @@
+package metabase
+
+import (
+	"context"
+	"fmt"
+)
+
+// The number of items requested in each call to the Metabase API when listing the items in a collection.
+const CollectionItemsPageSize = 100
+
+// Lists all the items in a collection, by requesting successive pages from the Metabase API until the total number of
+// items has been retrieved. The `Limit` and `Offset` of the given parameters are ignored.
+func ListAllCollectionItems(ctx context.Context, client ClientWithResponsesInterface, collectionId string, params ListCollectionItemsParams) ([]CollectionItem, error) {
+	items := make([]CollectionItem, 0)
+
+	limit := CollectionItemsPageSize
+	params.Limit = &limit
+
+	for {
+		offset := len(items)
+		params.Offset = &offset
+
+		listResp, err := client.ListCollectionItemsWithResponse(ctx, collectionId, &params)
+		if err != nil {
+			return nil, err
+		}
+		if listResp.JSON200 == nil {
+			return nil, fmt.Errorf("received unexpected response when listing items in collection %s, status code: %d, body: %s", collectionId, listResp.StatusCode(), listResp.BodyString())
+		}
+
+		items = append(items, listResp.JSON200.Data...)
+
+		// An empty page ensures the loop terminates if items are removed while listing them.
+		if len(listResp.JSON200.Data) == 0 || len(items) >= listResp.JSON200.Total {
+			return items, nil
+		}
+	}
+}
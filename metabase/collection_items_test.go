@@ -0,0 +1,60 @@
+package metabase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestListAllCollectionItemsPaginates(t *testing.T) {
+	total := CollectionItemsPageSize + 20
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if r.URL.Query().Get("models") != "dashboard" {
+			t.Errorf("expected the models filter to be sent, got query %s", r.URL.RawQuery)
+		}
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		data := "["
+		for i := offset; i < offset+limit && i < total; i++ {
+			if i > offset {
+				data += ","
+			}
+			data += fmt.Sprintf(`{"id":%d,"model":"dashboard","name":"Dashboard %d","description":null,"entity_id":"e%d"}`, i, i, i)
+		}
+		data += "]"
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data":%s,"total":%d,"models":["dashboard"],"limit":%d,"offset":%d}`, data, total, limit, offset)
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	items, err := ListAllCollectionItems(context.Background(), client, "root", ListCollectionItemsParams{
+		Models: &[]CollectionItemModel{CollectionItemModelDashboard},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != total {
+		t.Fatalf("expected %d items, got %d", total, len(items))
+	}
+	if items[total-1].Id != total-1 {
+		t.Errorf("expected items to be returned in order, got last item %d", items[total-1].Id)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
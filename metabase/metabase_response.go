@@ -1,5 +1,7 @@
 package metabase
 
+import "strings"
+
 // This file ensures the Metabase responses conform to the `MetabaseResponse` interface, for convenience when processing
 // them during Terraform operations.
 type MetabaseResponse interface {
@@ -8,6 +10,35 @@ type MetabaseResponse interface {
 	HasExpectedStatusWithoutExpectedBody() bool
 }
 
+// The messages in the body of a 404 response indicating that a paid feature is not available, rather than a missing
+// object. Endpoints of paid features do not exist at all in the open source version of Metabase.
+var paidFeatureNotFoundMessages = []string{
+	"API endpoint does not exist",
+	"is a paid feature",
+}
+
+// Returns whether the response of the Metabase API indicates that the feature is not available in this instance, i.e.
+// it requires a paid version of Metabase. This is either a 402 response, or a 404 response with a message specific to
+// paid features. Other 404 responses (e.g. for a missing object) are not considered.
+func IsPaidFeatureUnavailable(r MetabaseResponse) bool {
+	if r.StatusCode() == 402 {
+		return true
+	}
+
+	if r.StatusCode() != 404 {
+		return false
+	}
+
+	body := r.BodyString()
+	for _, m := range paidFeatureNotFoundMessages {
+		if strings.Contains(body, m) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (r *CreateCardResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -32,6 +63,22 @@ func (r *UpdateCardResponse) HasExpectedStatusWithoutExpectedBody() bool {
 	return r.StatusCode() == 200 && r.JSON200 == nil
 }
 
+func (r *GetCardDashboardsResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *GetCardDashboardsResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *GetCardRelatedResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *GetCardRelatedResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
 func (r *GetCollectionPermissionsGraphResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -72,6 +119,14 @@ func (r *UpdateCollectionResponse) HasExpectedStatusWithoutExpectedBody() bool {
 	return r.StatusCode() == 200 && r.JSON200 == nil
 }
 
+func (r *ListCollectionsResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *ListCollectionsResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
 func (r *ListCollectionItemsResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -104,6 +159,14 @@ func (r *UpdateDashboardResponse) HasExpectedStatusWithoutExpectedBody() bool {
 	return r.StatusCode() == 200 && r.JSON200 == nil
 }
 
+func (r *GetDashboardQueryMetadataResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *GetDashboardQueryMetadataResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
 func (r *DeleteDashboardResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -112,6 +175,14 @@ func (r *DeleteDashboardResponse) HasExpectedStatusWithoutExpectedBody() bool {
 	return false
 }
 
+func (r *ListDatabasesResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *ListDatabasesResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
 func (r *CreateDatabaseResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -120,6 +191,14 @@ func (r *CreateDatabaseResponse) HasExpectedStatusWithoutExpectedBody() bool {
 	return r.StatusCode() == 200 && r.JSON200 == nil
 }
 
+func (r *ValidateDatabaseResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *ValidateDatabaseResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
 func (r *GetDatabaseResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -144,6 +223,46 @@ func (r *DeleteDatabaseResponse) HasExpectedStatusWithoutExpectedBody() bool {
 	return false
 }
 
+func (r *ListDatabaseSchemasResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *ListDatabaseSchemasResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *GetImpersonationResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *GetImpersonationResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *DeleteImpersonationResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *DeleteImpersonationResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
+func (r *ExportSerializationResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *ExportSerializationResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
+func (r *ImportSerializationResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *ImportSerializationResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
 func (r *GetPermissionsGraphResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -160,6 +279,14 @@ func (r *ReplacePermissionsGraphResponse) HasExpectedStatusWithoutExpectedBody()
 	return r.StatusCode() == 200 && r.JSON200 == nil
 }
 
+func (r *ListPermissionsGroupsResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *ListPermissionsGroupsResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
 func (r *CreatePermissionsGroupResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -192,6 +319,118 @@ func (r *DeletePermissionsGroupResponse) HasExpectedStatusWithoutExpectedBody()
 	return false
 }
 
+func (r *CreateModerationReviewResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *CreateModerationReviewResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *CreateSandboxResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *CreateSandboxResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *GetSandboxResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *GetSandboxResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *UpdateSandboxResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *UpdateSandboxResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *DeleteSandboxResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *DeleteSandboxResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
+func (r *CreatePermissionsMembershipResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *CreatePermissionsMembershipResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *DeletePermissionsMembershipResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *DeletePermissionsMembershipResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
+func (r *EnablePersistenceResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *EnablePersistenceResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
+func (r *SetPersistenceRefreshScheduleResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *SetPersistenceRefreshScheduleResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
+func (r *PersistDatabaseResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *PersistDatabaseResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
+func (r *UnpersistDatabaseResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *UnpersistDatabaseResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
+func (r *GetPersistedInfoResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *GetPersistedInfoResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *PersistModelResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *PersistModelResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
+func (r *UnpersistModelResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *UnpersistModelResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
 func (r *CreateSessionResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -200,6 +439,14 @@ func (r *CreateSessionResponse) HasExpectedStatusWithoutExpectedBody() bool {
 	return r.StatusCode() == 200 && r.JSON200 == nil
 }
 
+func (r *GetSessionPropertiesResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *GetSessionPropertiesResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
 func (r *ListTablesResponse) BodyString() string {
 	return string(r.Body)
 }
@@ -216,6 +463,46 @@ func (r *GetTableMetadataResponse) HasExpectedStatusWithoutExpectedBody() bool {
 	return r.StatusCode() == 200 && r.JSON200 == nil
 }
 
+func (r *GetTableForeignKeysResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *GetTableForeignKeysResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *CreateFieldDimensionResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *CreateFieldDimensionResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *DeleteFieldDimensionResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *DeleteFieldDimensionResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
+func (r *GetFieldValuesResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *GetFieldValuesResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return r.StatusCode() == 200 && r.JSON200 == nil
+}
+
+func (r *UpdateFieldValuesResponse) BodyString() string {
+	return string(r.Body)
+}
+
+func (r *UpdateFieldValuesResponse) HasExpectedStatusWithoutExpectedBody() bool {
+	return false
+}
+
 func (r *UpdateTableResponse) BodyString() string {
 	return string(r.Body)
 }
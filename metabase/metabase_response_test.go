@@ -0,0 +1,31 @@
+package metabase
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestIsPaidFeatureUnavailable(t *testing.T) {
+	testCases := []struct {
+		statusCode int
+		body       string
+		expected   bool
+	}{
+		{402, `"Sandboxes is a paid feature not currently available to your instance."`, true},
+		{404, `"API endpoint does not exist."`, true},
+		{404, `"Not found."`, false},
+		{400, `"API endpoint does not exist."`, false},
+		{200, `{}`, false},
+	}
+
+	for _, tc := range testCases {
+		resp := &CreateSandboxResponse{
+			Body:         []byte(tc.body),
+			HTTPResponse: &http.Response{StatusCode: tc.statusCode},
+		}
+
+		if IsPaidFeatureUnavailable(resp) != tc.expected {
+			t.Errorf("Expected %t for status code %d and body %s.", tc.expected, tc.statusCode, tc.body)
+		}
+	}
+}
@@ -6,6 +6,10 @@ const AdministratorsPermissionsGroupId = 2
 // The ID of the `Metabase Analytics` database, automatically created for pro plans.
 const MetabaseAnalyticsDatabaseId = "13371337"
 
+// The ID of the virtual `Saved Questions` database, which can appear in the permissions graph and is used when querying
+// the results of other cards.
+const SavedQuestionsDatabaseId = "-1337"
+
 // The list of JSON attributes in a `Card` object that are needed to fully define the card, e.g. when creating it.
 var DefiningCardAttributes = map[string]bool{
 	"cache_ttl":              true,
@@ -21,12 +25,26 @@ var DefiningCardAttributes = map[string]bool{
 	"visualization_settings": true,
 }
 
+// The name of the attribute defining the type of card, e.g. `question` or `model`.
+const CardTypeAttribute = "type"
+
+// The type of a plain card, which is the default when the `type` attribute is not specified.
+const CardTypeQuestion = "question"
+
+// The name of the attribute flagging a card as a model (dataset) in older Metabase versions, before `type` was
+// introduced.
+const CardIsDatasetAttribute = "dataset"
+
 // The name of the attribute in cards for which the value is the ID of a `Table` object.
 const SourceTableAttribute = "source-table"
 
 // The name of the literal in an array, indicating a reference to a `Field` object.
 const FieldLiteral = "field"
 
+// The name of the option in a field reference for which the value is the ID of the foreign key `Field` used to
+// (implicitly) join the table of the referenced field.
+const SourceFieldAttribute = "source-field"
+
 // The name of the literal in an array indicating a reference to a `Field` object in the next array element.
 const FieldReferenceLiteral = "ref"
 
@@ -53,3 +71,12 @@ const ParameterMappingsAttribute = "parameter_mappings"
 
 // The name of the attribute describing the target of a dashboard parameter for a specific card in the dashboard.
 const TargetAttribute = "target"
+
+// The name of the attribute in a dataset query which defines a native (SQL) query.
+const NativeQueryAttribute = "native"
+
+// The name of the attribute in a native query which defines the variables (template tags) used in the query.
+const TemplateTagsAttribute = "template-tags"
+
+// The name of the attribute defining the parameters of a `Card` object.
+const ParametersAttribute = "parameters"